@@ -16,6 +16,7 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
@@ -24,6 +25,9 @@ import (
 type ClusterLogForwarderSpec struct {
 	// Indicator if the resource is 'Managed' or 'Unmanaged' by the operator.
 	//
+	// 'Preview' generates the collector configuration and resources without deploying them and records them along with
+	// their differences from the deployed resources in the '<name>-preview' configmap
+	//
 	// +kubebuilder:default:=Managed
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Management State"
 	ManagementState ManagementState `json:"managementState,omitempty"`
@@ -73,6 +77,16 @@ type ClusterLogForwarderSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Forwarder Pipelines"
 	Pipelines []PipelineSpec `json:"pipelines"`
 
+	// Tenancy enables the forwarder to serve the LogForwarders of the namespaces it selects.
+	//
+	// The container logs of each namespace are forwarded to the outputs of its LogForwarders
+	// by the collector of this forwarder.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tenancy"
+	Tenancy *TenancySpec `json:"tenancy,omitempty"`
+
 	// ServiceAccount points to the ServiceAccount resource used by the collector pods.
 	//
 	// +kubebuilder:validation:Required
@@ -88,9 +102,32 @@ type ServiceAccount struct {
 	Name string `json:"name"`
 }
 
+// TenancySpec defines the namespaces whose LogForwarders are served by a forwarder
+type TenancySpec struct {
+	// NamespaceSelector selects the namespaces whose LogForwarders are served by the forwarder. Selects all
+	// namespaces when not set.
+	//
+	// A namespace selected by several forwarders is served by the oldest forwarder.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tenant Namespace Selector"
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// RateLimit is the maximum rate of the container logs collected for a LogForwarder by the collector on each node.
+	//
+	// The limit applies to the logs of the LogForwarder regardless of the number of its outputs. The rate across the
+	// cluster is the limit multiplied by the number of nodes.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tenant Rate Limit"
+	RateLimit *LimitSpec `json:"rateLimit,omitempty"`
+}
+
 // ManagementState controls whether the operator's reconciliation is active for the given resource.
 //
-// +kubebuilder:validation:Enum:=Managed;Unmanaged
+// +kubebuilder:validation:Enum:=Managed;Unmanaged;Preview
 type ManagementState string
 
 const (
@@ -99,6 +136,10 @@ const (
 
 	// ManagementStateUnmanaged means that the operator will not take any action related to the component
 	ManagementStateUnmanaged ManagementState = "Unmanaged"
+
+	// ManagementStatePreview means that the operator generates its operands and resources and reports their differences
+	// from the deployed resources without changing them
+	ManagementStatePreview ManagementState = "Preview"
 )
 
 // CollectorSpec is spec to define scheduling and resources for a collector
@@ -152,6 +193,293 @@ type CollectorSpec struct {
 	// +kubebuilder:validation:Minimum:=1
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Termination Grace Period Seconds",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
 	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+
+	// Deployment defines the scaling, disruption and spreading of the collector pods when the collector
+	// is deployed as a Deployment.
+	//
+	// The collector is deployed as a Deployment when all inputs are receivers, kafka or events inputs which
+	// do not require access to the logs of the node. This spec is ignored when the collector is deployed as a DaemonSet.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Deployment"
+	Deployment *CollectorDeploymentSpec `json:"deployment,omitempty"`
+
+	// Aggregator enables two-tier collection where the collector pods on the nodes only read, normalize and forward
+	// logs to an aggregator Deployment managed by the operator which applies the filters and writes to the outputs.
+	//
+	// Traffic between the tiers is secured with mutual TLS using certificates of the service CA.
+	// This spec is ignored when the collector is deployed as a Deployment.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Aggregator"
+	Aggregator *CollectorAggregatorSpec `json:"aggregator,omitempty"`
+
+	// Rollout enables a canary rollout of changes to the config of the collector.
+	//
+	// A changed config is first rolled out to the collectors on a canary subset of the nodes. The config is rolled out
+	// to the remaining nodes once the canary collectors are healthy for the soak period, otherwise the canary collectors
+	// are rolled back to the previous config. The progress is reported by the Rollout condition of the status.
+	// This spec is ignored when the collector is deployed as a Deployment.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rollout"
+	Rollout *CollectorRolloutSpec `json:"rollout,omitempty"`
+
+	// NodeProfiles deploy the collector on the nodes selected by each profile as a separate DaemonSet with the
+	// resources, tolerations and inputs of the profile. The collector DaemonSet of the forwarder runs on the nodes
+	// which are not selected by a profile.
+	//
+	// The node selectors of the profiles must not overlap. The canary rollout only applies to the collector
+	// DaemonSet of the forwarder. This spec is ignored when the collector is deployed as a Deployment.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems:=10
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Node Profiles"
+	NodeProfiles []CollectorNodeProfile `json:"nodeProfiles,omitempty"`
+}
+
+// CollectorNodeProfile defines the collector deployed on a pool of nodes
+type CollectorNodeProfile struct {
+	// Name of the profile which is appended to the name of the collector DaemonSet of the profile
+	//
+	// +kubebuilder:validation:Pattern:="^[a-z]([-a-z0-9]*[a-z0-9])?$"
+	// +kubebuilder:validation:MaxLength:=20
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Name string `json:"name"`
+
+	// NodeSelector selects the nodes of the profile. It is combined with the node selector of the collector.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinProperties:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Node Selector"
+	NodeSelector map[string]string `json:"nodeSelector"`
+
+	// The resource requirements of the collector on the nodes of the profile. Defaults to the resources of the collector.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Resource Requirements",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:resourceRequirements"}
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Tolerations of the collector on the nodes of the profile which are added to the tolerations of the collector
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tolerations"
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// InputRefs is the subset of the inputs collected on the nodes of the profile. Defaults to all inputs.
+	//
+	// Receiver inputs are served by the collector DaemonSet of the forwarder and can not be referenced.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Input References",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	InputRefs []string `json:"inputRefs,omitempty"`
+}
+
+// CollectorRolloutSpec defines the canary rollout of changes to the config of the collector
+//
+// +kubebuilder:validation:XValidation:rule="!(has(self.canaryNodeSelector) && has(self.canaryPercentage))", message="canaryNodeSelector and canaryPercentage are mutually exclusive"
+type CollectorRolloutSpec struct {
+	// CanaryNodeSelector selects the canary nodes by their labels
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Canary Node Selector"
+	CanaryNodeSelector map[string]string `json:"canaryNodeSelector,omitempty"`
+
+	// CanaryPercentage is the percentage of the nodes running a collector which are canary nodes when
+	// canaryNodeSelector is not set. At least one node is a canary node. Defaults to 10.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=100
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Canary Percentage",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	CanaryPercentage *int32 `json:"canaryPercentage,omitempty"`
+
+	// SoakPeriod is the time the canary collectors must be healthy before the config is rolled out to the
+	// remaining nodes (e.g. 10m). The canary is rolled back when its collectors are not ready within twice the
+	// soak period. Defaults to 10m.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Soak Period"
+	SoakPeriod *metav1.Duration `json:"soakPeriod,omitempty"`
+
+	// MaxRestarts is the number of restarts of the canary collectors tolerated during the canary. Defaults to 0.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=0
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Restarts",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	MaxRestarts *int32 `json:"maxRestarts,omitempty"`
+
+	// MaxComponentErrors is the number of errors reported by the components of the canary collectors
+	// (vector_component_errors_total) tolerated during the soak period.
+	//
+	// The metric is queried from the cluster monitoring stack and is not evaluated when not set.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=0
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Component Errors",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	MaxComponentErrors *int64 `json:"maxComponentErrors,omitempty"`
+}
+
+// CollectorAggregatorSpec defines the settings of the aggregator tier of a two-tier collector
+type CollectorAggregatorSpec struct {
+	// The resource requirements for the aggregator
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Aggregator Resource Requirements",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:resourceRequirements"}
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Define nodes for scheduling the aggregator pods.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Aggregator Node Selector"
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Define the tolerations the aggregator pods will accept
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Aggregator Tolerations"
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// Deployment defines the scaling, disruption and spreading of the aggregator pods
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Aggregator Deployment"
+	Deployment *CollectorDeploymentSpec `json:"deployment,omitempty"`
+}
+
+// CollectorDeploymentSpec defines the settings of a collector deployed as a Deployment
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.replicas) || !has(self.autoscaling)", message="replicas and autoscaling are mutually exclusive"
+type CollectorDeploymentSpec struct {
+	// Replicas is the number of collector pods. Defaults to 2.
+	//
+	// Collectors with an events input run a single replica to avoid forwarding events more than once and are
+	// invalid when replicas is not 1.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Replicas",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:podCount"}
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Autoscaling scales the number of collector pods using a HorizontalPodAutoscaler
+	//
+	// Collectors with an events input are invalid when autoscaling is specified.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Autoscaling"
+	Autoscaling *CollectorAutoscalingSpec `json:"autoscaling,omitempty"`
+
+	// PodDisruptionBudget limits the number of collector pods that are down simultaneously due to voluntary disruptions
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pod Disruption Budget"
+	PodDisruptionBudget *CollectorPodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+
+	// TopologySpreadConstraints describes how the collector pods are spread across topology domains.
+	//
+	// The label selector of a constraint defaults to the labels of the collector pods when absent.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Topology Spread Constraints"
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+}
+
+// CollectorAutoscalingMetric is the metric used to scale the collector
+//
+// +kubebuilder:validation:Enum:=cpu;bufferUsage;eventsIn
+type CollectorAutoscalingMetric string
+
+const (
+	// CollectorAutoscalingMetricCPU scales on the average CPU utilization of the collector pods
+	CollectorAutoscalingMetricCPU CollectorAutoscalingMetric = "cpu"
+
+	// CollectorAutoscalingMetricBufferUsage scales on the average number of bytes buffered by the collector pods
+	CollectorAutoscalingMetricBufferUsage CollectorAutoscalingMetric = "bufferUsage"
+
+	// CollectorAutoscalingMetricEventsIn scales on the average number of events per second received by the collector pods
+	CollectorAutoscalingMetricEventsIn CollectorAutoscalingMetric = "eventsIn"
+)
+
+// CollectorAutoscalingSpec defines the HorizontalPodAutoscaler of the collector
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.minReplicas) || self.minReplicas <= self.maxReplicas", message="minReplicas must be less than or equal to maxReplicas"
+// +kubebuilder:validation:XValidation:rule="self.metric == 'cpu' || has(self.targetAverageValue)", message="targetAverageValue is required for the bufferUsage and eventsIn metrics"
+type CollectorAutoscalingSpec struct {
+	// MinReplicas is the lower limit for the number of collector pods. Defaults to 1.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Min Replicas",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:podCount"}
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper limit for the number of collector pods
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Replicas",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:podCount"}
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// Metric is the metric used to scale the collector.
+	//
+	// The bufferUsage and eventsIn metrics are the collector's own metrics and require a custom metrics API
+	// adapter (e.g. prometheus-adapter) exposing them per pod. bufferUsage is the `vector_buffer_byte_size` gauge.
+	// eventsIn is `vector_component_received_events` of the sources (`component_kind="source"`), the per second rate of
+	// the `vector_component_received_events_total` counter. The adapter must expose it with a rule naming the counter
+	// without its `_total` suffix and querying its rate (e.g. the default prometheus-adapter rule for counters)
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=cpu
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Metric"
+	Metric CollectorAutoscalingMetric `json:"metric,omitempty"`
+
+	// TargetCPUUtilizationPercentage is the target average CPU utilization of the collector pods, in percent of the
+	// requested CPU, for the cpu metric. Defaults to 80.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=100
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Target CPU Utilization Percentage",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+
+	// TargetAverageValue is the target average value per collector pod for the bufferUsage and eventsIn metrics
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Target Average Value",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	TargetAverageValue *resource.Quantity `json:"targetAverageValue,omitempty"`
+}
+
+// CollectorPodDisruptionBudgetSpec defines the PodDisruptionBudget of the collector
+//
+// +kubebuilder:validation:XValidation:rule="!(has(self.minAvailable) && has(self.maxUnavailable))", message="minAvailable and maxUnavailable are mutually exclusive"
+type CollectorPodDisruptionBudgetSpec struct {
+	// MinAvailable is the number or percentage of collector pods that must remain available.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Min Available",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// MaxUnavailable is the number or percentage of collector pods that may be unavailable.
+	// Defaults to 1 when neither minAvailable nor maxUnavailable is set.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Unavailable",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
 }
 
 type NetworkPolicy struct {
@@ -264,6 +592,21 @@ type SecretReference struct {
 	SecretName string `json:"secretName"`
 }
 
+// ConfigMapReference encodes a reference to a single key in a ConfigMap in the same namespace.
+type ConfigMapReference struct {
+	// Key contains the name of the key inside the referenced ConfigMap.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Key Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Key string `json:"key"`
+
+	// ConfigMapName contains the name of the ConfigMap containing the referenced value.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="ConfigMap Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	ConfigMapName string `json:"configMapName"`
+}
+
 // BearerToken allows configuring the source of a bearer token used for authentication.
 // The token can either be read from a secret or from a Kubernetes ServiceAccount.
 // +kubebuilder:validation:XValidation:rule="self.from != 'secret' || has(self.secret)", message="Additional secret spec is required when bearer token is sourced from a secret"
@@ -44,6 +44,13 @@ const (
 	// outside the operator's control.
 	ConditionTypeReady string = "Ready"
 
+	// ConditionTypeRollout identifies the state of the canary rollout of the config of the collector.
+	//
+	// Rollout=Unknown means a changed config is rolled out to the canary collectors.
+	// Rollout=True means the config is rolled out to all collectors.
+	// Rollout=False means the canary collectors were rolled back to the previous config.
+	ConditionTypeRollout = GroupName + "/Rollout"
+
 	// ConditionTypeValid identifies the state of validation for the service
 	ConditionTypeValid = GroupName + "/Valid"
 
@@ -59,12 +66,21 @@ const (
 	// ConditionTypeValidFilterPrefix prefixes a named filter to identify its validation state
 	ConditionTypeValidFilterPrefix = GroupName + "/ValidFilter"
 
+	// ConditionTypeValidNodeProfiles identifies the state of validation of the node profiles of the collector
+	ConditionTypeValidNodeProfiles = GroupName + "/ValidNodeProfiles"
+
 	// ReasonClusterRolesExist means the collector serviceAccount is bound to all the cluster roles needed to collect a log_type
 	ReasonClusterRolesExist = "ClusterRolesExist"
 
 	// ReasonClusterRoleMissing means the collector serviceAccount is missing one or more clusterRoles needed to collect a log_type
 	ReasonClusterRoleMissing = "ClusterRoleMissing"
 
+	// ReasonConfigValidationFailure means the collector rejected the generated config which is not rolled out
+	ReasonConfigValidationFailure = "ConfigValidationFailure"
+
+	// ReasonConfigValidationPending means the generated config is rolled out once it is validated by the collector
+	ReasonConfigValidationPending = "ConfigValidationPending"
+
 	// ReasonDeploymentError means an error occurred trying to deploy the collector or some related component
 	ReasonDeploymentError = "DeploymentError"
 
@@ -77,6 +93,9 @@ const (
 	// ReasonManagementStateUnmanaged is used when the workload is in an Unmanaged state
 	ReasonManagementStateUnmanaged = "ManagementStateUnmanaged"
 
+	// ReasonManagementStatePreview is used when the workload is in a Preview state
+	ReasonManagementStatePreview = "ManagementStatePreview"
+
 	// ReasonMissingSpec applies when a type is specified without a defined spec (e.g. type application without obs.Application)
 	ReasonMissingSpec = "MissingSpec"
 
@@ -92,6 +111,18 @@ const (
 	// ReasonReconciliationComplete when the operator has initialized, validated, and deployed the resources for the workload
 	ReasonReconciliationComplete = "ReconciliationComplete"
 
+	// ReasonNotServed means no ClusterLogForwarder serves the LogForwarders of the namespace
+	ReasonNotServed = "NotServed"
+
+	// ReasonRolloutCanary is used when a changed config is rolled out to the canary collectors
+	ReasonRolloutCanary = "RolloutCanary"
+
+	// ReasonRolloutComplete is used when the config is rolled out to all collectors
+	ReasonRolloutComplete = "RolloutComplete"
+
+	// ReasonRolledBack is used when the canary collectors were rolled back to the previous config
+	ReasonRolledBack = "RolledBack"
+
 	// ReasonServiceAccountDoesNotExist when the ServiceAccount is not found
 	ReasonServiceAccountDoesNotExist = "ServiceAccountDoesNotExist"
 
@@ -40,9 +40,10 @@ import auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
 //
 // === Wildcards
 //
-// Names of users, groups, namespaces, and resources can have a leading or trailing '*' character.
+// Names of users, groups, namespaces, API groups and resources can have a leading or trailing '*' character.
 // For example namespace 'openshift-*' matches 'openshift-apiserver' or 'openshift-authentication.
-// Resource '*/status' matches 'Pod/status' or 'Deployment/status'
+// Resource '*/status' matches 'Pod/status' or 'Deployment/status'.
+// API group '*.openshift.io' matches 'config.openshift.io' or 'route.openshift.io'.
 //
 // Events which include both a 'resource' and 'subresource' are evaluated by combing those
 // fields with a forward slash.  This means rules that rely upon a resource type that may or
@@ -63,6 +64,16 @@ import auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
 //
 // You can drop events based on the HTTP status code in the response. See the OmitResponseCodes field.
 //
+// === Sources
+//
+// The policy applies to the events of the Kubernetes API server, the OpenShift API servers and the OAuth server.
+// The Sources field restricts the policy to some of them, the events of the other sources pass the filter unchanged.
+//
+// === OAuth Login Events
+//
+// Login events of the OAuth server are made by the 'system:anonymous' user and are dropped by the default rules.
+// See the OAuth field to forward them and to drop them by the decision of the login.
+//
 // [Kube Audit Policy]: https://kubernetes.io/docs/reference/config-api/apiserver-audit.v1/#audit-k8s-io-v1-Policy
 // [Kubernetes Auditing]: https://kubernetes.io/docs/tasks/debug/debug-cluster/audit/
 type KubeAPIAudit struct {
@@ -87,4 +98,116 @@ type KubeAPIAudit struct {
 	//
 	// +kubebuilder:validation:Optional
 	OmitResponseCodes *[]int `json:"omitResponseCodes,omitempty"`
+
+	// Sources are the API servers whose audit events are filtered by the policy.
+	// Events of other sources pass the filter unchanged.
+	//
+	//   - kubeAPI: events of the Kubernetes API server.
+	//   - openshiftAPI: events of the OpenShift API servers, except the events of the oauth.openshift.io and user.openshift.io API groups.
+	//   - oauth: events of the OAuth server and of the oauth.openshift.io and user.openshift.io API groups.
+	//
+	// If this field is missing or empty, the policy applies to all sources.
+	//
+	// +kubebuilder:validation:Optional
+	// +listType:=set
+	Sources []KubeAPIAuditSource `json:"sources,omitempty"`
+
+	// OAuth filters the login events of the OAuth server.
+	// When set, login events which do not match any rule are forwarded instead of being dropped by the default rules,
+	// unless their decision is omitted.
+	//
+	// +kubebuilder:validation:Optional
+	OAuth *OAuthAudit `json:"oauth,omitempty"`
 }
+
+// KubeAPIAuditSource is an API server whose audit events are filtered by a KubeAPIAudit filter
+//
+// +kubebuilder:validation:Enum:=kubeAPI;openshiftAPI;oauth
+type KubeAPIAuditSource string
+
+const (
+	KubeAPIAuditSourceKube      KubeAPIAuditSource = "kubeAPI"
+	KubeAPIAuditSourceOpenShift KubeAPIAuditSource = "openshiftAPI"
+	KubeAPIAuditSourceOAuth     KubeAPIAuditSource = "oauth"
+)
+
+// OAuthAudit filters the login events of the OAuth server
+type OAuthAudit struct {
+	// OmitDecisions is a list of login decisions for which no events are forwarded.
+	// The decision of a login is recorded by the `authentication.openshift.io/decision` annotation of the event.
+	//
+	// +kubebuilder:validation:Optional
+	// +listType:=set
+	OmitDecisions []OAuthLoginDecision `json:"omitDecisions,omitempty"`
+}
+
+// OAuthLoginDecision is the decision of the OAuth server for a login
+//
+// +kubebuilder:validation:Enum:=allow;deny;error
+type OAuthLoginDecision string
+
+const (
+	OAuthLoginDecisionAllow OAuthLoginDecision = "allow"
+	OAuthLoginDecisionDeny  OAuthLoginDecision = "deny"
+	OAuthLoginDecisionError OAuthLoginDecision = "error"
+)
+
+// OVNAudit filters the ACL audit logs of the Open Virtual Network, as described in [OVN Audit Logging].
+//
+// An event is dropped when it matches any of the drop rules. Events which are not OVN ACL audit logs
+// pass the filter unchanged.
+//
+// [OVN Audit Logging]: https://docs.openshift.com/container-platform/latest/networking/ovn_kubernetes_network_provider/logging-network-policy.html
+type OVNAudit struct {
+	// Drop is a list of rules matching the events to drop.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	Drop []OVNAuditRule `json:"drop"`
+}
+
+// OVNAuditRule matches the OVN ACL audit events which match all its fields.
+// A rule without any field matches all events.
+type OVNAuditRule struct {
+	// ACLNames is a list of names of ACLs. Names can have a leading or trailing '*' character.
+	// For example 'verify-audit-logging_*' matches 'verify-audit-logging_deny-all'.
+	//
+	// +kubebuilder:validation:Optional
+	ACLNames []string `json:"aclNames,omitempty"`
+
+	// Verdicts is a list of verdicts of the ACLs.
+	//
+	// +kubebuilder:validation:Optional
+	// +listType:=set
+	Verdicts []OVNACLVerdict `json:"verdicts,omitempty"`
+
+	// Severities is a list of severities of the ACLs.
+	//
+	// +kubebuilder:validation:Optional
+	// +listType:=set
+	Severities []OVNACLSeverity `json:"severities,omitempty"`
+}
+
+// OVNACLVerdict is the verdict of an OVN ACL
+//
+// +kubebuilder:validation:Enum:=allow;drop;reject
+type OVNACLVerdict string
+
+const (
+	OVNACLVerdictAllow  OVNACLVerdict = "allow"
+	OVNACLVerdictDrop   OVNACLVerdict = "drop"
+	OVNACLVerdictReject OVNACLVerdict = "reject"
+)
+
+// OVNACLSeverity is the severity of an OVN ACL
+//
+// +kubebuilder:validation:Enum:=alert;warning;notice;info;debug
+type OVNACLSeverity string
+
+const (
+	OVNACLSeverityAlert   OVNACLSeverity = "alert"
+	OVNACLSeverityWarning OVNACLSeverity = "warning"
+	OVNACLSeverityNotice  OVNACLSeverity = "notice"
+	OVNACLSeverityInfo    OVNACLSeverity = "info"
+	OVNACLSeverityDebug   OVNACLSeverity = "debug"
+)
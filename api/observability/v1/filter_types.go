@@ -16,7 +16,7 @@ package v1
 
 // FilterType specifies the type of filter used in a pipeline
 //
-// +kubebuilder:validation:Enum:=openshiftLabels;detectMultilineException;drop;kubeAPIAudit;parse;prune
+// +kubebuilder:validation:Enum:=openshiftLabels;detectMultilineException;drop;kubeAPIAudit;ovnAudit;parse;prune
 type FilterType string
 
 // Filter type constants, must match JSON tags of FilterTypeSpec fields.
@@ -25,6 +25,7 @@ const (
 	FilterTypeDrop            FilterType = "drop"
 	FilterTypeKubeAPIAudit    FilterType = "kubeAPIAudit"
 	FilterTypeOpenshiftLabels FilterType = "openshiftLabels"
+	FilterTypeOVNAudit        FilterType = "ovnAudit"
 	FilterTypeParse           FilterType = "parse"
 	FilterTypePrune           FilterType = "prune"
 )
@@ -35,6 +36,7 @@ var (
 		FilterTypeDetectMultiline,
 		FilterTypeDrop,
 		FilterTypeKubeAPIAudit,
+		FilterTypeOVNAudit,
 		FilterTypeParse,
 		FilterTypePrune,
 	}
@@ -43,6 +45,7 @@ var (
 // FilterSpec defines a filter for log messages.
 //
 // +kubebuilder:validation:XValidation:rule="self.type != 'kubeAPIAudit' || has(self.kubeAPIAudit)", message="Additional type specific spec is required for the filter type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'ovnAudit' || has(self.ovnAudit)", message="Additional type specific spec is required for the filter type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'drop' || has(self.drop)", message="Additional type specific spec is required for the filter type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'prune' || has(self.prune)", message="Additional type specific spec is required for the filter type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'openshiftLabels' || has(self.openshiftLabels)", message="Additional type specific spec is required for the filter type"
@@ -61,8 +64,9 @@ type FilterSpec struct {
 	// 2. drop - Drop whole log records based on the evaluation of a set of regex tests. See field `drop` for configuration.
 	// 3. kubeAPIAudit - Remove unwanted audit events and reduce event size to create a manageable audit trail. See field `kubeAPIaudit` for configuration.
 	// 4. openshiftLabels - Labels to be applied to log records passing through a pipeline. See field `openshiftLabels` for configuration.
-	// 5. parse - Enables parsing of log entries into structured logs. No additional configuration required.
-	// 6. prune - Prune log record fields to reduce the size of logs flowing into a log store. See field `prune` for configuration.
+	// 5. ovnAudit - Remove unwanted OVN ACL audit events by ACL name, verdict and severity. See field `ovnAudit` for configuration.
+	// 6. parse - Enables parsing of log entries into structured logs. No additional configuration required.
+	// 7. prune - Prune log record fields to reduce the size of logs flowing into a log store. See field `prune` for configuration.
 	//
 	// +kubebuilder:validation:Required
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Filter Type"
@@ -72,6 +76,10 @@ type FilterSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kubernetes API Audit Filter"
 	KubeAPIAudit *KubeAPIAudit `json:"kubeAPIAudit,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="OVN Audit Filter"
+	OVNAudit *OVNAudit `json:"ovnAudit,omitempty"`
+
 	// A drop filter applies a sequence of tests to a log record and drops the record if any test passes.
 	// Each test contains a sequence of conditions, all conditions must be true for the test to pass.
 	// A DropTestsSpec contains an array of tests which contains an array of conditions
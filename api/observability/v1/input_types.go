@@ -21,7 +21,7 @@ import (
 
 // InputType specifies the type of log input to create.
 //
-// +kubebuilder:validation:Enum:=audit;application;infrastructure;receiver
+// +kubebuilder:validation:Enum:=audit;application;infrastructure;receiver;kafka;events
 type InputType string
 
 func (s InputType) String() string {
@@ -37,6 +37,10 @@ const (
 	InputTypeAudit InputType = "audit"
 	// InputTypeReceiver defines a network receiver for receiving logs from non-cluster sources.
 	InputTypeReceiver InputType = "receiver"
+	// InputTypeKafka consumes logs published to the topics of a Kafka cluster.
+	InputTypeKafka InputType = "kafka"
+	// InputTypeEvents collects the events of the cluster from the API server.
+	InputTypeEvents InputType = "events"
 )
 
 var (
@@ -45,6 +49,8 @@ var (
 		InputTypeInfrastructure,
 		InputTypeAudit,
 		InputTypeReceiver,
+		InputTypeKafka,
+		InputTypeEvents,
 	}
 )
 
@@ -53,6 +59,8 @@ var (
 // +kubebuilder:validation:XValidation:rule="self.type != 'infrastructure' || has(self.infrastructure)", message="Additional type specific spec is required for the input type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'audit' || has(self.audit)", message="Additional type specific spec is required for the input type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'receiver' || has(self.receiver)", message="Additional type specific spec is required for the input type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'kafka' || has(self.kafka)", message="Additional type specific spec is required for the input type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'events' || has(self.events)", message="Additional type specific spec is required for the input type"
 type InputSpec struct {
 	// Name used to refer to the input of a `pipeline`.
 	//
@@ -91,6 +99,18 @@ type InputSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Receiver"
 	Receiver *ReceiverSpec `json:"receiver,omitempty"`
+
+	// Kafka to consume logs from the topics of a Kafka cluster.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kafka Consumer"
+	Kafka *KafkaInput `json:"kafka,omitempty"`
+
+	// Events to collect the events of the cluster.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cluster Events Input"
+	Events *EventsInput `json:"events,omitempty"`
 }
 
 type ContainerInputTuningSpec struct {
@@ -139,6 +159,17 @@ type Application struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pod Selector",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:selector:core:v1:Pod"}
 	Selector *metav1.LabelSelector `json:"selector,omitempty"`
 
+	// NamespaceSelector for logs from pods in namespaces with matching labels.
+	//
+	// Only messages from pods in namespaces with these labels are collected. Namespaces
+	// gaining or losing the labels start or stop being collected without redeploying the collector.
+	//
+	// If absent or empty, logs are collected regardless of namespace labels.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Namespace Selector",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:selector:core:v1:Namespace"}
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
 	// Tuning is the container input tuning spec for this container sources
 	//
 	// +kubebuilder:validation:Optional
@@ -160,8 +191,40 @@ type Application struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Exclude"
 	Excludes []NamespaceContainerSpec `json:"excludes,omitempty"`
+
+	// AllowedPodAnnotations is the set of pod annotations application owners may use to control
+	// the collection and processing of their own logs.
+	//
+	// Annotations that are not listed are ignored. The supported annotations are:
+	//
+	// `observability.openshift.io/collect`: Logs of pods with the value "false" are not collected
+	//
+	// `observability.openshift.io/parser`: Format used by a parse filter to parse the message. One of "json", "logfmt" or "none"
+	//
+	// `observability.openshift.io/pipeline-hint`: Name of the pipeline, consuming this input, that exclusively forwards the logs of the pod
+	//
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Allowed Pod Annotations"
+	AllowedPodAnnotations []PodAnnotationKey `json:"allowedPodAnnotations,omitempty"`
 }
 
+// PodAnnotationKey is the key of a pod annotation honored by the collector
+//
+// +kubebuilder:validation:Enum:=observability.openshift.io/collect;observability.openshift.io/parser;observability.openshift.io/pipeline-hint
+type PodAnnotationKey string
+
+const (
+	// PodAnnotationCollect opts the logs of a pod out of collection when set to "false"
+	PodAnnotationCollect PodAnnotationKey = "observability.openshift.io/collect"
+
+	// PodAnnotationParser requests the format used to parse the logs of a pod
+	PodAnnotationParser PodAnnotationKey = "observability.openshift.io/parser"
+
+	// PodAnnotationPipelineHint requests the pipeline that forwards the logs of a pod
+	PodAnnotationPipelineHint PodAnnotationKey = "observability.openshift.io/pipeline-hint"
+)
+
 type NamespaceContainerSpec struct {
 
 	// Namespace specs the namespace from which to collect logs
@@ -181,7 +244,7 @@ type NamespaceContainerSpec struct {
 
 // InfrastructureSource defines the type of infrastructure log source to use.
 //
-// +kubebuilder:validation:Enum:=container;node
+// +kubebuilder:validation:Enum:=container;node;file
 type InfrastructureSource string
 
 func (s InfrastructureSource) String() string {
@@ -195,6 +258,10 @@ const (
 	// InfrastructureSourceContainer are container logs from workloads deployed
 	// in any of the following namespaces: default, kube*, openshift*
 	InfrastructureSourceContainer InfrastructureSource = "container"
+
+	// InfrastructureSourceFile are log files written to the node by agents that log neither
+	// to journald nor to containers. It is only collected when explicitly listed in the sources
+	InfrastructureSourceFile InfrastructureSource = "file"
 )
 
 var (
@@ -204,7 +271,7 @@ var (
 	}
 )
 
-// InfrastructureInputTuningSpec is the infrastructure input tuning spec, for now available only for container sources
+// InfrastructureInputTuningSpec is the infrastructure input tuning spec for container and node sources
 type InfrastructureInputTuningSpec struct {
 
 	// Container is the input tuning spec for container sources
@@ -212,12 +279,124 @@ type InfrastructureInputTuningSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Input Tuning"
 	Container *ContainerInputTuningSpec `json:"container,omitempty"`
+
+	// Journal is the input tuning spec for node sources which filters the journal entries when they are read
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Journal Input Tuning"
+	Journal *JournalInputTuningSpec `json:"journal,omitempty"`
+}
+
+// JournalPriority is the priority of a journal entry as defined by syslog
+//
+// +kubebuilder:validation:Enum:=emerg;alert;crit;err;warning;notice;info;debug
+type JournalPriority string
+
+const (
+	JournalPriorityEmergency JournalPriority = "emerg"
+	JournalPriorityAlert     JournalPriority = "alert"
+	JournalPriorityCritical  JournalPriority = "crit"
+	JournalPriorityError     JournalPriority = "err"
+	JournalPriorityWarning   JournalPriority = "warning"
+	JournalPriorityNotice    JournalPriority = "notice"
+	JournalPriorityInfo      JournalPriority = "info"
+	JournalPriorityDebug     JournalPriority = "debug"
+)
+
+var (
+	// JournalPriorities are the journal priorities ordered by their numeric value, from the most to the least severe
+	JournalPriorities = []JournalPriority{
+		JournalPriorityEmergency,
+		JournalPriorityAlert,
+		JournalPriorityCritical,
+		JournalPriorityError,
+		JournalPriorityWarning,
+		JournalPriorityNotice,
+		JournalPriorityInfo,
+		JournalPriorityDebug,
+	}
+)
+
+// JournalSince defines the first journal entries read when the collector starts without a checkpoint
+//
+// +kubebuilder:validation:Enum:=boot;now
+type JournalSince string
+
+const (
+	// JournalSinceBoot reads the journal entries since the node was booted
+	JournalSinceBoot JournalSince = "boot"
+
+	// JournalSinceNow reads only the journal entries written after the collector started
+	JournalSinceNow JournalSince = "now"
+)
+
+// JournalMatch matches journal entries by the values of a journal field
+type JournalMatch struct {
+	// Field is the name of the journal field (e.g. _TRANSPORT, SYSLOG_IDENTIFIER)
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:="^[A-Z0-9_]+$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Field"
+	Field string `json:"field"`
+
+	// Values is the list of values of the field to match. A journal entry matches when the field has any of the values
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Values"
+	Values []string `json:"values"`
+}
+
+// JournalInputTuningSpec filters the entries read from the journal of the nodes
+type JournalInputTuningSpec struct {
+
+	// IncludeUnits is the list of systemd units to collect. All units are collected when omitted.
+	// Unit names without a type suffix are presumed to be services (e.g. kubelet is kubelet.service)
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Include Units"
+	IncludeUnits []string `json:"includeUnits,omitempty"`
+
+	// ExcludeUnits is the list of systemd units not to collect. Exclusions take precedence over inclusions
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Exclude Units"
+	ExcludeUnits []string `json:"excludeUnits,omitempty"`
+
+	// Priority is the minimum priority of the collected entries. Entries with a lower priority (e.g. debug when
+	// the priority is info) are not collected. All entries are collected when omitted
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Minimum Priority"
+	Priority *JournalPriority `json:"priority,omitempty"`
+
+	// IncludeMatches is the list of journal field values an entry must match to be collected.
+	// An entry is collected when it matches any value of every field, the minimum priority and the included units.
+	// Unlike the units, the matches are applied to the entries after they are read from the journal, as is the priority
+	// unless no units are included and no fields are matched
+	//
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=field
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Include Matches"
+	IncludeMatches []JournalMatch `json:"includeMatches,omitempty"`
+
+	// Since defines the first entries read when the collector starts on a node without a checkpoint:
+	// `boot` reads the entries since the node was booted and `now` reads only the new entries. The default is `boot`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=boot
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Since"
+	Since JournalSince `json:"since,omitempty"`
 }
 
 // Infrastructure enables infrastructure logs.
 // Sources of these logs:
 // * container workloads deployed to namespaces: default, kube*, openshift*
 // * journald logs from cluster nodes
+// * log files written to the nodes by node agents
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.sources) || !self.sources.exists(s, s == 'file') || has(self.files)",message="files must be specified when the file source is collected"
 type Infrastructure struct {
 	// Sources defines the list of infrastructure sources to collect.
 	// This field is optional and omission results in the collection of all infrastructure sources.
@@ -231,6 +410,133 @@ type Infrastructure struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Container Input Tuning"
 	Tuning *InfrastructureInputTuningSpec `json:"tuning,omitempty"`
+
+	// Files defines the log files of the node collected by the `file` source
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Node Files"
+	Files *NodeFiles `json:"files,omitempty"`
+}
+
+// NodeFiles defines the log files of the node to collect
+type NodeFiles struct {
+	// Includes is a list of glob patterns of the files to collect.
+	// Each pattern must be an absolute path in a subdirectory of /var/log (e.g. /var/log/myapp/*.log) that
+	// is not collected by another source (e.g. /var/log/pods, /var/log/journal, /var/log/audit).
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Include Globs"
+	Includes []string `json:"includes"`
+
+	// Excludes is a list of glob patterns of the files matched by the includes not to collect
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Exclude Globs"
+	Excludes []string `json:"excludes,omitempty"`
+
+	// Multiline aggregates the lines of a file into a single log record
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Multiline"
+	Multiline *NodeFileMultiline `json:"multiline,omitempty"`
+
+	// Fingerprint defines how the collector identifies a file to track its read position across renames and rotations
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Fingerprint"
+	Fingerprint *NodeFileFingerprint `json:"fingerprint,omitempty"`
+}
+
+// NodeFileMultilineMode defines how the condition pattern ends a multiline record
+//
+// +kubebuilder:validation:Enum:=continueThrough;continuePast;haltBefore;haltWith
+type NodeFileMultilineMode string
+
+const (
+	// NodeFileMultilineModeContinueThrough appends the consecutive lines matching the condition pattern (e.g. indented stack frames)
+	NodeFileMultilineModeContinueThrough NodeFileMultilineMode = "continueThrough"
+
+	// NodeFileMultilineModeContinuePast appends the consecutive lines matching the condition pattern plus one
+	// additional line (e.g. lines continued with a trailing backslash)
+	NodeFileMultilineModeContinuePast NodeFileMultilineMode = "continuePast"
+
+	// NodeFileMultilineModeHaltBefore appends the consecutive lines not matching the condition pattern
+	NodeFileMultilineModeHaltBefore NodeFileMultilineMode = "haltBefore"
+
+	// NodeFileMultilineModeHaltWith appends the lines up to and including the first line matching the condition pattern
+	NodeFileMultilineModeHaltWith NodeFileMultilineMode = "haltWith"
+)
+
+// NodeFileMultiline defines how lines of a file are aggregated into a single log record
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.timeout) || duration(self.timeout) >= duration('1ms')",message="timeout must be at least 1 millisecond"
+type NodeFileMultiline struct {
+	// StartPattern is a regular expression matching the first line of a record
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Start Pattern"
+	StartPattern string `json:"startPattern"`
+
+	// ConditionPattern is a regular expression matched against the following lines, as defined by the mode
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Condition Pattern"
+	ConditionPattern string `json:"conditionPattern"`
+
+	// Mode defines how the condition pattern ends a record. The default is `continueThrough`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=continueThrough
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Mode"
+	Mode NodeFileMultilineMode `json:"mode,omitempty"`
+
+	// Timeout is the maximum time to wait for the next line before a record is flushed. The default is 1s
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Timeout"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// NodeFileFingerprintStrategy defines how a file is identified
+//
+// +kubebuilder:validation:Enum:=checksum;deviceAndInode
+type NodeFileFingerprintStrategy string
+
+const (
+	// NodeFileFingerprintStrategyChecksum identifies a file by the checksum of its first lines
+	NodeFileFingerprintStrategyChecksum NodeFileFingerprintStrategy = "checksum"
+
+	// NodeFileFingerprintStrategyDeviceAndInode identifies a file by its device and inode
+	NodeFileFingerprintStrategyDeviceAndInode NodeFileFingerprintStrategy = "deviceAndInode"
+)
+
+// NodeFileFingerprint defines how the collector identifies a file
+type NodeFileFingerprint struct {
+	// Strategy is the method used to identify a file. The default is `checksum`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=checksum
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Strategy"
+	Strategy NodeFileFingerprintStrategy `json:"strategy,omitempty"`
+
+	// Lines is the number of lines of a file used to compute the checksum.
+	// Increase it for files which start with the same header. Only used by the `checksum` strategy
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Lines"
+	Lines *int64 `json:"lines,omitempty"`
+
+	// IgnoredHeaderBytes is the number of bytes skipped at the start of a file before the checksum is computed.
+	// Only used by the `checksum` strategy
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=0
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Ignored Header Bytes"
+	IgnoredHeaderBytes *int64 `json:"ignoredHeaderBytes,omitempty"`
 }
 
 // AuditSource defines which type of audit log source is used.
@@ -298,33 +604,48 @@ type Audit struct {
 
 // ReceiverType specifies the type of receiver that should be created.
 //
-// +kubebuilder:validation:Enum:=http;syslog
+// +kubebuilder:validation:Enum:=http;syslog;otlp;fluentForward;splunkHEC
 type ReceiverType string
 
 const (
-	ReceiverTypeHTTP   ReceiverType = "http"
-	ReceiverTypeSyslog ReceiverType = "syslog"
+	ReceiverTypeHTTP          ReceiverType = "http"
+	ReceiverTypeSyslog        ReceiverType = "syslog"
+	ReceiverTypeOTLP          ReceiverType = "otlp"
+	ReceiverTypeFluentForward ReceiverType = "fluentForward"
+	ReceiverTypeSplunkHEC     ReceiverType = "splunkHEC"
 )
 
 var (
 	ReceiverTypes = []ReceiverType{
 		ReceiverTypeHTTP,
 		ReceiverTypeSyslog,
+		ReceiverTypeOTLP,
+		ReceiverTypeFluentForward,
+		ReceiverTypeSplunkHEC,
 	}
 )
 
 type InputTLSSpec TLSSpec
 
 // ReceiverSpec is a union of input Receiver types.
+//
+// +kubebuilder:validation:XValidation:rule="self.type != 'otlp' || (has(self.otlp) && self.otlp.grpcPort != self.port)",message="otlp receivers require an otlp spec with a grpcPort different from port"
+// +kubebuilder:validation:XValidation:rule="self.type != 'splunkHEC' || has(self.splunkHEC)",message="splunkHEC receivers require a splunkHEC spec"
 type ReceiverSpec struct {
 	// Type of Receiver plugin.
 	//
 	// Supported Receiver types are:
 	//
 	// 1. http
-	//    - Currently only supports kubernetes audit logs (log_type = "audit")
+	//    - Supports kubernetes audit logs (log_type = "audit") and json, ndjson and otlp application logs
 	// 2. syslog
 	//    - Currently only supports node infrastructure logs (log_type = "infrastructure")
+	// 3. otlp
+	//    - Supports OpenTelemetry logs sent over OTLP/gRPC and OTLP/HTTP (log_type = "application" by default)
+	// 4. fluentForward
+	//    - Supports logs sent by Fluent Bit and Fluentd clients using the forward protocol (log_type = "application" by default)
+	// 5. splunkHEC
+	//    - Supports logs sent by Splunk HTTP Event Collector clients (log_type = "application" by default)
 	//
 	// +kubebuilder:validation:Required
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Receiver Type"
@@ -342,6 +663,8 @@ type ReceiverSpec struct {
 
 	// Port the Receiver listens on. It must be a value between 1024 and 65535
 	//
+	// Port is the OTLP/HTTP port of otlp receivers
+	//
 	// +kubebuilder:validation:Minimum:=1024
 	// +kubebuilder:validation:Maximum:=65535
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Listen Port",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
@@ -350,24 +673,327 @@ type ReceiverSpec struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="HTTP Receiver Configuration"
 	HTTP *HTTPReceiver `json:"http,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="OTLP Receiver Configuration"
+	OTLP *OTLPReceiver `json:"otlp,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Fluent Forward Receiver Configuration"
+	FluentForward *FluentForwardReceiver `json:"fluentForward,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Splunk HEC Receiver Configuration"
+	SplunkHEC *SplunkHECReceiver `json:"splunkHEC,omitempty"`
 }
 
 // HTTPReceiverFormat defines the type of log data incoming through the HTTP receiver.
 //
-// +kubebuilder:validation:Enum:=kubeAPIAudit
+// +kubebuilder:validation:Enum:=kubeAPIAudit;json;ndjson;otlp
 type HTTPReceiverFormat string
 
 const (
 	HTTPReceiverFormatKubeAPIAudit HTTPReceiverFormat = "kubeAPIAudit"
+	HTTPReceiverFormatJSON         HTTPReceiverFormat = "json"
+	HTTPReceiverFormatNDJSON       HTTPReceiverFormat = "ndjson"
+	HTTPReceiverFormatOTLP         HTTPReceiverFormat = "otlp"
 )
 
 // HTTPReceiver receives encoded logs as a HTTP endpoint.
+//
+// +kubebuilder:validation:XValidation:rule="self.format != 'kubeAPIAudit' || (!has(self.logType) && !has(self.namespace) && !has(self.app))",message="logType, namespace and app are only supported by the json, ndjson and otlp formats"
 type HTTPReceiver struct {
 	// Format is the format of incoming log data.
 	//
-	// The only currently supported format is `kubeAPIAudit`.
+	// Supported formats are:
+	//
+	// 1. kubeAPIAudit
+	//    - Kubernetes API audit events (log_type = "audit")
+	// 2. json
+	//    - A JSON object or an array of JSON objects per request
+	// 3. ndjson
+	//    - Newline delimited JSON objects
+	// 4. otlp
+	//    - OTLP/HTTP logs encoded as JSON posted to `/v1/logs`. Requests encoded as protobuf are rejected.
+	//
+	// The `message`, `timestamp` and `level` fields of json and ndjson records are used as the message, timestamp and
+	// level of the log entry. The timestamp must be an RFC 3339 string, otherwise the time the record is received is
+	// used. The remaining fields are kept as structured content.
 	//
 	// +kubebuilder:validation:Required
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Data Format"
 	Format HTTPReceiverFormat `json:"format"`
+
+	// LogType is the log_type of records received in the json, ndjson or otlp format.
+	//
+	// Defaults to `application`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum:=application;infrastructure
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Type"
+	LogType string `json:"logType,omitempty"`
+
+	// Namespace identifies the source of the namespace of records received in the json, ndjson or otlp format.
+	//
+	// The namespace is read from the `k8s.namespace.name` resource attribute of otlp records when not specified.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Namespace Attribution"
+	Namespace *HTTPReceiverAttribution `json:"namespace,omitempty"`
+
+	// App identifies the source of the application name of records received in the json, ndjson or otlp format.
+	//
+	// The name is set as the `app.kubernetes.io/name` label of the record and is read from the `service.name` resource
+	// attribute of otlp records when not specified.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Application Attribution"
+	App *HTTPReceiverAttribution `json:"app,omitempty"`
+
+	// Authentication requires senders to present a bearer token.
+	//
+	// Requests are not authenticated when not specified.
+	//
+	// +kubebuilder:validation:Optional
+	// +nullable
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Authentication"
+	Authentication *HTTPReceiverAuthentication `json:"authentication,omitempty"`
+}
+
+// HTTPReceiverAttribution identifies where a value is read from for records received by an HTTP receiver
+//
+// +kubebuilder:validation:XValidation:rule="has(self.header) != has(self.field)",message="exactly one of header or field must be specified"
+type HTTPReceiverAttribution struct {
+	// Header is the name of the request header holding the value
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^[A-Za-z0-9-]+$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Header",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Header string `json:"header,omitempty"`
+
+	// Field is the path to the field of the record holding the value
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Field",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Field FieldPath `json:"field,omitempty"`
+}
+
+// HTTPReceiverAuthentication authenticates the senders of an HTTP receiver
+type HTTPReceiverAuthentication struct {
+	// Token is the bearer token senders must present in the `Authorization` header of requests
+	// (e.g. `Authorization: Bearer <token>`). The scheme is matched case-insensitively.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Bearer Token"
+	Token *SecretReference `json:"token"`
+}
+
+// OTLPReceiver receives logs using the OpenTelemetry Protocol over gRPC and HTTP.
+//
+// Resource attributes of the logs are mapped to the ViaQ data model. Logs forwarded to an `otlp` output keep their
+// original resource, scope and log record. The labels added by an `openshiftLabels` filter are added to the resource,
+// and the log record attributes, body and severity removed by a `prune` filter are removed from the log record.
+type OTLPReceiver struct {
+	// GRPCPort the Receiver listens on for OTLP/gRPC requests. It must be a value between 1024 and 65535
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum:=1024
+	// +kubebuilder:validation:Maximum:=65535
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="gRPC Listen Port",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	GRPCPort int32 `json:"grpcPort"`
+
+	// LogType is the log_type of received records.
+	//
+	// Defaults to `application`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum:=application;infrastructure
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Type"
+	LogType string `json:"logType,omitempty"`
+}
+
+// FluentForwardReceiver receives logs from Fluent Bit and Fluentd clients using the forward protocol.
+//
+// The `message` or `log` field of a record is used as the message of the log entry. The remaining fields are kept as
+// structured content.
+//
+// Clients authenticate with the shared key handshake of the forward protocol when `sharedKey` is defined. When
+// `tls.ca` is defined, clients must also present a certificate signed by it. The serving certificate is requested
+// from the cluster's cert signing service when `tls.certificate` and `tls.key` are not defined.
+type FluentForwardReceiver struct {
+	// LogType is the log_type of received records.
+	//
+	// Defaults to `application`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum:=application;infrastructure
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Type"
+	LogType string `json:"logType,omitempty"`
+
+	// TagPattern is a regular expression matched against the fluent tag of records.
+	//
+	// The named capture groups `namespace`, `pod`, `container` and `app` set the namespace, pod name, container name
+	// and `app.kubernetes.io/name` label of the record. Other named capture groups are added to the structured content.
+	// The tag is always available in the `fluent_tag` field of the structured content.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tag Pattern",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	TagPattern string `json:"tagPattern,omitempty"`
+
+	// SharedKey is the key clients must present in the shared key handshake of the forward protocol
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Shared Key"
+	SharedKey *SecretReference `json:"sharedKey,omitempty"`
+}
+
+// SplunkHECReceiver receives logs from clients using the Splunk HTTP Event Collector (HEC) protocol.
+//
+// The `event` of a request is used as the message of the log entry when it is a string, the fields of the event are
+// kept as structured content otherwise. The `index`, `source` and `sourcetype` of events are preserved by `splunk`
+// outputs that do not specify their own.
+type SplunkHECReceiver struct {
+	// Tokens are the HEC tokens senders may present in the `Authorization` header of requests
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="HEC Tokens"
+	Tokens []*SecretReference `json:"tokens"`
+
+	// Acknowledgements enables the indexer acknowledgement endpoint of the HEC protocol.
+	//
+	// Senders can query the endpoint to verify events were delivered to the outputs of the receiver.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Indexer Acknowledgements",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	Acknowledgements bool `json:"acknowledgements,omitempty"`
+
+	// LogType is the log_type of received records.
+	//
+	// Defaults to `application`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum:=application;infrastructure
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Type"
+	LogType string `json:"logType,omitempty"`
+}
+
+// KafkaInputDecoding defines the format of the messages consumed by a Kafka input.
+type KafkaInputDecoding string
+
+const (
+	KafkaInputDecodingJSON KafkaInputDecoding = "json"
+	KafkaInputDecodingText KafkaInputDecoding = "text"
+)
+
+// KafkaInput consumes logs from the topics of a Kafka cluster as a member of a consumer group.
+//
+// The offsets of consumed messages are committed once they are accepted by the outputs of the input. Outputs with
+// the `AtLeastOnce` delivery mode only accept messages once they are written to their buffer, so messages are
+// consumed again after a restart of the collector unless they were delivered.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.topics) != has(self.topicPattern)",message="exactly one of topics or topicPattern must be specified"
+type KafkaInput struct {
+	// Brokers specifies the list of broker endpoints of the Kafka cluster.
+	//
+	// The list represents only the initial set used by the collector's Kafka client for the
+	// first connection. It must contain valid URLs with a 'tcp' or 'tls' scheme and include a port number.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kafka Brokers"
+	Brokers []BrokerURL `json:"brokers"`
+
+	// Topics are the names of the topics to consume logs from.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self.all(t, !t.startsWith('^'))",message="topics must not be regular expressions, use topicPattern instead"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kafka Topics"
+	Topics []string `json:"topics,omitempty"`
+
+	// TopicPattern is a regular expression matching the names of the topics to consume logs from.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Kafka Topic Pattern",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	TopicPattern string `json:"topicPattern,omitempty"`
+
+	// GroupID is the consumer group the collector joins to consume the topics.
+	//
+	// Defaults to `<clusterlogforwarder.namespace>-<clusterlogforwarder.name>-<input.name>`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Consumer Group",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	GroupID string `json:"groupID,omitempty"`
+
+	// Decoding is the format of the consumed messages.
+	//
+	// Supported formats are:
+	//
+	// 1. text
+	//    - The message is used as the message of the log entry
+	// 2. json
+	//    - The `message`, `timestamp` and `level` fields of the message are used as the message, timestamp and
+	//      level of the log entry. The remaining fields are kept as structured content.
+	//
+	// Defaults to `text`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum:=json;text
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Decoding"
+	Decoding KafkaInputDecoding `json:"decoding,omitempty"`
+
+	// LogType is the log_type of consumed records.
+	//
+	// Defaults to `application`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum:=application;infrastructure
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Type"
+	LogType string `json:"logType,omitempty"`
+
+	// Authentication sets credentials for authenticating to the brokers.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Authentication Options"
+	Authentication *KafkaAuthentication `json:"authentication,omitempty"`
+
+	// TLS contains settings for controlling options on TLS client connections to the brokers.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="TLS Options"
+	TLS *OutputTLSSpec `json:"tls,omitempty"`
+}
+
+// EventType is the type of a cluster event.
+type EventType string
+
+const (
+	// EventTypeNormal are events reporting the normal operation of a resource.
+	EventTypeNormal EventType = "Normal"
+
+	// EventTypeWarning are events reporting a problem with a resource, like a scheduling failure or an OOM kill.
+	EventTypeWarning EventType = "Warning"
+)
+
+// EventsInput collects the `events.k8s.io/v1` events of all namespaces from the API server.
+//
+// Events are collected by a single collector replica to avoid forwarding them more than once. A forwarder
+// with an events input is deployed as a deployment of exactly one replica, which is recreated instead of rolled so
+// two replicas never run at once, and may only additionally spec receiver or kafka inputs. The forwarder is invalid
+// when the collector deployment specs more replicas or autoscaling.
+// Its service account must be permitted to list and watch events (e.g. `collect-events` cluster role).
+//
+// Events are watched by consecutive watches of 30 seconds and are forwarded each time they are created or updated.
+// Each watch starts with the events which already exist, of which only those created during the last minute are
+// forwarded unless they were already forwarded. Updates made between two watches to events created earlier are
+// missed, as are events created or updated more than a minute before the collector starts.
+type EventsInput struct {
+	// Types of the events to collect.
+	//
+	// Defaults to all types
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:items:Enum:=Normal;Warning
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Event Types"
+	Types []EventType `json:"types,omitempty"`
 }
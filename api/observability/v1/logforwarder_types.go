@@ -0,0 +1,120 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LogForwarderSpec defines the desired state of LogForwarder
+type LogForwarderSpec struct {
+	// Selector selects the pods of the namespace whose container logs are forwarded by their labels.
+	// Selects all pods of the namespace when not set.
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pod Selector"
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Outputs are named destinations for log messages.
+	//
+	// Secrets are resolved in the namespace of the LogForwarder. ConfigMaps and the token of the collector
+	// service account can not be referenced.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +listType:=map
+	// +listMapKey:=name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Forwarder Outputs"
+	Outputs []OutputSpec `json:"outputs"`
+
+	// Filters are applied to log records passing through a pipeline.
+	//
+	// +kubebuilder:validation:Optional
+	// +listType:=map
+	// +listMapKey:=name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Forwarder Pipeline Filters"
+	Filters []FilterSpec `json:"filters,omitempty"`
+
+	// Pipelines forward the container logs of the namespace to a set of outputs.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	// +listType:=map
+	// +listMapKey:=name
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Forwarder Pipelines"
+	Pipelines []LogForwarderPipelineSpec `json:"pipelines"`
+}
+
+// LogForwarderPipelineSpec forwards the container logs of the namespace to a set of outputs
+type LogForwarderPipelineSpec struct {
+	// Name of the pipeline
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:="^[a-z][a-z0-9-]{2,62}[a-z0-9]$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pipeline Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Name string `json:"name"`
+
+	// OutputRefs lists the names (`output.name`) of outputs from this pipeline.
+	//
+	// +kubebuilder:validation:MinItems:=1
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Outputs",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	OutputRefs []string `json:"outputRefs"`
+
+	// Filters lists the names of filters to be applied to records going through this pipeline.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Filters",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	FilterRefs []string `json:"filterRefs,omitempty"`
+}
+
+// LogForwarderStatus defines the observed state of LogForwarder
+type LogForwarderStatus struct {
+	// Conditions of the log forwarder.
+	//
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Forwarder Conditions",xDescriptors={"urn:alm:descriptor:io.kubernetes.conditions"}
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// LogForwarder is an API for the users of a namespace to forward the container logs of the namespace.
+//
+// The LogForwarder is served by the ClusterLogForwarder whose tenancy selects the namespace. Its outputs
+// are added to the config of the collector of the ClusterLogForwarder.
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=observability,shortName=obslf
+// +kubebuilder:validation:XValidation:rule="self.metadata.name.matches('^[a-z][a-z0-9-]{1,61}[a-z0-9]$')",message="Name must be a valid DNS1035 label"
+type LogForwarder struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LogForwarderSpec   `json:"spec,omitempty"`
+	Status LogForwarderStatus `json:"status,omitempty"`
+}
+
+// LogForwarderList contains a list of LogForwarder
+//
+// +kubebuilder:object:root=true
+type LogForwarderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LogForwarder `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LogForwarder{}, &LogForwarderList{})
+}
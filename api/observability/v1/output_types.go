@@ -23,7 +23,7 @@ import (
 
 // OutputType is used to define the type of output to be created.
 //
-// +kubebuilder:validation:Enum:=azureLogsIngestion;azureMonitor;cloudwatch;elasticsearch;http;kafka;loki;lokiStack;googleCloudLogging;s3;splunk;syslog;otlp
+// +kubebuilder:validation:Enum:=azureLogsIngestion;azureMonitor;cloudwatch;datadog;elasticsearch;http;kafka;loki;lokiStack;googleCloudLogging;newRelic;s3;socket;splunk;syslog;otlp
 type OutputType string
 
 func (s OutputType) String() string {
@@ -35,14 +35,17 @@ const (
 	OutputTypeAzureLogsIngestion OutputType = "azureLogsIngestion"
 	OutputTypeAzureMonitor       OutputType = "azureMonitor"
 	OutputTypeCloudwatch         OutputType = "cloudwatch"
+	OutputTypeDatadog            OutputType = "datadog"
 	OutputTypeElasticsearch      OutputType = "elasticsearch"
 	OutputTypeGoogleCloudLogging OutputType = "googleCloudLogging"
 	OutputTypeHTTP               OutputType = "http"
 	OutputTypeKafka              OutputType = "kafka"
 	OutputTypeLoki               OutputType = "loki"
 	OutputTypeLokiStack          OutputType = "lokiStack"
+	OutputTypeNewRelic           OutputType = "newRelic"
 	OutputTypeOTLP               OutputType = "otlp"
 	OutputTypeS3                 OutputType = "s3"
+	OutputTypeSocket             OutputType = "socket"
 	OutputTypeSplunk             OutputType = "splunk"
 	OutputTypeSyslog             OutputType = "syslog"
 )
@@ -53,13 +56,16 @@ var (
 		OutputTypeAzureLogsIngestion,
 		OutputTypeAzureMonitor,
 		OutputTypeCloudwatch,
+		OutputTypeDatadog,
 		OutputTypeElasticsearch,
 		OutputTypeGoogleCloudLogging,
 		OutputTypeHTTP,
 		OutputTypeKafka,
 		OutputTypeLoki,
 		OutputTypeLokiStack,
+		OutputTypeNewRelic,
 		OutputTypeS3,
+		OutputTypeSocket,
 		OutputTypeSplunk,
 		OutputTypeSyslog,
 		OutputTypeOTLP,
@@ -71,13 +77,16 @@ var (
 // +kubebuilder:validation:XValidation:rule="self.type != 'azureLogsIngestion' || has(self.azureLogsIngestion)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'azureMonitor' || has(self.azureMonitor)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'cloudwatch' || has(self.cloudwatch)", message="Additional type specific spec is required for the output type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'datadog' || has(self.datadog)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'elasticsearch' || has(self.elasticsearch)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'googleCloudLogging' || has(self.googleCloudLogging)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'http' || has(self.http)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'kafka' || has(self.kafka)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'loki' || has(self.loki)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'lokiStack' || has(self.lokiStack)", message="Additional type specific spec is required for the output type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'newRelic' || has(self.newRelic)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 's3' || has(self.s3)", message="Additional type specific spec is required for the output type"
+// +kubebuilder:validation:XValidation:rule="self.type != 'socket' || has(self.socket)", message="Additional type specific spec is required for the output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'splunk' || has(self.splunk)", message="Additional type specific spec is required the for output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'syslog' || has(self.syslog)", message="Additional type specific spec is required the for output type"
 // +kubebuilder:validation:XValidation:rule="self.type != 'otlp' || has(self.otlp)", message="Additional type specific spec is required the for output type"
@@ -109,6 +118,18 @@ type OutputSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rate Limiting"
 	Limit *LimitSpec `json:"rateLimit,omitempty"`
 
+	// PreDeliveryRejectionsOutputRef is the name of an output that receives the records this output rejects
+	// before delivery instead of dropping them.
+	//
+	// A record is rejected before delivery when it does not have a `log_type` or when its JSON encoding exceeds
+	// the `maxWrite` tuning of this output. Records rejected by the remote endpoint, e.g. with a 4xx response or a
+	// mapping conflict, are not forwarded to this output. Rejected records are annotated with the rejecting output
+	// and the reason under the `.dead_letter` field.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Pre-delivery Rejections Output"
+	PreDeliveryRejectionsOutputRef string `json:"preDeliveryRejectionsOutputRef,omitempty"`
+
 	// AzureLogsIngestion configures forwarding log events to the Azure Monitor Logs Ingestion API
 	//
 	// +kubebuilder:validation:Optional
@@ -128,6 +149,12 @@ type OutputSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Amazon CloudWatch"
 	Cloudwatch *Cloudwatch `json:"cloudwatch,omitempty"`
 
+	// Datadog configures forwarding log events to the Datadog logs intake
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Datadog"
+	Datadog *Datadog `json:"datadog,omitempty"`
+
 	// Elasticsearch configures forwarding log events to an Elasticsearch cluster
 	//
 	// +kubebuilder:validation:Optional
@@ -172,12 +199,24 @@ type OutputSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="LokiStack"
 	LokiStack *LokiStack `json:"lokiStack,omitempty"`
 
+	// NewRelic configures forwarding log events to the New Relic Log API
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="New Relic"
+	NewRelic *NewRelic `json:"newRelic,omitempty"`
+
 	// S3 configures forwarding log events to Amazon S3 buckets
 	//
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Amazon S3"
 	S3 *S3 `json:"s3,omitempty"`
 
+	// Socket configures forwarding log events to a raw TCP, UDP or Unix socket destination
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Socket Output"
+	Socket *Socket `json:"socket,omitempty"`
+
 	// Splunk configures forwarding log events to Splunk's HTTP event collector
 	//
 	// +kubebuilder:validation:Optional
@@ -664,6 +703,117 @@ type AwsAssumeRole struct {
 	SessionName string `json:"sessionName,omitempty"`
 }
 
+type DatadogTuningSpec struct {
+	BaseOutputTuningSpec `json:",inline"`
+
+	// Compression causes data to be compressed before sending over the network.
+	//
+	// Valid values are: none, gzip, zlib.
+	//
+	// +kubebuilder:validation:Enum:=none;gzip;zlib
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Compression"
+	Compression string `json:"compression,omitempty"`
+}
+
+// DatadogAuthentication contains configuration for authenticating requests to a Datadog output.
+type DatadogAuthentication struct {
+	// APIKey points to the secret containing the Datadog API key used for authenticating requests.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Datadog API Key"
+	APIKey *SecretReference `json:"apiKey"`
+}
+
+// DatadogTag maps the value of a log record field to a Datadog tag
+type DatadogTag struct {
+	// Name of the tag.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^[a-zA-Z][a-zA-Z0-9_\-./]*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tag Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Name string `json:"name"`
+
+	// Field is the path to the log record field used as the tag value. The tag is omitted when the field does not exist.
+	//
+	// Field paths must only contain alphanumeric and underscores. Any field with other characters must be quoted.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Field"
+	Field FieldPath `json:"field"`
+}
+
+// Datadog configures forwarding log events to the Datadog logs intake
+type Datadog struct {
+	// Authentication sets credentials for authenticating the requests.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Authentication Options"
+	Authentication *DatadogAuthentication `json:"authentication"`
+
+	// Tuning specs tuning for the output
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tuning Options"
+	Tuning *DatadogTuningSpec `json:"tuning,omitempty"`
+
+	// Site is the Datadog site the logs are sent to. If not set, `datadoghq.com` is used.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)+$`
+	// +kubebuilder:example:=`datadoghq.eu`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Datadog Site",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Site string `json:"site,omitempty"`
+
+	// URL overrides the logs intake endpoint derived from the site, for example to send through a proxy.
+	//
+	// The 'username@password' part of `url` is ignored.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == '' ||  isURL(self)", message="invalid URL"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Destination URL",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	URL string `json:"url,omitempty"`
+
+	// Service is the reserved `service` attribute of a log event. This supports template syntax to allow dynamic per-event values.
+	//
+	// The Service can be a combination of static and dynamic values consisting of field paths followed by `||` followed by another field path or a static value.
+	//
+	// A dynamic value is encased in single curly brackets `{}` and MUST end with a static fallback value separated with `||`.
+	//
+	// Static values can only contain alphanumeric characters along with dashes, underscores, dots, colons and forward slashes.
+	//
+	// If not set, the container name is used for container logs and the log source otherwise.
+	//
+	// Example:
+	//
+	//  1. {.kubernetes.labels."app.kubernetes.io/name"||"unknown"}
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.:\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Service",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Service string `json:"service,omitempty"`
+
+	// Source is the reserved `ddsource` attribute of a log event used by Datadog to select the log integration.
+	// This supports the same template syntax as service.
+	//
+	// If not set, `openshift` is used.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern:=`^(([a-zA-Z0-9-_.:\/])*(\{(\.[a-zA-Z0-9_]+|\."[^"]+")+((\|\|)(\.[a-zA-Z0-9_]+|\.?"[^"]+")+)*\|\|"[^"]*"\})*)*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Source",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Source string `json:"source,omitempty"`
+
+	// Tags maps log record fields to the `ddtags` attribute of a log event.
+	//
+	// Example:
+	//
+	//  1. name: namespace, field: .kubernetes.namespace_name
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tags"
+	Tags []DatadogTag `json:"tags,omitempty"`
+}
+
 type ElasticsearchTuningSpec struct {
 	BaseOutputTuningSpec `json:",inline"`
 
@@ -789,6 +939,49 @@ type GoogleCloudLogging struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Log Stream ID",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
 	LogId string `json:"logId"`
 
+	// Resource is the monitored resource associated with each log entry.
+	//
+	// When not specified, container log entries are associated with a `k8s_container` resource populated from the
+	// cluster, namespace, pod and container of the record and the other entries with a `k8s_node` resource populated
+	// from the cluster and node of the record. The location of both is the region of the cloud platform of the
+	// cluster or `global`.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Monitored Resource"
+	Resource *GoogleCloudLoggingResource `json:"resource,omitempty"`
+
+	// SeverityKey is the path to the field of the record holding the severity of the entry.
+	//
+	// The value is normalized to one of the severities accepted by Google Cloud Logging. Defaults to `.level`
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Severity Key",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	SeverityKey FieldPath `json:"severityKey,omitempty"`
+
+	// Labels are the user-defined labels of each log entry.
+	//
+	// Values can be a combination of static and dynamic values as described for LogId.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxProperties:=64
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Labels"
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// TraceKey is the path to the field of the record holding the trace ID of the entry.
+	//
+	// The ID is prefixed with `projects/<project>/traces/` when the ID type is `project` and the value is not already
+	// a resource name.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Trace Key",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	TraceKey FieldPath `json:"traceKey,omitempty"`
+
+	// SpanIdKey is the path to the field of the record holding the span ID of the entry within the trace.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Span ID Key",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	SpanIdKey FieldPath `json:"spanIdKey,omitempty"`
+
 	// Tuning specs tuning for the output
 	//
 	// +kubebuilder:validation:Optional
@@ -796,6 +989,27 @@ type GoogleCloudLogging struct {
 	Tuning *GoogleCloudLoggingTuningSpec `json:"tuning,omitempty"`
 }
 
+// GoogleCloudLoggingResource is the monitored resource of a log entry.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.labels) || self.labels.all(k, k.matches('^[a-z][a-z0-9_]*$'))",message="label names must be lowercase alphanumeric characters or underscores and start with a letter"
+type GoogleCloudLoggingResource struct {
+	// Type is the monitored resource type (e.g. k8s_container, k8s_node, generic_node)
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^[a-z][a-z0-9_]*$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Resource Type",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Type string `json:"type"`
+
+	// Labels are the labels of the monitored resource.
+	//
+	// Values can be a combination of static and dynamic values as described for LogId.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxProperties:=32
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Resource Labels"
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
 type GoogleCloudLoggingId struct {
 	// Type is the ID type provided
 	// +kubebuilder:validation:Required
@@ -848,7 +1062,137 @@ const (
 	HTTPFormatNDJSON HTTPFormat = "ndjson"
 )
 
+// HTTPCodecType is the encoding of the payload sent to an HTTP endpoint.
+//
+// +kubebuilder:validation:Enum:=json;ndjson;text;csv;protobuf;avro
+type HTTPCodecType string
+
+const (
+	// HTTPCodecTypeJSON encodes the records of a request as a JSON array
+	HTTPCodecTypeJSON HTTPCodecType = "json"
+
+	// HTTPCodecTypeNDJSON encodes each record as JSON, one record per line
+	HTTPCodecTypeNDJSON HTTPCodecType = "ndjson"
+
+	// HTTPCodecTypeText encodes the `.message` field of each record as plain text, one record per line
+	HTTPCodecTypeText HTTPCodecType = "text"
+
+	// HTTPCodecTypeCSV encodes the listed fields of each record as a comma separated line
+	HTTPCodecTypeCSV HTTPCodecType = "csv"
+
+	// HTTPCodecTypeProtobuf encodes each record as a protobuf message described by a descriptor set
+	HTTPCodecTypeProtobuf HTTPCodecType = "protobuf"
+
+	// HTTPCodecTypeAvro encodes each record as an Avro datum of a given schema
+	HTTPCodecTypeAvro HTTPCodecType = "avro"
+)
+
+// TimestampFormat is the format used to encode timestamp fields.
+//
+// +kubebuilder:validation:Enum:=rfc3339;unix;unixMs;unixUs;unixNs;unixFloat
+type TimestampFormat string
+
+const (
+	TimestampFormatRFC3339   TimestampFormat = "rfc3339"
+	TimestampFormatUnix      TimestampFormat = "unix"
+	TimestampFormatUnixMs    TimestampFormat = "unixMs"
+	TimestampFormatUnixUs    TimestampFormat = "unixUs"
+	TimestampFormatUnixNs    TimestampFormat = "unixNs"
+	TimestampFormatUnixFloat TimestampFormat = "unixFloat"
+)
+
+// ProtobufCodec defines the message used to encode records as protobuf
+type ProtobufCodec struct {
+	// Descriptor references the ConfigMap key containing a compiled protobuf descriptor set (e.g. `protoc --descriptor_set_out`).
+	//
+	// Binary descriptor sets should be stored in the `binaryData` of the ConfigMap.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Descriptor Set"
+	Descriptor ConfigMapReference `json:"descriptor"`
+
+	// MessageType is the fully qualified name of the message in the descriptor set used to encode each record.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:example:=`package.LogRecord`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Message Type",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	MessageType string `json:"messageType"`
+}
+
+// AvroCodec defines the schema used to encode records as Avro
+type AvroCodec struct {
+	// Schema references the ConfigMap key containing the Avro schema in JSON.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Schema"
+	Schema ConfigMapReference `json:"schema"`
+}
+
+// HTTPCodec defines the encoding of the payload sent to an HTTP endpoint
+//
+// +kubebuilder:validation:XValidation:rule="self.type != 'csv' || (has(self.csvFields) && size(self.csvFields) > 0)", message="csvFields are required when type is csv"
+// +kubebuilder:validation:XValidation:rule="self.type != 'protobuf' || has(self.protobuf)", message="protobuf is required when type is protobuf"
+// +kubebuilder:validation:XValidation:rule="self.type != 'avro' || has(self.avro)", message="avro is required when type is avro"
+type HTTPCodec struct {
+	// Type of encoding for the payload.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Codec Type"
+	Type HTTPCodecType `json:"type"`
+
+	// CSVFields is the ordered list of field paths written as columns when type is `csv`.
+	//
+	// Field paths must only contain alphanumeric and underscores. Any field with other characters must be quoted.
+	//
+	// Example:
+	//
+	//  1. .kubernetes.namespace_name
+	//
+	//  2. .message
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="CSV Fields"
+	CSVFields []FieldPath `json:"csvFields,omitempty"`
+
+	// Protobuf defines the message used when type is `protobuf`.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Protobuf Options"
+	Protobuf *ProtobufCodec `json:"protobuf,omitempty"`
+
+	// Avro defines the schema used when type is `avro`.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Avro Options"
+	Avro *AvroCodec `json:"avro,omitempty"`
+
+	// OnlyFields is the list of field paths to keep in each record before it is encoded. All other fields are removed.
+	//
+	// Field paths must only contain alphanumeric and underscores. Any field with other characters must be quoted.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Only Fields"
+	OnlyFields []FieldPath `json:"onlyFields,omitempty"`
+
+	// ExceptFields is the list of field paths to remove from each record before it is encoded.
+	//
+	// Field paths must only contain alphanumeric and underscores. Any field with other characters must be quoted.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Except Fields"
+	ExceptFields []FieldPath `json:"exceptFields,omitempty"`
+
+	// TimestampFormat is the format used to encode timestamp fields. If not set, rfc3339 is used.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Timestamp Format"
+	TimestampFormat TimestampFormat `json:"timestampFormat,omitempty"`
+}
+
 // HTTP provided configuration for sending json encoded logs to a generic HTTP endpoint.
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.format) || !has(self.codec)", message="Only one of format and codec can be set"
 type HTTP struct {
 	URLSpec `json:",inline"`
 
@@ -895,6 +1239,13 @@ type HTTP struct {
 	// +kubebuilder:validation:Optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Format"
 	Format HTTPFormat `json:"format,omitempty"`
+
+	// Codec defines the encoding of the payload sent to the endpoint. If not set, records are sent as a JSON array
+	// or as defined by format.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Codec"
+	Codec *HTTPCodec `json:"codec,omitempty"`
 }
 
 type KafkaTuningSpec struct {
@@ -916,7 +1267,7 @@ type KafkaTuningSpec struct {
 	Compression string `json:"compression,omitempty"`
 }
 
-// KafkaAuthentication contains configuration for authenticating requests to a Kafka output.
+// KafkaAuthentication contains configuration for authenticating requests to the brokers of a Kafka output or input.
 type KafkaAuthentication struct {
 	// SASL contains options configuring SASL authentication.
 	//
@@ -1261,6 +1612,198 @@ type Loki struct {
 	ProxyURL string `json:"proxyURL,omitempty"`
 }
 
+// NewRelicRegion is the New Relic data center region of an account.
+//
+// +kubebuilder:validation:Enum:=us;eu
+type NewRelicRegion string
+
+const (
+	NewRelicRegionUS NewRelicRegion = "us"
+	NewRelicRegionEU NewRelicRegion = "eu"
+)
+
+type NewRelicTuningSpec struct {
+	BaseOutputTuningSpec `json:",inline"`
+
+	// Compression causes data to be compressed before sending over the network.
+	//
+	// Valid values are: none, gzip.
+	//
+	// +kubebuilder:validation:Enum:=none;gzip
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Compression"
+	Compression string `json:"compression,omitempty"`
+}
+
+// NewRelicAuthentication contains configuration for authenticating requests to a New Relic output.
+type NewRelicAuthentication struct {
+	// LicenseKey points to the secret containing the New Relic license key or insert key used for authenticating requests.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="License Key"
+	LicenseKey *SecretReference `json:"licenseKey"`
+}
+
+// NewRelic configures forwarding log events to the New Relic Log API
+type NewRelic struct {
+	// AccountID is the identifier of the New Relic account receiving the logs.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern:=`^[0-9]+$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Account ID",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	AccountID string `json:"accountID"`
+
+	// Authentication sets credentials for authenticating the requests.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Authentication Options"
+	Authentication *NewRelicAuthentication `json:"authentication"`
+
+	// Region is the data center region of the account. If not set, `us` is used.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Region"
+	Region NewRelicRegion `json:"region,omitempty"`
+
+	// Tuning specs tuning for the output
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tuning Options"
+	Tuning *NewRelicTuningSpec `json:"tuning,omitempty"`
+}
+
+// SocketFramingMethod is the method used to delimit log events in a stream.
+//
+// +kubebuilder:validation:Enum:=newline;lengthDelimited;characterDelimited
+type SocketFramingMethod string
+
+const (
+	// SocketFramingMethodNewline terminates each event with a newline character
+	SocketFramingMethodNewline SocketFramingMethod = "newline"
+
+	// SocketFramingMethodLengthDelimited prefixes each event with its length as a 32-bit big-endian integer
+	SocketFramingMethodLengthDelimited SocketFramingMethod = "lengthDelimited"
+
+	// SocketFramingMethodCharacterDelimited terminates each event with the configured delimiter character
+	SocketFramingMethodCharacterDelimited SocketFramingMethod = "characterDelimited"
+)
+
+// SocketFraming defines how log events are delimited when sent over a stream socket
+//
+// +kubebuilder:validation:XValidation:rule="self.method != 'characterDelimited' || has(self.delimiter)", message="delimiter is required when method is characterDelimited"
+type SocketFraming struct {
+	// Method used to delimit log events.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Framing Method"
+	Method SocketFramingMethod `json:"method"`
+
+	// Delimiter is the single ASCII character terminating each event when method is `characterDelimited`
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Delimiter",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	Delimiter string `json:"delimiter,omitempty"`
+}
+
+// SocketCodecType is the encoding of each log event.
+//
+// +kubebuilder:validation:Enum:=json;text;gelf;csv;rawMessage
+type SocketCodecType string
+
+const (
+	// SocketCodecTypeJSON encodes the entire record as JSON
+	SocketCodecTypeJSON SocketCodecType = "json"
+
+	// SocketCodecTypeText encodes the `.message` field of the record as plain text
+	SocketCodecTypeText SocketCodecType = "text"
+
+	// SocketCodecTypeGELF encodes the record as a Graylog Extended Log Format message
+	SocketCodecTypeGELF SocketCodecType = "gelf"
+
+	// SocketCodecTypeCSV encodes the listed fields of the record as a comma separated line
+	SocketCodecTypeCSV SocketCodecType = "csv"
+
+	// SocketCodecTypeRawMessage sends the `.message` field of the record without any encoding
+	SocketCodecTypeRawMessage SocketCodecType = "rawMessage"
+)
+
+// SocketCodec defines the encoding of log events sent to a socket
+//
+// +kubebuilder:validation:XValidation:rule="self.type != 'csv' || (has(self.csvFields) && size(self.csvFields) > 0)", message="csvFields are required when type is csv"
+type SocketCodec struct {
+	// Type of encoding for each log event.
+	//
+	// +kubebuilder:validation:Required
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Codec Type"
+	Type SocketCodecType `json:"type"`
+
+	// CSVFields is the ordered list of field paths written as columns when type is `csv`.
+	//
+	// Field paths must only contain alphanumeric and underscores. Any field with other characters must be quoted.
+	//
+	// Example:
+	//
+	//  1. .kubernetes.namespace_name
+	//
+	//  2. .message
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="CSV Fields"
+	CSVFields []FieldPath `json:"csvFields,omitempty"`
+}
+
+type SocketTuningSpec struct {
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Delivery Mode"
+	DeliveryMode DeliveryMode `json:"deliveryMode,omitempty"`
+}
+
+// Socket provides configuration for the output type `socket`
+//
+// +kubebuilder:validation:XValidation:rule="!has(self.framing) || !self.url.startsWith('udp:')", message="framing is not supported for udp"
+type Socket struct {
+	// An absolute URL of the destination. The scheme selects the socket mode and must be one of: `tcp`, `tls`, `udp`, `unix`.
+	//
+	// The `unix` scheme sends to a Unix stream socket at the absolute path of the URL on the node. The directory
+	// of the socket is mounted from the node into the collector, so it is only supported when the collector
+	// is deployed as a daemonset without an aggregator.
+	//
+	// Examples:
+	//
+	//  1. tcp://collector.example.com:5170
+	//
+	//  2. udp://graylog.example.com:12201
+	//
+	//  3. unix:///var/run/collector/logs.sock
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^((tcp|tls|udp)://([a-zA-Z0-9\-\.]+|\[[a-fA-F0-9:]+\]):[0-9]+|unix://(/[^/\s]+)+)$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Destination URL",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	URL string `json:"url"`
+
+	// Framing defines how events are delimited on stream sockets. Defaults to `newline`.
+	//
+	// Framing is not supported for `udp` where each event is sent as a single datagram.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Framing"
+	Framing *SocketFraming `json:"framing,omitempty"`
+
+	// Codec defines the encoding of each log event. Defaults to `json`.
+	//
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Codec"
+	Codec *SocketCodec `json:"codec,omitempty"`
+
+	// Tuning specs tuning for the output
+	//
+	// +nullable
+	// +kubebuilder:validation:Optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Tuning Options"
+	Tuning *SocketTuningSpec `json:"tuning,omitempty"`
+}
+
 type SplunkTuningSpec struct {
 	BaseOutputTuningSpec `json:",inline"`
 
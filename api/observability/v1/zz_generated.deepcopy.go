@@ -22,6 +22,11 @@ func (in *Application) DeepCopyInto(out *Application) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tuning != nil {
 		in, out := &in.Tuning, &out.Tuning
 		*out = new(ContainerInputTuningSpec)
@@ -37,6 +42,11 @@ func (in *Application) DeepCopyInto(out *Application) {
 		*out = make([]NamespaceContainerSpec, len(*in))
 		copy(*out, *in)
 	}
+	if in.AllowedPodAnnotations != nil {
+		in, out := &in.AllowedPodAnnotations, &out.AllowedPodAnnotations
+		*out = make([]PodAnnotationKey, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Application.
@@ -94,6 +104,22 @@ func (in *AuditInputTuningSpec) DeepCopy() *AuditInputTuningSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvroCodec) DeepCopyInto(out *AvroCodec) {
+	*out = *in
+	out.Schema = in.Schema
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvroCodec.
+func (in *AvroCodec) DeepCopy() *AvroCodec {
+	if in == nil {
+		return nil
+	}
+	out := new(AvroCodec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AwsAccessKey) DeepCopyInto(out *AwsAccessKey) {
 	*out = *in
@@ -527,6 +553,11 @@ func (in *ClusterLogForwarderSpec) DeepCopyInto(out *ClusterLogForwarderSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Tenancy != nil {
+		in, out := &in.Tenancy, &out.Tenancy
+		*out = new(TenancySpec)
+		(*in).DeepCopyInto(*out)
+	}
 	out.ServiceAccount = in.ServiceAccount
 }
 
@@ -590,6 +621,218 @@ func (in *ClusterLogForwarderStatus) DeepCopy() *ClusterLogForwarderStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectorAggregatorSpec) DeepCopyInto(out *CollectorAggregatorSpec) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Deployment != nil {
+		in, out := &in.Deployment, &out.Deployment
+		*out = new(CollectorDeploymentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorAggregatorSpec.
+func (in *CollectorAggregatorSpec) DeepCopy() *CollectorAggregatorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorAggregatorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectorAutoscalingSpec) DeepCopyInto(out *CollectorAutoscalingSpec) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCPUUtilizationPercentage != nil {
+		in, out := &in.TargetCPUUtilizationPercentage, &out.TargetCPUUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetAverageValue != nil {
+		in, out := &in.TargetAverageValue, &out.TargetAverageValue
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorAutoscalingSpec.
+func (in *CollectorAutoscalingSpec) DeepCopy() *CollectorAutoscalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorAutoscalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectorDeploymentSpec) DeepCopyInto(out *CollectorDeploymentSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(CollectorAutoscalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(CollectorPodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorDeploymentSpec.
+func (in *CollectorDeploymentSpec) DeepCopy() *CollectorDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectorNodeProfile) DeepCopyInto(out *CollectorNodeProfile) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InputRefs != nil {
+		in, out := &in.InputRefs, &out.InputRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorNodeProfile.
+func (in *CollectorNodeProfile) DeepCopy() *CollectorNodeProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorNodeProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectorPodDisruptionBudgetSpec) DeepCopyInto(out *CollectorPodDisruptionBudgetSpec) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorPodDisruptionBudgetSpec.
+func (in *CollectorPodDisruptionBudgetSpec) DeepCopy() *CollectorPodDisruptionBudgetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorPodDisruptionBudgetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectorRolloutSpec) DeepCopyInto(out *CollectorRolloutSpec) {
+	*out = *in
+	if in.CanaryNodeSelector != nil {
+		in, out := &in.CanaryNodeSelector, &out.CanaryNodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CanaryPercentage != nil {
+		in, out := &in.CanaryPercentage, &out.CanaryPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SoakPeriod != nil {
+		in, out := &in.SoakPeriod, &out.SoakPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxRestarts != nil {
+		in, out := &in.MaxRestarts, &out.MaxRestarts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxComponentErrors != nil {
+		in, out := &in.MaxComponentErrors, &out.MaxComponentErrors
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorRolloutSpec.
+func (in *CollectorRolloutSpec) DeepCopy() *CollectorRolloutSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorRolloutSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CollectorSpec) DeepCopyInto(out *CollectorSpec) {
 	*out = *in
@@ -632,6 +875,28 @@ func (in *CollectorSpec) DeepCopyInto(out *CollectorSpec) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Deployment != nil {
+		in, out := &in.Deployment, &out.Deployment
+		*out = new(CollectorDeploymentSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Aggregator != nil {
+		in, out := &in.Aggregator, &out.Aggregator
+		*out = new(CollectorAggregatorSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(CollectorRolloutSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeProfiles != nil {
+		in, out := &in.NodeProfiles, &out.NodeProfiles
+		*out = make([]CollectorNodeProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorSpec.
@@ -644,6 +909,21 @@ func (in *CollectorSpec) DeepCopy() *CollectorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapReference) DeepCopyInto(out *ConfigMapReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapReference.
+func (in *ConfigMapReference) DeepCopy() *ConfigMapReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerInputTuningSpec) DeepCopyInto(out *ContainerInputTuningSpec) {
 	*out = *in
@@ -669,6 +949,87 @@ func (in *ContainerInputTuningSpec) DeepCopy() *ContainerInputTuningSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Datadog) DeepCopyInto(out *Datadog) {
+	*out = *in
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(DatadogAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tuning != nil {
+		in, out := &in.Tuning, &out.Tuning
+		*out = new(DatadogTuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]DatadogTag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Datadog.
+func (in *Datadog) DeepCopy() *Datadog {
+	if in == nil {
+		return nil
+	}
+	out := new(Datadog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogAuthentication) DeepCopyInto(out *DatadogAuthentication) {
+	*out = *in
+	if in.APIKey != nil {
+		in, out := &in.APIKey, &out.APIKey
+		*out = new(SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogAuthentication.
+func (in *DatadogAuthentication) DeepCopy() *DatadogAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogTag) DeepCopyInto(out *DatadogTag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogTag.
+func (in *DatadogTag) DeepCopy() *DatadogTag {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogTuningSpec) DeepCopyInto(out *DatadogTuningSpec) {
+	*out = *in
+	in.BaseOutputTuningSpec.DeepCopyInto(&out.BaseOutputTuningSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogTuningSpec.
+func (in *DatadogTuningSpec) DeepCopy() *DatadogTuningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogTuningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DropCondition) DeepCopyInto(out *DropCondition) {
 	*out = *in
@@ -754,15 +1115,40 @@ func (in *ElasticsearchTuningSpec) DeepCopy() *ElasticsearchTuningSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FilterSpec) DeepCopyInto(out *FilterSpec) {
+func (in *EventsInput) DeepCopyInto(out *EventsInput) {
 	*out = *in
-	if in.KubeAPIAudit != nil {
-		in, out := &in.KubeAPIAudit, &out.KubeAPIAudit
-		*out = new(KubeAPIAudit)
-		(*in).DeepCopyInto(*out)
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]EventType, len(*in))
+		copy(*out, *in)
 	}
-	if in.DropTestsSpec != nil {
-		in, out := &in.DropTestsSpec, &out.DropTestsSpec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventsInput.
+func (in *EventsInput) DeepCopy() *EventsInput {
+	if in == nil {
+		return nil
+	}
+	out := new(EventsInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FilterSpec) DeepCopyInto(out *FilterSpec) {
+	*out = *in
+	if in.KubeAPIAudit != nil {
+		in, out := &in.KubeAPIAudit, &out.KubeAPIAudit
+		*out = new(KubeAPIAudit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OVNAudit != nil {
+		in, out := &in.OVNAudit, &out.OVNAudit
+		*out = new(OVNAudit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DropTestsSpec != nil {
+		in, out := &in.DropTestsSpec, &out.DropTestsSpec
 		*out = make([]DropTest, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
@@ -792,6 +1178,26 @@ func (in *FilterSpec) DeepCopy() *FilterSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FluentForwardReceiver) DeepCopyInto(out *FluentForwardReceiver) {
+	*out = *in
+	if in.SharedKey != nil {
+		in, out := &in.SharedKey, &out.SharedKey
+		*out = new(SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FluentForwardReceiver.
+func (in *FluentForwardReceiver) DeepCopy() *FluentForwardReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(FluentForwardReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GoogleCloudLogging) DeepCopyInto(out *GoogleCloudLogging) {
 	*out = *in
@@ -801,6 +1207,18 @@ func (in *GoogleCloudLogging) DeepCopyInto(out *GoogleCloudLogging) {
 		(*in).DeepCopyInto(*out)
 	}
 	out.ID = in.ID
+	if in.Resource != nil {
+		in, out := &in.Resource, &out.Resource
+		*out = new(GoogleCloudLoggingResource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.Tuning != nil {
 		in, out := &in.Tuning, &out.Tuning
 		*out = new(GoogleCloudLoggingTuningSpec)
@@ -858,6 +1276,28 @@ func (in *GoogleCloudLoggingId) DeepCopy() *GoogleCloudLoggingId {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudLoggingResource) DeepCopyInto(out *GoogleCloudLoggingResource) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudLoggingResource.
+func (in *GoogleCloudLoggingResource) DeepCopy() *GoogleCloudLoggingResource {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudLoggingResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GoogleCloudLoggingTuningSpec) DeepCopyInto(out *GoogleCloudLoggingTuningSpec) {
 	*out = *in
@@ -895,6 +1335,11 @@ func (in *HTTP) DeepCopyInto(out *HTTP) {
 			(*out)[key] = val
 		}
 	}
+	if in.Codec != nil {
+		in, out := &in.Codec, &out.Codec
+		*out = new(HTTPCodec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTP.
@@ -937,9 +1382,64 @@ func (in *HTTPAuthentication) DeepCopy() *HTTPAuthentication {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPCodec) DeepCopyInto(out *HTTPCodec) {
+	*out = *in
+	if in.CSVFields != nil {
+		in, out := &in.CSVFields, &out.CSVFields
+		*out = make([]FieldPath, len(*in))
+		copy(*out, *in)
+	}
+	if in.Protobuf != nil {
+		in, out := &in.Protobuf, &out.Protobuf
+		*out = new(ProtobufCodec)
+		**out = **in
+	}
+	if in.Avro != nil {
+		in, out := &in.Avro, &out.Avro
+		*out = new(AvroCodec)
+		**out = **in
+	}
+	if in.OnlyFields != nil {
+		in, out := &in.OnlyFields, &out.OnlyFields
+		*out = make([]FieldPath, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExceptFields != nil {
+		in, out := &in.ExceptFields, &out.ExceptFields
+		*out = make([]FieldPath, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPCodec.
+func (in *HTTPCodec) DeepCopy() *HTTPCodec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPCodec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPReceiver) DeepCopyInto(out *HTTPReceiver) {
 	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(HTTPReceiverAttribution)
+		**out = **in
+	}
+	if in.App != nil {
+		in, out := &in.App, &out.App
+		*out = new(HTTPReceiverAttribution)
+		**out = **in
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(HTTPReceiverAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPReceiver.
@@ -952,6 +1452,41 @@ func (in *HTTPReceiver) DeepCopy() *HTTPReceiver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPReceiverAttribution) DeepCopyInto(out *HTTPReceiverAttribution) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPReceiverAttribution.
+func (in *HTTPReceiverAttribution) DeepCopy() *HTTPReceiverAttribution {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPReceiverAttribution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPReceiverAuthentication) DeepCopyInto(out *HTTPReceiverAuthentication) {
+	*out = *in
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPReceiverAuthentication.
+func (in *HTTPReceiverAuthentication) DeepCopy() *HTTPReceiverAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPReceiverAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPTuningSpec) DeepCopyInto(out *HTTPTuningSpec) {
 	*out = *in
@@ -981,6 +1516,11 @@ func (in *Infrastructure) DeepCopyInto(out *Infrastructure) {
 		*out = new(InfrastructureInputTuningSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = new(NodeFiles)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Infrastructure.
@@ -1001,6 +1541,11 @@ func (in *InfrastructureInputTuningSpec) DeepCopyInto(out *InfrastructureInputTu
 		*out = new(ContainerInputTuningSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Journal != nil {
+		in, out := &in.Journal, &out.Journal
+		*out = new(JournalInputTuningSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfrastructureInputTuningSpec.
@@ -1036,6 +1581,16 @@ func (in *InputSpec) DeepCopyInto(out *InputSpec) {
 		*out = new(ReceiverSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaInput)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = new(EventsInput)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InputSpec.
@@ -1083,6 +1638,63 @@ func (in *InputTLSSpec) DeepCopy() *InputTLSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JournalInputTuningSpec) DeepCopyInto(out *JournalInputTuningSpec) {
+	*out = *in
+	if in.IncludeUnits != nil {
+		in, out := &in.IncludeUnits, &out.IncludeUnits
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeUnits != nil {
+		in, out := &in.ExcludeUnits, &out.ExcludeUnits
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(JournalPriority)
+		**out = **in
+	}
+	if in.IncludeMatches != nil {
+		in, out := &in.IncludeMatches, &out.IncludeMatches
+		*out = make([]JournalMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JournalInputTuningSpec.
+func (in *JournalInputTuningSpec) DeepCopy() *JournalInputTuningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JournalInputTuningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JournalMatch) DeepCopyInto(out *JournalMatch) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JournalMatch.
+func (in *JournalMatch) DeepCopy() *JournalMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(JournalMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Kafka) DeepCopyInto(out *Kafka) {
 	*out = *in
@@ -1133,6 +1745,41 @@ func (in *KafkaAuthentication) DeepCopy() *KafkaAuthentication {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaInput) DeepCopyInto(out *KafkaInput) {
+	*out = *in
+	if in.Brokers != nil {
+		in, out := &in.Brokers, &out.Brokers
+		*out = make([]BrokerURL, len(*in))
+		copy(*out, *in)
+	}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(KafkaAuthentication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(OutputTLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaInput.
+func (in *KafkaInput) DeepCopy() *KafkaInput {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaInput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KafkaTuningSpec) DeepCopyInto(out *KafkaTuningSpec) {
 	*out = *in
@@ -1177,6 +1824,16 @@ func (in *KubeAPIAudit) DeepCopyInto(out *KubeAPIAudit) {
 			copy(*out, *in)
 		}
 	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]KubeAPIAuditSource, len(*in))
+		copy(*out, *in)
+	}
+	if in.OAuth != nil {
+		in, out := &in.OAuth, &out.OAuth
+		*out = new(OAuthAudit)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeAPIAudit.
@@ -1204,6 +1861,153 @@ func (in *LimitSpec) DeepCopy() *LimitSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogForwarder) DeepCopyInto(out *LogForwarder) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogForwarder.
+func (in *LogForwarder) DeepCopy() *LogForwarder {
+	if in == nil {
+		return nil
+	}
+	out := new(LogForwarder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogForwarder) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogForwarderList) DeepCopyInto(out *LogForwarderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LogForwarder, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogForwarderList.
+func (in *LogForwarderList) DeepCopy() *LogForwarderList {
+	if in == nil {
+		return nil
+	}
+	out := new(LogForwarderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogForwarderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogForwarderPipelineSpec) DeepCopyInto(out *LogForwarderPipelineSpec) {
+	*out = *in
+	if in.OutputRefs != nil {
+		in, out := &in.OutputRefs, &out.OutputRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FilterRefs != nil {
+		in, out := &in.FilterRefs, &out.FilterRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogForwarderPipelineSpec.
+func (in *LogForwarderPipelineSpec) DeepCopy() *LogForwarderPipelineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LogForwarderPipelineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogForwarderSpec) DeepCopyInto(out *LogForwarderSpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]OutputSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]FilterSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Pipelines != nil {
+		in, out := &in.Pipelines, &out.Pipelines
+		*out = make([]LogForwarderPipelineSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogForwarderSpec.
+func (in *LogForwarderSpec) DeepCopy() *LogForwarderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LogForwarderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogForwarderStatus) DeepCopyInto(out *LogForwarderStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogForwarderStatus.
+func (in *LogForwarderStatus) DeepCopy() *LogForwarderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LogForwarderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Loki) DeepCopyInto(out *Loki) {
 	*out = *in
@@ -1261,143 +2065,304 @@ func (in *LokiStack) DeepCopy() *LokiStack {
 	if in == nil {
 		return nil
 	}
-	out := new(LokiStack)
+	out := new(LokiStack)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiStackAuthentication) DeepCopyInto(out *LokiStackAuthentication) {
+	*out = *in
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(BearerToken)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStackAuthentication.
+func (in *LokiStackAuthentication) DeepCopy() *LokiStackAuthentication {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiStackAuthentication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiStackLabelKeys) DeepCopyInto(out *LokiStackLabelKeys) {
+	*out = *in
+	if in.Global != nil {
+		in, out := &in.Global, &out.Global
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Application != nil {
+		in, out := &in.Application, &out.Application
+		*out = new(LokiStackTenantLabelKeys)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Infrastructure != nil {
+		in, out := &in.Infrastructure, &out.Infrastructure
+		*out = new(LokiStackTenantLabelKeys)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Audit != nil {
+		in, out := &in.Audit, &out.Audit
+		*out = new(LokiStackTenantLabelKeys)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStackLabelKeys.
+func (in *LokiStackLabelKeys) DeepCopy() *LokiStackLabelKeys {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiStackLabelKeys)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiStackTarget) DeepCopyInto(out *LokiStackTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStackTarget.
+func (in *LokiStackTarget) DeepCopy() *LokiStackTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiStackTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiStackTenantLabelKeys) DeepCopyInto(out *LokiStackTenantLabelKeys) {
+	*out = *in
+	if in.LabelKeys != nil {
+		in, out := &in.LabelKeys, &out.LabelKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStackTenantLabelKeys.
+func (in *LokiStackTenantLabelKeys) DeepCopy() *LokiStackTenantLabelKeys {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiStackTenantLabelKeys)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiTuningSpec) DeepCopyInto(out *LokiTuningSpec) {
+	*out = *in
+	in.BaseOutputTuningSpec.DeepCopyInto(&out.BaseOutputTuningSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiTuningSpec.
+func (in *LokiTuningSpec) DeepCopy() *LokiTuningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiTuningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceContainerSpec) DeepCopyInto(out *NamespaceContainerSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceContainerSpec.
+func (in *NamespaceContainerSpec) DeepCopy() *NamespaceContainerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceContainerSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LokiStackAuthentication) DeepCopyInto(out *LokiStackAuthentication) {
+func (in *NetworkPolicy) DeepCopyInto(out *NetworkPolicy) {
 	*out = *in
-	if in.Token != nil {
-		in, out := &in.Token, &out.Token
-		*out = new(BearerToken)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStackAuthentication.
-func (in *LokiStackAuthentication) DeepCopy() *LokiStackAuthentication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicy.
+func (in *NetworkPolicy) DeepCopy() *NetworkPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(LokiStackAuthentication)
+	out := new(NetworkPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LokiStackLabelKeys) DeepCopyInto(out *LokiStackLabelKeys) {
+func (in *NewRelic) DeepCopyInto(out *NewRelic) {
 	*out = *in
-	if in.Global != nil {
-		in, out := &in.Global, &out.Global
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Application != nil {
-		in, out := &in.Application, &out.Application
-		*out = new(LokiStackTenantLabelKeys)
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(NewRelicAuthentication)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Infrastructure != nil {
-		in, out := &in.Infrastructure, &out.Infrastructure
-		*out = new(LokiStackTenantLabelKeys)
+	if in.Tuning != nil {
+		in, out := &in.Tuning, &out.Tuning
+		*out = new(NewRelicTuningSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Audit != nil {
-		in, out := &in.Audit, &out.Audit
-		*out = new(LokiStackTenantLabelKeys)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewRelic.
+func (in *NewRelic) DeepCopy() *NewRelic {
+	if in == nil {
+		return nil
 	}
+	out := new(NewRelic)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStackLabelKeys.
-func (in *LokiStackLabelKeys) DeepCopy() *LokiStackLabelKeys {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NewRelicAuthentication) DeepCopyInto(out *NewRelicAuthentication) {
+	*out = *in
+	if in.LicenseKey != nil {
+		in, out := &in.LicenseKey, &out.LicenseKey
+		*out = new(SecretReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewRelicAuthentication.
+func (in *NewRelicAuthentication) DeepCopy() *NewRelicAuthentication {
 	if in == nil {
 		return nil
 	}
-	out := new(LokiStackLabelKeys)
+	out := new(NewRelicAuthentication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LokiStackTarget) DeepCopyInto(out *LokiStackTarget) {
+func (in *NewRelicTuningSpec) DeepCopyInto(out *NewRelicTuningSpec) {
 	*out = *in
+	in.BaseOutputTuningSpec.DeepCopyInto(&out.BaseOutputTuningSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStackTarget.
-func (in *LokiStackTarget) DeepCopy() *LokiStackTarget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewRelicTuningSpec.
+func (in *NewRelicTuningSpec) DeepCopy() *NewRelicTuningSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LokiStackTarget)
+	out := new(NewRelicTuningSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LokiStackTenantLabelKeys) DeepCopyInto(out *LokiStackTenantLabelKeys) {
+func (in *NodeFileFingerprint) DeepCopyInto(out *NodeFileFingerprint) {
 	*out = *in
-	if in.LabelKeys != nil {
-		in, out := &in.LabelKeys, &out.LabelKeys
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Lines != nil {
+		in, out := &in.Lines, &out.Lines
+		*out = new(int64)
+		**out = **in
+	}
+	if in.IgnoredHeaderBytes != nil {
+		in, out := &in.IgnoredHeaderBytes, &out.IgnoredHeaderBytes
+		*out = new(int64)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiStackTenantLabelKeys.
-func (in *LokiStackTenantLabelKeys) DeepCopy() *LokiStackTenantLabelKeys {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeFileFingerprint.
+func (in *NodeFileFingerprint) DeepCopy() *NodeFileFingerprint {
 	if in == nil {
 		return nil
 	}
-	out := new(LokiStackTenantLabelKeys)
+	out := new(NodeFileFingerprint)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LokiTuningSpec) DeepCopyInto(out *LokiTuningSpec) {
+func (in *NodeFileMultiline) DeepCopyInto(out *NodeFileMultiline) {
 	*out = *in
-	in.BaseOutputTuningSpec.DeepCopyInto(&out.BaseOutputTuningSpec)
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiTuningSpec.
-func (in *LokiTuningSpec) DeepCopy() *LokiTuningSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeFileMultiline.
+func (in *NodeFileMultiline) DeepCopy() *NodeFileMultiline {
 	if in == nil {
 		return nil
 	}
-	out := new(LokiTuningSpec)
+	out := new(NodeFileMultiline)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NamespaceContainerSpec) DeepCopyInto(out *NamespaceContainerSpec) {
+func (in *NodeFiles) DeepCopyInto(out *NodeFiles) {
 	*out = *in
+	if in.Includes != nil {
+		in, out := &in.Includes, &out.Includes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Excludes != nil {
+		in, out := &in.Excludes, &out.Excludes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Multiline != nil {
+		in, out := &in.Multiline, &out.Multiline
+		*out = new(NodeFileMultiline)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Fingerprint != nil {
+		in, out := &in.Fingerprint, &out.Fingerprint
+		*out = new(NodeFileFingerprint)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceContainerSpec.
-func (in *NamespaceContainerSpec) DeepCopy() *NamespaceContainerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeFiles.
+func (in *NodeFiles) DeepCopy() *NodeFiles {
 	if in == nil {
 		return nil
 	}
-	out := new(NamespaceContainerSpec)
+	out := new(NodeFiles)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkPolicy) DeepCopyInto(out *NetworkPolicy) {
+func (in *OAuthAudit) DeepCopyInto(out *OAuthAudit) {
 	*out = *in
+	if in.OmitDecisions != nil {
+		in, out := &in.OmitDecisions, &out.OmitDecisions
+		*out = make([]OAuthLoginDecision, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicy.
-func (in *NetworkPolicy) DeepCopy() *NetworkPolicy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuthAudit.
+func (in *OAuthAudit) DeepCopy() *OAuthAudit {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkPolicy)
+	out := new(OAuthAudit)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1427,6 +2392,21 @@ func (in *OTLP) DeepCopy() *OTLP {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPReceiver) DeepCopyInto(out *OTLPReceiver) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPReceiver.
+func (in *OTLPReceiver) DeepCopy() *OTLPReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OTLPTuningSpec) DeepCopyInto(out *OTLPTuningSpec) {
 	*out = *in
@@ -1443,6 +2423,58 @@ func (in *OTLPTuningSpec) DeepCopy() *OTLPTuningSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OVNAudit) DeepCopyInto(out *OVNAudit) {
+	*out = *in
+	if in.Drop != nil {
+		in, out := &in.Drop, &out.Drop
+		*out = make([]OVNAuditRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVNAudit.
+func (in *OVNAudit) DeepCopy() *OVNAudit {
+	if in == nil {
+		return nil
+	}
+	out := new(OVNAudit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OVNAuditRule) DeepCopyInto(out *OVNAuditRule) {
+	*out = *in
+	if in.ACLNames != nil {
+		in, out := &in.ACLNames, &out.ACLNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Verdicts != nil {
+		in, out := &in.Verdicts, &out.Verdicts
+		*out = make([]OVNACLVerdict, len(*in))
+		copy(*out, *in)
+	}
+	if in.Severities != nil {
+		in, out := &in.Severities, &out.Severities
+		*out = make([]OVNACLSeverity, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OVNAuditRule.
+func (in *OVNAuditRule) DeepCopy() *OVNAuditRule {
+	if in == nil {
+		return nil
+	}
+	out := new(OVNAuditRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OutputSpec) DeepCopyInto(out *OutputSpec) {
 	*out = *in
@@ -1471,6 +2503,11 @@ func (in *OutputSpec) DeepCopyInto(out *OutputSpec) {
 		*out = new(Cloudwatch)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Datadog != nil {
+		in, out := &in.Datadog, &out.Datadog
+		*out = new(Datadog)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Elasticsearch != nil {
 		in, out := &in.Elasticsearch, &out.Elasticsearch
 		*out = new(Elasticsearch)
@@ -1501,11 +2538,21 @@ func (in *OutputSpec) DeepCopyInto(out *OutputSpec) {
 		*out = new(LokiStack)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NewRelic != nil {
+		in, out := &in.NewRelic, &out.NewRelic
+		*out = new(NewRelic)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.S3 != nil {
 		in, out := &in.S3, &out.S3
 		*out = new(S3)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Socket != nil {
+		in, out := &in.Socket, &out.Socket
+		*out = new(Socket)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Splunk != nil {
 		in, out := &in.Splunk, &out.Splunk
 		*out = new(Splunk)
@@ -1584,6 +2631,22 @@ func (in *PipelineSpec) DeepCopy() *PipelineSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtobufCodec) DeepCopyInto(out *ProtobufCodec) {
+	*out = *in
+	out.Descriptor = in.Descriptor
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtobufCodec.
+func (in *ProtobufCodec) DeepCopy() *ProtobufCodec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtobufCodec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PruneFilterSpec) DeepCopyInto(out *PruneFilterSpec) {
 	*out = *in
@@ -1620,8 +2683,23 @@ func (in *ReceiverSpec) DeepCopyInto(out *ReceiverSpec) {
 	if in.HTTP != nil {
 		in, out := &in.HTTP, &out.HTTP
 		*out = new(HTTPReceiver)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OTLP != nil {
+		in, out := &in.OTLP, &out.OTLP
+		*out = new(OTLPReceiver)
 		**out = **in
 	}
+	if in.FluentForward != nil {
+		in, out := &in.FluentForward, &out.FluentForward
+		*out = new(FluentForwardReceiver)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SplunkHEC != nil {
+		in, out := &in.SplunkHEC, &out.SplunkHEC
+		*out = new(SplunkHECReceiver)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReceiverSpec.
@@ -1730,6 +2808,86 @@ func (in *ServiceAccount) DeepCopy() *ServiceAccount {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Socket) DeepCopyInto(out *Socket) {
+	*out = *in
+	if in.Framing != nil {
+		in, out := &in.Framing, &out.Framing
+		*out = new(SocketFraming)
+		**out = **in
+	}
+	if in.Codec != nil {
+		in, out := &in.Codec, &out.Codec
+		*out = new(SocketCodec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tuning != nil {
+		in, out := &in.Tuning, &out.Tuning
+		*out = new(SocketTuningSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Socket.
+func (in *Socket) DeepCopy() *Socket {
+	if in == nil {
+		return nil
+	}
+	out := new(Socket)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SocketCodec) DeepCopyInto(out *SocketCodec) {
+	*out = *in
+	if in.CSVFields != nil {
+		in, out := &in.CSVFields, &out.CSVFields
+		*out = make([]FieldPath, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SocketCodec.
+func (in *SocketCodec) DeepCopy() *SocketCodec {
+	if in == nil {
+		return nil
+	}
+	out := new(SocketCodec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SocketFraming) DeepCopyInto(out *SocketFraming) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SocketFraming.
+func (in *SocketFraming) DeepCopy() *SocketFraming {
+	if in == nil {
+		return nil
+	}
+	out := new(SocketFraming)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SocketTuningSpec) DeepCopyInto(out *SocketTuningSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SocketTuningSpec.
+func (in *SocketTuningSpec) DeepCopy() *SocketTuningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SocketTuningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Splunk) DeepCopyInto(out *Splunk) {
 	*out = *in
@@ -1781,6 +2939,32 @@ func (in *SplunkAuthentication) DeepCopy() *SplunkAuthentication {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplunkHECReceiver) DeepCopyInto(out *SplunkHECReceiver) {
+	*out = *in
+	if in.Tokens != nil {
+		in, out := &in.Tokens, &out.Tokens
+		*out = make([]*SecretReference, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SecretReference)
+				**out = **in
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplunkHECReceiver.
+func (in *SplunkHECReceiver) DeepCopy() *SplunkHECReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(SplunkHECReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SplunkTuningSpec) DeepCopyInto(out *SplunkTuningSpec) {
 	*out = *in
@@ -1867,6 +3051,31 @@ func (in *TLSSpec) DeepCopy() *TLSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenancySpec) DeepCopyInto(out *TenancySpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(LimitSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenancySpec.
+func (in *TenancySpec) DeepCopy() *TenancySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TenancySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *URLSpec) DeepCopyInto(out *URLSpec) {
 	*out = *in
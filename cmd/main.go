@@ -13,16 +13,19 @@ import (
 	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
 	"github.com/openshift/cluster-logging-operator/internal/collector"
 	internaltls "github.com/openshift/cluster-logging-operator/internal/tls"
+	observabilitywebhook "github.com/openshift/cluster-logging-operator/internal/webhook/observability"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	internalmetrics "github.com/openshift/cluster-logging-operator/internal/metrics"
 	"github.com/openshift/cluster-logging-operator/internal/metrics/dashboard"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/openshift/cluster-logging-operator/api/logging/v1alpha1"
 	observabilityv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
@@ -80,6 +83,8 @@ func main() {
 	var enableLeaderElection bool
 	var secureMetrics bool
 	var probeAddr string
+	var webhookCertPath, webhookCertName, webhookCertKey, webhookFailurePolicy string
+	var validateCollectorConfig bool
 
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8443", "The address the metrics endpoint binds to. "+
@@ -95,6 +100,14 @@ func main() {
 		"The directory that contains the metrics server certificate.")
 	flag.StringVar(&metricsCertName, "metrics-cert-name", "tls.crt", "The name of the metrics server certificate file.")
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
+	flag.StringVar(&webhookCertPath, "webhook-cert-path", "",
+		"The directory that contains the webhook server certificate. The webhook server is disabled when not set.")
+	flag.StringVar(&webhookCertName, "webhook-cert-name", "tls.crt", "The name of the webhook server certificate file.")
+	flag.StringVar(&webhookCertKey, "webhook-cert-key", "tls.key", "The name of the webhook server key file.")
+	flag.StringVar(&webhookFailurePolicy, "webhook-failure-policy", "Ignore",
+		"The policy when the webhook server is unavailable. Use Ignore to admit (fail open) or Fail to reject (fail closed) ClusterLogForwarders.")
+	flag.BoolVar(&validateCollectorConfig, "validate-collector-config", true,
+		"Validate the generated config with the collector before it is rolled out and keep the deployed config when it is rejected.")
 	flag.Parse()
 
 	logger := utils.InitStaticLogger("cluster-logging-operator")
@@ -167,11 +180,25 @@ func main() {
 		metricsServerOptions.KeyName = metricsCertKey
 	}
 
+	var webhookServer webhook.Server
+	if len(webhookCertPath) > 0 {
+		log.Info("Initializing webhook certificate watcher using provided certificates",
+			"webhook-cert-path", webhookCertPath, "webhook-cert-name", webhookCertName, "webhook-cert-key", webhookCertKey)
+
+		webhookServer = webhook.NewServer(webhook.Options{
+			CertDir:  webhookCertPath,
+			CertName: webhookCertName,
+			KeyName:  webhookCertKey,
+			TLSOpts:  tlsOpts,
+		})
+	}
+
 	// https://issues.redhat.com/browse/LOG-3321
 	cacheOptions := cache.Options{
 		SyncPeriod: utils.GetPtr(time.Minute * 3),
 	}
-	if watchNS := getWatchNS(); len(watchNS) > 0 {
+	watchNS := getWatchNS()
+	if len(watchNS) > 0 {
 		cacheOptions.DefaultNamespaces = map[string]cache.Config{}
 		for _, ns := range watchNS {
 			cacheOptions.DefaultNamespaces[ns] = cache.Config{}
@@ -184,6 +211,7 @@ func main() {
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "b430cc2e.openshift.io",
 		Cache:                  cacheOptions,
+		WebhookServer:          webhookServer,
 	})
 	if err != nil {
 		log.Error(err, "unable to start manager")
@@ -232,6 +260,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	// the component errors of the canary collectors are not evaluated without the metrics of the cluster monitoring stack
+	metricsQuerier, err := internalmetrics.NewThanosQuerier()
+	if err != nil {
+		log.V(1).Error(err, "unable to query the metrics of the collectors")
+	}
+
 	if err = (&observabilitycontroller.ClusterLogForwarderReconciler{
 		Scheme:       mgr.GetScheme(),
 		PollInterval: collector.DefaultPollInterval,
@@ -242,6 +276,8 @@ func main() {
 				Reader:         mgr.GetAPIReader(),
 				ClusterID:      clusterID,
 				ClusterVersion: clusterVersion,
+				ValidateConfig: validateCollectorConfig,
+				Metrics:        metricsQuerier,
 			}
 		},
 	}).SetupWithManager(mgr); err != nil {
@@ -258,6 +294,25 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Register the admission webhook which rejects invalid ClusterLogForwarders
+	if webhookServer != nil {
+		failurePolicy, err := observabilitywebhook.ParseFailurePolicy(webhookFailurePolicy)
+		if err != nil {
+			log.Error(err, "invalid webhook failure policy")
+			os.Exit(1)
+		}
+		if err = (&observabilitywebhook.ClusterLogForwarderValidator{}).SetupWithManager(mgr); err != nil {
+			log.Error(err, "unable to create webhook", "webhook", "observability.ClusterLogForwarder")
+			os.Exit(1)
+		}
+		if err = observabilitywebhook.ReconcileValidatingWebhookConfiguration(k8sClient, getOperatorNS(), failurePolicy, watchNS); err != nil {
+			log.Error(err, "unable to reconcile the webhook configuration")
+			os.Exit(1)
+		}
+	} else if err = observabilitywebhook.RemoveValidatingWebhookConfiguration(k8sClient); err != nil {
+		log.Error(err, "unable to remove the webhook configuration")
+	}
+
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -293,6 +348,17 @@ func getWatchNS() []string {
 	return strings.Split(ns, ",")
 }
 
+// getOperatorNS returns the namespace of the operator
+func getOperatorNS() string {
+	OperatorNSEnvVar := "POD_NAMESPACE"
+	ns, found := os.LookupEnv(OperatorNSEnvVar)
+	if !found {
+		log.Error(fmt.Errorf("exiting. %s must be set", OperatorNSEnvVar), "Failed to get operator namespace")
+		os.Exit(1)
+	}
+	return ns
+}
+
 func migrateManifestResources(k8sClient client.Client) {
 	log.Info("migrating resources provided by the manifest")
 	if err := k8sClient.Delete(context.TODO(), loggingruntime.NewPriorityClass("cluster-logging", 0, false, "")); err != nil && !errors.IsNotFound(err) {
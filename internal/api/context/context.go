@@ -2,6 +2,7 @@ package context
 
 import (
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/metrics"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
 	corev1 "k8s.io/api/core/v1"
 	kubernetes "sigs.k8s.io/controller-runtime/pkg/client"
@@ -32,6 +33,15 @@ type ForwarderContext struct {
 	// ClusterVersion is the version of the cluster on which the operator is deployed
 	ClusterVersion string
 
+	// ClusterRegion is the region of the cloud platform of the cluster on which the operator is deployed, if any
+	ClusterRegion string
+
+	// ValidateConfig enables validating the generated config with the collector before it is rolled out
+	ValidateConfig bool
+
+	// Metrics queries the metrics of the collectors from the cluster monitoring stack when it is available
+	Metrics metrics.Querier
+
 	// AdditionalContext are additional context options to take pass along during reconciliation
 	AdditionalContext utils.Options
 }
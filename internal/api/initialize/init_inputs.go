@@ -54,20 +54,23 @@ func migrateInputReceiver(spec obs.InputSpec, forwarderName string, options util
 	if spec.Type != obs.InputTypeReceiver {
 		return spec
 	}
-	if spec.Receiver != nil && spec.Receiver.TLS != nil {
+	if spec.Receiver != nil && spec.Receiver.TLS != nil && !onlyClientCA(spec.Receiver) {
 		return spec
 	}
+	tlsSpec := obs.InputTLSSpec{}
+	if spec.Receiver.TLS != nil {
+		tlsSpec = *spec.Receiver.TLS
+	}
 	secretName := fmt.Sprintf("%s-%s", forwarderName, spec.Name)
-	spec.Receiver.TLS = &obs.InputTLSSpec{
-		Key: &obs.SecretReference{
-			Key:        constants.ClientPrivateKey,
-			SecretName: secretName,
-		},
-		Certificate: &obs.ValueReference{
-			Key:        constants.ClientCertKey,
-			SecretName: secretName,
-		},
+	tlsSpec.Key = &obs.SecretReference{
+		Key:        constants.ClientPrivateKey,
+		SecretName: secretName,
+	}
+	tlsSpec.Certificate = &obs.ValueReference{
+		Key:        constants.ClientCertKey,
+		SecretName: secretName,
 	}
+	spec.Receiver.TLS = &tlsSpec
 	secrets := []*corev1.Secret{
 		runtime.NewSecret("", secretName, map[string][]byte{
 			constants.ClientPrivateKey: {},
@@ -79,3 +82,9 @@ func migrateInputReceiver(spec obs.InputSpec, forwarderName string, options util
 	})
 	return spec
 }
+
+// onlyClientCA returns true for fluentForward receivers which only spec the CA verifying the certificates of their
+// clients and need the serving certificate of the cert signing service
+func onlyClientCA(receiver *obs.ReceiverSpec) bool {
+	return receiver.Type == obs.ReceiverTypeFluentForward && receiver.TLS.CA != nil && receiver.TLS.Certificate == nil && receiver.TLS.Key == nil
+}
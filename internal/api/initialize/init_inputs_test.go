@@ -200,6 +200,60 @@ var _ = Describe("migrateInputs", func() {
 					}),
 			}), "Exp. context to include the secrets to mount to the deployment")
 		})
+		It("should add the TLS settings of the cert signing service and keep the CA of a fluentForward receiver when only the CA is spec'd", func() {
+			ca := &obs.ValueReference{Key: constants.TrustedCABundleKey, ConfigMapName: "client-ca"}
+			spec = obs.ClusterLogForwarder{
+				Spec: obs.ClusterLogForwarderSpec{
+					Inputs: []obs.InputSpec{
+						{
+							Name: "fluent",
+							Type: obs.InputTypeReceiver,
+							Receiver: &obs.ReceiverSpec{
+								Type: obs.ReceiverTypeFluentForward,
+								TLS:  &obs.InputTLSSpec{CA: ca},
+							},
+						},
+					},
+				},
+			}
+			spec.Name = forwarderName
+			secretName := fmt.Sprintf("%s-%s", forwarderName, "fluent")
+
+			migratedSpec := migrate(spec, spec)
+			Expect(migratedSpec.Spec.Inputs[0].Receiver.TLS).To(Equal(&obs.InputTLSSpec{
+				CA: ca,
+				Key: &obs.SecretReference{
+					Key:        constants.ClientPrivateKey,
+					SecretName: secretName,
+				},
+				Certificate: &obs.ValueReference{
+					Key:        constants.ClientCertKey,
+					SecretName: secretName,
+				},
+			}))
+		})
+		It("should not modify the TLS settings of other receivers when only the CA is spec'd", func() {
+			spec = obs.ClusterLogForwarder{
+				Spec: obs.ClusterLogForwarderSpec{
+					Inputs: []obs.InputSpec{
+						{
+							Name: "anapp",
+							Type: obs.InputTypeReceiver,
+							Receiver: &obs.ReceiverSpec{
+								Type: obs.ReceiverTypeHTTP,
+								TLS: &obs.InputTLSSpec{
+									CA: &obs.ValueReference{Key: constants.TrustedCABundleKey, ConfigMapName: "client-ca"},
+								},
+							},
+						},
+					},
+				},
+			}
+			spec.Name = forwarderName
+
+			migratedSpec := migrate(spec, spec)
+			Expect(migratedSpec.Spec.Inputs).To(Equal(spec.Spec.Inputs))
+		})
 	})
 
 })
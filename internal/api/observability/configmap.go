@@ -5,6 +5,7 @@ import (
 	"hash/fnv"
 	"sort"
 
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -29,6 +30,17 @@ func (c ConfigMaps) Hash64a() string {
 			buffer.Write([]byte(k))
 			buffer.Write([]byte(v))
 		}
+
+		keys = []string{}
+		for key := range cm.BinaryData {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			buffer.Write([]byte(k))
+			buffer.Write(cm.BinaryData[k])
+		}
 	}
 	return fmt.Sprintf("%d", buffer.Sum64())
 }
@@ -41,3 +53,18 @@ func (c ConfigMaps) Names() (names []string) {
 	sort.Strings(names)
 	return names
 }
+
+// AsString returns the value of the given configmap with key if it exists or empty
+func (c ConfigMaps) AsString(key *obs.ConfigMapReference) string {
+	if key != nil && key.ConfigMapName != "" {
+		if cm, exists := c[key.ConfigMapName]; exists {
+			if value, exists := cm.Data[key.Key]; exists {
+				return value
+			}
+			if value, exists := cm.BinaryData[key.Key]; exists {
+				return string(value)
+			}
+		}
+	}
+	return ""
+}
@@ -3,6 +3,7 @@ package observability_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -26,6 +27,36 @@ var _ = Describe("ConfigMaps ", func() {
 		Expect(original.Hash64a()).ToNot(Equal(modified.Hash64a()))
 	})
 
+	It("should return different hashes when configmap binary content changes", func() {
+		original := internalobs.ConfigMaps{
+			"proto": &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "proto"},
+				BinaryData: map[string][]byte{"records.desc": {0x0a, 0x01}},
+			},
+		}
+		modified := internalobs.ConfigMaps{
+			"proto": &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "proto"},
+				BinaryData: map[string][]byte{"records.desc": {0x0a, 0x02}},
+			},
+		}
+		Expect(original.Hash64a()).ToNot(Equal(modified.Hash64a()))
+	})
+
+	It("should return the value of a key from data or binaryData", func() {
+		configMaps := internalobs.ConfigMaps{
+			"codec": &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "codec"},
+				Data:       map[string]string{"schema.avsc": `{"type":"string"}`},
+				BinaryData: map[string][]byte{"records.desc": []byte("desc")},
+			},
+		}
+		Expect(configMaps.AsString(&obs.ConfigMapReference{ConfigMapName: "codec", Key: "schema.avsc"})).To(Equal(`{"type":"string"}`))
+		Expect(configMaps.AsString(&obs.ConfigMapReference{ConfigMapName: "codec", Key: "records.desc"})).To(Equal("desc"))
+		Expect(configMaps.AsString(&obs.ConfigMapReference{ConfigMapName: "codec", Key: "missing"})).To(BeEmpty())
+		Expect(configMaps.AsString(&obs.ConfigMapReference{ConfigMapName: "missing", Key: "schema.avsc"})).To(BeEmpty())
+	})
+
 	It("should return the same hash for identical content", func() {
 		cm1 := internalobs.ConfigMaps{
 			"ca-test": &corev1.ConfigMap{
@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
-	"github.com/openshift/cluster-logging-operator/internal/constants"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -17,14 +16,25 @@ type NameList interface {
 }
 
 // DeployAsDeployment evaluates the spec to determine if the collector will be deployed as a deployment.
-// Collector is not a daemonset if the only input source is an HTTP receiver
-// Enabled through an annotation
+// Collector is not a daemonset if the only input sources are receivers, kafka consumers or cluster events which do not
+// require access to the logs of the node
 func DeployAsDeployment(forwarder obs.ClusterLogForwarder) bool {
-	if _, ok := forwarder.Annotations[constants.AnnotationEnableCollectorAsDeployment]; ok {
-		inputTypes := Inputs(forwarder.Spec.Inputs).InputTypes()
-		return len(inputTypes) == 1 && inputTypes[0] == obs.InputTypeReceiver
+	inputTypes := Inputs(forwarder.Spec.Inputs).InputTypes()
+	if len(inputTypes) == 0 {
+		return false
 	}
-	return false
+	for _, t := range inputTypes {
+		if t != obs.InputTypeReceiver && t != obs.InputTypeKafka && t != obs.InputTypeEvents {
+			return false
+		}
+	}
+	return true
+}
+
+// UseAggregator evaluates the spec to determine if the collector forwards logs to an aggregator tier which applies
+// the pipelines. The aggregator is only used in front of collectors deployed as a daemonset
+func UseAggregator(forwarder obs.ClusterLogForwarder) bool {
+	return forwarder.Spec.Collector != nil && forwarder.Spec.Collector.Aggregator != nil && !DeployAsDeployment(forwarder)
 }
 
 // IsValidSpec evaluates the status conditions to determine if the spec is valid
@@ -32,6 +42,7 @@ func IsValidSpec(forwarder obs.ClusterLogForwarder) bool {
 	log.V(3).Info("IsValidSpec", "outputs", forwarder.Spec.Outputs)
 	status := forwarder.Status
 	return isAuthorized(status.Conditions) &&
+		!hasFailed(obs.ConditionTypeValidNodeProfiles, status.Conditions) &&
 		isValid(obs.ConditionTypeValidInputPrefix, status.InputConditions, len(forwarder.Spec.Inputs)) &&
 		isValid(obs.ConditionTypeValidOutputPrefix, status.OutputConditions, len(forwarder.Spec.Outputs)) &&
 		isValid(obs.ConditionTypeValidPipelinePrefix, status.PipelineConditions, len(forwarder.Spec.Pipelines)) &&
@@ -53,6 +64,16 @@ func isValid(prefix string, conditions []metav1.Condition, expConditions int) bo
 	return conditionTrue == expConditions
 }
 
+// hasFailed returns true when the condition of the type is false
+func hasFailed(conditionType string, conditions []metav1.Condition) bool {
+	for _, cond := range conditions {
+		if cond.Type == conditionType && cond.Status == obs.ConditionFalse {
+			return true
+		}
+	}
+	return false
+}
+
 func isAuthorized(conditions []metav1.Condition) bool {
 	for _, cond := range conditions {
 		if cond.Type == obs.ConditionTypeAuthorized && cond.Status == obs.ConditionTrue {
@@ -79,3 +100,31 @@ func (spec ClusterLogForwarderSpec) InputSpecsTo(out obs.OutputSpec) (results []
 	}
 	return results
 }
+
+// PipelineNamesFrom returns the sorted names of the pipelines consuming the input
+func (spec ClusterLogForwarderSpec) PipelineNamesFrom(in obs.InputSpec) []string {
+	found := sets.NewString()
+	for _, p := range spec.Pipelines {
+		if sets.NewString(p.InputRefs...).Has(in.Name) {
+			found.Insert(p.Name)
+		}
+	}
+	return found.List()
+}
+
+// OutputSpecsFrom returns the outputs of the pipelines consuming the given input
+func (spec ClusterLogForwarderSpec) OutputSpecsFrom(in obs.InputSpec) (results []obs.OutputSpec) {
+	outputs := Outputs(spec.Outputs).Map()
+	found := sets.NewString()
+	for _, p := range spec.Pipelines {
+		if sets.NewString(p.InputRefs...).Has(in.Name) {
+			found.Insert(p.OutputRefs...)
+		}
+	}
+	for _, name := range found.List() {
+		if out, ok := outputs[name]; ok {
+			results = append(results, out)
+		}
+	}
+	return results
+}
@@ -72,6 +72,12 @@ var _ = Describe("[internal][api][observability]", func() {
 			}
 			Expect(IsValidSpec(forwarder)).To(BeFalse())
 		})
+		It("should be false when the node profiles are invalid", func() {
+			forwarder.Status.Conditions = append(forwarder.Status.Conditions,
+				NewCondition(obs.ConditionTypeValidNodeProfiles, obs.ConditionFalse, obs.ReasonValidationFailure, ""),
+			)
+			Expect(IsValidSpec(forwarder)).To(BeFalse())
+		})
 		It("should be false when the forwarder is not authorized", func() {
 			forwarder.Status.Conditions = []metav1.Condition{
 				NewCondition(obs.ConditionTypeAuthorized, obs.ConditionFalse, "", ""),
@@ -93,24 +99,93 @@ var _ = Describe("[internal][api][observability]", func() {
 			}
 		})
 
-		It("should not be a deployment if the annotation does not enable the feature", func() {
+		It("should not be a deployment when there are node inputs", func() {
 			Expect(DeployAsDeployment(forwarder)).To(BeFalse())
 		})
 
-		Context("when the forwarder is annotated to enable the feature", func() {
-			BeforeEach(func() {
-				forwarder.Annotations = map[string]string{constants.AnnotationEnableCollectorAsDeployment: "true"}
-			})
-			It("should be true when there are only receiver inputs", func() {
-				forwarder.Spec.Inputs = []obs.InputSpec{
-					{Type: obs.InputTypeReceiver},
-					{Type: obs.InputTypeReceiver},
-				}
-				Expect(DeployAsDeployment(forwarder)).To(BeTrue())
-			})
-			It("should be false when there are more then just receiver inputs", func() {
-				Expect(DeployAsDeployment(forwarder)).To(BeFalse())
-			})
+		It("should be a deployment when there are only events and receiver inputs", func() {
+			forwarder.Spec.Inputs = []obs.InputSpec{
+				{Type: obs.InputTypeEvents},
+				{Type: obs.InputTypeReceiver},
+			}
+			Expect(DeployAsDeployment(forwarder)).To(BeTrue())
+		})
+
+		It("should not be a deployment when there are events and node inputs", func() {
+			forwarder.Spec.Inputs = append(forwarder.Spec.Inputs, obs.InputSpec{Type: obs.InputTypeEvents})
+			Expect(DeployAsDeployment(forwarder)).To(BeFalse())
+		})
+
+		It("should be a deployment when there are only receiver inputs", func() {
+			forwarder.Spec.Inputs = []obs.InputSpec{
+				{Type: obs.InputTypeReceiver},
+				{Type: obs.InputTypeReceiver},
+			}
+			Expect(DeployAsDeployment(forwarder)).To(BeTrue())
+		})
+
+		It("should be a deployment when there are only receiver and kafka inputs", func() {
+			forwarder.Spec.Inputs = []obs.InputSpec{
+				{Type: obs.InputTypeReceiver},
+				{Type: obs.InputTypeKafka},
+			}
+			Expect(DeployAsDeployment(forwarder)).To(BeTrue())
+		})
+
+		It("should not be a deployment without inputs", func() {
+			forwarder.Spec.Inputs = nil
+			Expect(DeployAsDeployment(forwarder)).To(BeFalse())
+		})
+	})
+
+	Context("#UseAggregator", func() {
+		var (
+			forwarder obs.ClusterLogForwarder
+		)
+		BeforeEach(func() {
+			forwarder = *obsruntime.NewClusterLogForwarder(constants.OpenshiftNS, constants.SingletonName, runtime.Initialize)
+			forwarder.Spec.Inputs = []obs.InputSpec{
+				{Type: obs.InputTypeApplication},
+			}
+			forwarder.Spec.Collector = &obs.CollectorSpec{
+				Aggregator: &obs.CollectorAggregatorSpec{},
+			}
+		})
+
+		It("should use an aggregator when it is defined for a daemonset", func() {
+			Expect(UseAggregator(forwarder)).To(BeTrue())
+		})
+
+		It("should not use an aggregator when it is not defined", func() {
+			forwarder.Spec.Collector.Aggregator = nil
+			Expect(UseAggregator(forwarder)).To(BeFalse())
+		})
+
+		It("should not use an aggregator when the collector is a deployment", func() {
+			forwarder.Spec.Inputs = []obs.InputSpec{
+				{Type: obs.InputTypeReceiver},
+			}
+			Expect(UseAggregator(forwarder)).To(BeFalse())
+		})
+	})
+
+	Context("#OutputSpecsFrom", func() {
+		It("should return the outputs of the pipelines consuming the input", func() {
+			spec := ClusterLogForwarderSpec{
+				Inputs: []obs.InputSpec{{Name: "my-kafka", Type: obs.InputTypeKafka}},
+				Outputs: []obs.OutputSpec{
+					{Name: "one", Type: obs.OutputTypeHTTP},
+					{Name: "two", Type: obs.OutputTypeKafka},
+					{Name: "three", Type: obs.OutputTypeSplunk},
+				},
+				Pipelines: []obs.PipelineSpec{
+					{Name: "a", InputRefs: []string{"my-kafka"}, OutputRefs: []string{"one", "two"}},
+					{Name: "b", InputRefs: []string{"application", "my-kafka"}, OutputRefs: []string{"two"}},
+					{Name: "c", InputRefs: []string{"application"}, OutputRefs: []string{"three"}},
+				},
+			}
+			outputs := spec.OutputSpecsFrom(spec.Inputs[0])
+			Expect(Outputs(outputs).Names()).To(Equal([]string{"one", "two"}))
 		})
 	})
 })
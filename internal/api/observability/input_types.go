@@ -1,8 +1,10 @@
 package observability
 
 import (
+	"path"
 	"regexp"
 	"sort"
+	"strings"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/utils/sets"
@@ -19,6 +21,19 @@ var (
 	ReservedInfrastructureSources = sets.NewString(obs.InfrastructureSourceContainer.String(), obs.InfrastructureSourceNode.String())
 	ReservedAuditSources          = sets.NewString(obs.AuditSourceKube.String(), obs.AuditSourceOpenShift.String(), obs.AuditSourceAuditd.String(), obs.AuditSourceOVN.String())
 
+	// ReservedNodeLogDirs are the directories of the node collected by sources other than the infrastructure file source
+	ReservedNodeLogDirs = sets.NewString(
+		"/var/log/pods",
+		"/var/log/containers",
+		"/var/log/journal",
+		"/var/log/audit",
+		"/var/log/ovn",
+		"/var/log/oauth-server",
+		"/var/log/oauth-apiserver",
+		"/var/log/openshift-apiserver",
+		"/var/log/kube-apiserver",
+	)
+
 	InfraNSRegex = regexp.MustCompile(`^(?P<default>default)|(?P<openshift>openshift.*)|(?P<kube>kube.*)$`)
 )
 
@@ -48,8 +63,26 @@ func Threshold(ls *obs.LimitSpec) (int64, bool) {
 	return ls.MaxRecordsPerSecond, true
 }
 
+// AllowsPodAnnotation returns true if the input honors the given pod annotation
+func AllowsPodAnnotation(input obs.InputSpec, key obs.PodAnnotationKey) bool {
+	if app := input.Application; app != nil {
+		return set.New(app.AllowedPodAnnotations...).Has(key)
+	}
+	return false
+}
+
 type Inputs []obs.InputSpec
 
+// AllowPodAnnotation returns true if any input honors the given pod annotation
+func (inputs Inputs) AllowPodAnnotation(key obs.PodAnnotationKey) bool {
+	for _, input := range inputs {
+		if AllowsPodAnnotation(input, key) {
+			return true
+		}
+	}
+	return false
+}
+
 func IncludesInfraNamespace(input *obs.Application) bool {
 	if input != nil {
 		for _, ns := range input.Includes {
@@ -124,6 +157,9 @@ func (inputs Inputs) ConfigmapNames() []string {
 		if i.Receiver != nil && i.Receiver.TLS != nil {
 			names.Insert(ConfigmapsForTLS(obs.TLSSpec(*i.Receiver.TLS))...)
 		}
+		if i.Kafka != nil && i.Kafka.TLS != nil {
+			names.Insert(ConfigmapsForTLS(i.Kafka.TLS.TLSSpec)...)
+		}
 	}
 	return names.UnsortedList()
 }
@@ -135,6 +171,27 @@ func (inputs Inputs) SecretNames() []string {
 		if i.Receiver != nil && i.Receiver.TLS != nil {
 			secrets.Insert(SecretsForTLS(obs.TLSSpec(*i.Receiver.TLS))...)
 		}
+		if i.Receiver != nil && i.Receiver.HTTP != nil && i.Receiver.HTTP.Authentication != nil && i.Receiver.HTTP.Authentication.Token != nil {
+			secrets.Insert(i.Receiver.HTTP.Authentication.Token.SecretName)
+		}
+		if i.Receiver != nil && i.Receiver.FluentForward != nil && i.Receiver.FluentForward.SharedKey != nil {
+			secrets.Insert(i.Receiver.FluentForward.SharedKey.SecretName)
+		}
+		if i.Receiver != nil && i.Receiver.SplunkHEC != nil {
+			for _, token := range i.Receiver.SplunkHEC.Tokens {
+				if token != nil {
+					secrets.Insert(token.SecretName)
+				}
+			}
+		}
+		if i.Kafka != nil {
+			if i.Kafka.TLS != nil {
+				secrets.Insert(SecretsForTLS(i.Kafka.TLS.TLSSpec)...)
+			}
+			for _, ref := range KafkaSecretReferences(i.Kafka.Authentication) {
+				secrets.Insert(ref.SecretName)
+			}
+		}
 	}
 	return secrets.UnsortedList()
 }
@@ -148,6 +205,43 @@ func (inputs Inputs) HasJournalSource() bool {
 	return false
 }
 
+// HasNodeFileSource returns true if any infrastructure input collects log files of the node
+func (inputs Inputs) HasNodeFileSource() bool {
+	return len(inputs.NodeFileDirs()) > 0
+}
+
+// NodeFileDirs returns the unique, sorted list of node directories which contain the files collected by
+// the infrastructure file sources. Directories nested in another directory of the list are omitted
+func (inputs Inputs) NodeFileDirs() (result []string) {
+	dirs := set.New[string]()
+	for _, i := range inputs {
+		if i.Type == obs.InputTypeInfrastructure && i.Infrastructure != nil && i.Infrastructure.Files != nil &&
+			set.New(i.Infrastructure.Sources...).Has(obs.InfrastructureSourceFile) {
+			for _, include := range i.Infrastructure.Files.Includes {
+				dirs.Insert(NodeFileDir(include))
+			}
+		}
+	}
+	for _, dir := range dirs.SortedList() {
+		if len(result) == 0 || !strings.HasPrefix(dir, result[len(result)-1]+"/") {
+			result = append(result, dir)
+		}
+	}
+	return result
+}
+
+// NodeFileDir returns the directory of a glob pattern up to the first path element which contains a wildcard
+// (e.g. /var/log/myapp for /var/log/myapp/*/*.log)
+func NodeFileDir(pattern string) string {
+	elements := strings.Split(path.Clean(pattern), "/")
+	for i, e := range elements {
+		if strings.ContainsAny(e, "*?[{") {
+			return path.Clean("/" + path.Join(elements[:i]...))
+		}
+	}
+	return path.Dir(path.Clean(pattern))
+}
+
 func (inputs Inputs) HasContainerSource() bool {
 	for _, i := range inputs {
 		if i.Type == obs.InputTypeApplication {
@@ -189,6 +283,87 @@ func (inputs Inputs) HasReceiverSource() bool {
 	return false
 }
 
+// HasHTTPLogReceiver returns true if any HTTP receiver accepts logs in a format other than kubeAPIAudit
+func (inputs Inputs) HasHTTPLogReceiver() bool {
+	for _, i := range inputs {
+		if i.Type == obs.InputTypeReceiver && i.Receiver != nil && i.Receiver.HTTP != nil && i.Receiver.HTTP.Format != obs.HTTPReceiverFormatKubeAPIAudit {
+			return true
+		}
+	}
+	return false
+}
+
+// ReceiverLogSources returns the unique, sorted log sources of the records received by the receiver inputs which are
+// not audit logs and of the records consumed by the kafka and events inputs
+func (inputs Inputs) ReceiverLogSources() []string {
+	sources := set.New[string]()
+	for _, i := range inputs {
+		if i.Type == obs.InputTypeKafka || i.Type == obs.InputTypeEvents {
+			sources.Insert(string(i.Type))
+		}
+		if i.Type != obs.InputTypeReceiver || i.Receiver == nil {
+			continue
+		}
+		switch i.Receiver.Type {
+		case obs.ReceiverTypeHTTP:
+			if i.Receiver.HTTP != nil && i.Receiver.HTTP.Format != obs.HTTPReceiverFormatKubeAPIAudit {
+				sources.Insert(string(obs.ReceiverTypeHTTP))
+			}
+		case obs.ReceiverTypeOTLP, obs.ReceiverTypeFluentForward, obs.ReceiverTypeSplunkHEC:
+			sources.Insert(string(i.Receiver.Type))
+		}
+	}
+	return sources.SortedList()
+}
+
+// HasOTLPReceiver returns true if any receiver accepts logs using the OpenTelemetry Protocol
+func (inputs Inputs) HasOTLPReceiver() bool {
+	return inputs.HasReceiverType(obs.ReceiverTypeOTLP)
+}
+
+// HasKafkaSource returns true if any input consumes logs from Kafka
+func (inputs Inputs) HasKafkaSource() bool {
+	for _, i := range inputs {
+		if i.Type == obs.InputTypeKafka && i.Kafka != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// HasEventsSource returns true if any input collects the events of the cluster
+func (inputs Inputs) HasEventsSource() bool {
+	for _, i := range inputs {
+		if i.Type == obs.InputTypeEvents {
+			return true
+		}
+	}
+	return false
+}
+
+// KafkaSecretReferences returns the secret references of the SASL username and password of a Kafka authentication
+func KafkaSecretReferences(auth *obs.KafkaAuthentication) (refs []*obs.SecretReference) {
+	if auth == nil || auth.SASL == nil {
+		return nil
+	}
+	for _, ref := range []*obs.SecretReference{auth.SASL.Username, auth.SASL.Password} {
+		if ref != nil {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// HasReceiverType returns true if any receiver is of the given type
+func (inputs Inputs) HasReceiverType(receiverType obs.ReceiverType) bool {
+	for _, i := range inputs {
+		if i.Type == obs.InputTypeReceiver && i.Receiver != nil && i.Receiver.Type == receiverType {
+			return true
+		}
+	}
+	return false
+}
+
 type InfrastructureSources []obs.InfrastructureSource
 
 func (infraSources InfrastructureSources) AsStrings() (result []string) {
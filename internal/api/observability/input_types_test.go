@@ -62,3 +62,197 @@ var _ = Describe("#InputSources", func() {
 		})
 	})
 })
+
+var _ = Describe("#HasHTTPLogReceiver", func() {
+
+	httpReceiver := func(format obs.HTTPReceiverFormat) obs.InputSpec {
+		return obs.InputSpec{
+			Name: "myreceiver",
+			Type: obs.InputTypeReceiver,
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeHTTP,
+				HTTP: &obs.HTTPReceiver{Format: format},
+			},
+		}
+	}
+
+	It("should be false for an HTTP receiver of audit logs", func() {
+		Expect(Inputs{httpReceiver(obs.HTTPReceiverFormatKubeAPIAudit)}.HasHTTPLogReceiver()).To(BeFalse())
+	})
+
+	It("should be true for an HTTP receiver of application logs", func() {
+		Expect(Inputs{httpReceiver(obs.HTTPReceiverFormatNDJSON)}.HasHTTPLogReceiver()).To(BeTrue())
+	})
+
+	It("should include the authentication token secret in the secret names", func() {
+		input := httpReceiver(obs.HTTPReceiverFormatJSON)
+		input.Receiver.HTTP.Authentication = &obs.HTTPReceiverAuthentication{
+			Token: &obs.SecretReference{Key: "token", SecretName: "receiver-token"},
+		}
+		Expect(Inputs{input}.SecretNames()).To(ConsistOf("receiver-token"))
+	})
+
+	It("should return the log source of an HTTP receiver of application logs", func() {
+		Expect(Inputs{httpReceiver(obs.HTTPReceiverFormatJSON)}.ReceiverLogSources()).To(Equal([]string{string(obs.ReceiverTypeHTTP)}))
+	})
+
+	It("should not return a log source for an HTTP receiver of audit logs", func() {
+		Expect(Inputs{httpReceiver(obs.HTTPReceiverFormatKubeAPIAudit)}.ReceiverLogSources()).To(BeEmpty())
+	})
+})
+
+var _ = Describe("#HasOTLPReceiver", func() {
+
+	It("should be true for an otlp receiver", func() {
+		inputs := Inputs{
+			{Name: "myreceiver", Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{Type: obs.ReceiverTypeOTLP, OTLP: &obs.OTLPReceiver{GRPCPort: 4317}}},
+		}
+		Expect(inputs.HasOTLPReceiver()).To(BeTrue())
+		Expect(inputs.ReceiverLogSources()).To(Equal([]string{string(obs.ReceiverTypeOTLP)}))
+	})
+
+	It("should be false for other receivers", func() {
+		inputs := Inputs{
+			{Name: "myreceiver", Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{Type: obs.ReceiverTypeSyslog}},
+		}
+		Expect(inputs.HasOTLPReceiver()).To(BeFalse())
+	})
+})
+
+var _ = Describe("#SecretNames", func() {
+
+	It("should include the HEC token secrets of splunkHEC receivers", func() {
+		inputs := Inputs{
+			{Name: "myreceiver", Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeSplunkHEC,
+				SplunkHEC: &obs.SplunkHECReceiver{
+					Tokens: []*obs.SecretReference{
+						{Key: "hecToken", SecretName: "hec-token"},
+						{Key: "hecToken", SecretName: "other-hec-token"},
+					},
+				},
+			}},
+		}
+		Expect(inputs.SecretNames()).To(ConsistOf("hec-token", "other-hec-token"))
+	})
+
+	It("should return the log source of splunkHEC receivers", func() {
+		inputs := Inputs{
+			{Name: "myreceiver", Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{Type: obs.ReceiverTypeSplunkHEC, SplunkHEC: &obs.SplunkHECReceiver{}}},
+		}
+		Expect(inputs.ReceiverLogSources()).To(Equal([]string{string(obs.ReceiverTypeSplunkHEC)}))
+	})
+
+	It("should include the shared key secret of fluentForward receivers", func() {
+		inputs := Inputs{
+			{Name: "myreceiver", Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeFluentForward,
+				FluentForward: &obs.FluentForwardReceiver{
+					SharedKey: &obs.SecretReference{Key: "shared_key", SecretName: "fluent-key"},
+				},
+			}},
+		}
+		Expect(inputs.SecretNames()).To(ConsistOf("fluent-key"))
+	})
+
+	It("should return the log source of fluentForward receivers", func() {
+		inputs := Inputs{
+			{Name: "myreceiver", Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{Type: obs.ReceiverTypeFluentForward}},
+		}
+		Expect(inputs.ReceiverLogSources()).To(Equal([]string{string(obs.ReceiverTypeFluentForward)}))
+	})
+
+	It("should include the SASL and TLS secrets of kafka inputs", func() {
+		inputs := Inputs{
+			{Name: "mykafka", Type: obs.InputTypeKafka, Kafka: &obs.KafkaInput{
+				Authentication: &obs.KafkaAuthentication{
+					SASL: &obs.SASLAuthentication{
+						Username: &obs.SecretReference{Key: "username", SecretName: "kafka-sasl"},
+						Password: &obs.SecretReference{Key: "password", SecretName: "kafka-sasl"},
+					},
+				},
+				TLS: &obs.OutputTLSSpec{
+					TLSSpec: obs.TLSSpec{
+						CA: &obs.ValueReference{Key: "ca-bundle.crt", SecretName: "kafka-tls"},
+					},
+				},
+			}},
+		}
+		Expect(inputs.SecretNames()).To(ConsistOf("kafka-sasl", "kafka-tls"))
+	})
+
+	It("should return the log source of kafka inputs", func() {
+		inputs := Inputs{
+			{Name: "mykafka", Type: obs.InputTypeKafka, Kafka: &obs.KafkaInput{}},
+		}
+		Expect(inputs.ReceiverLogSources()).To(Equal([]string{string(obs.InputTypeKafka)}))
+	})
+
+	It("should return the log source of events inputs", func() {
+		inputs := Inputs{
+			{Name: "myevents", Type: obs.InputTypeEvents, Events: &obs.EventsInput{}},
+		}
+		Expect(inputs.ReceiverLogSources()).To(Equal([]string{string(obs.InputTypeEvents)}))
+	})
+})
+
+var _ = Describe("#HasReceiverType", func() {
+
+	It("should only be true for the type of the receivers", func() {
+		inputs := Inputs{
+			{Name: "myreceiver", Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{Type: obs.ReceiverTypeFluentForward}},
+		}
+		Expect(inputs.HasReceiverType(obs.ReceiverTypeFluentForward)).To(BeTrue())
+		Expect(inputs.HasReceiverType(obs.ReceiverTypeSyslog)).To(BeFalse())
+	})
+})
+
+var _ = Describe("#NodeFileDirs", func() {
+
+	It("should return the unique top directories of the includes of the file sources", func() {
+		inputs := Inputs{
+			{Name: "gpu", Type: obs.InputTypeInfrastructure, Infrastructure: &obs.Infrastructure{
+				Sources: []obs.InfrastructureSource{obs.InfrastructureSourceFile},
+				Files: &obs.NodeFiles{
+					Includes: []string{"/var/log/gpu/*.log", "/var/log/gpu/driver/*.log", "/var/log/storage/*/daemon-?.log"},
+				},
+			}},
+			{Name: "storage", Type: obs.InputTypeInfrastructure, Infrastructure: &obs.Infrastructure{
+				Sources: []obs.InfrastructureSource{obs.InfrastructureSourceFile},
+				Files: &obs.NodeFiles{
+					Includes: []string{"/var/log/storage/**/*.log"},
+				},
+			}},
+		}
+		Expect(inputs.NodeFileDirs()).To(Equal([]string{"/var/log/gpu", "/var/log/storage"}))
+		Expect(inputs.HasNodeFileSource()).To(BeTrue())
+	})
+
+	It("should ignore the files of inputs not collecting the file source", func() {
+		inputs := Inputs{
+			{Name: "infra", Type: obs.InputTypeInfrastructure, Infrastructure: &obs.Infrastructure{
+				Sources: []obs.InfrastructureSource{obs.InfrastructureSourceNode},
+				Files: &obs.NodeFiles{
+					Includes: []string{"/var/log/gpu/*.log"},
+				},
+			}},
+		}
+		Expect(inputs.NodeFileDirs()).To(BeEmpty())
+		Expect(inputs.HasNodeFileSource()).To(BeFalse())
+	})
+})
+
+var _ = Describe("#AllowPodAnnotation", func() {
+
+	It("should only be true for the pod annotations allowed by an application input", func() {
+		inputs := Inputs{
+			{Name: "infra", Type: obs.InputTypeInfrastructure},
+			{Name: "app", Type: obs.InputTypeApplication, Application: &obs.Application{
+				AllowedPodAnnotations: []obs.PodAnnotationKey{obs.PodAnnotationCollect},
+			}},
+		}
+		Expect(inputs.AllowPodAnnotation(obs.PodAnnotationCollect)).To(BeTrue())
+		Expect(inputs.AllowPodAnnotation(obs.PodAnnotationPipelineHint)).To(BeFalse())
+		Expect(AllowsPodAnnotation(inputs[0], obs.PodAnnotationCollect)).To(BeFalse())
+	})
+})
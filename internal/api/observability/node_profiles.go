@@ -0,0 +1,63 @@
+package observability
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils/sets"
+)
+
+// NodeProfiles returns the node profiles of the collector or nil when the collector is deployed as a deployment
+func NodeProfiles(forwarder obs.ClusterLogForwarder) []obs.CollectorNodeProfile {
+	if forwarder.Spec.Collector == nil || DeployAsDeployment(forwarder) {
+		return nil
+	}
+	return forwarder.Spec.Collector.NodeProfiles
+}
+
+// NodeProfileSpec returns the spec of the forwarder reduced to the inputs collected on the nodes of a node profile and
+// the pipelines, filters and outputs consuming them. Receiver inputs are served by the collector of the forwarder and
+// are never collected on the nodes of a profile
+func NodeProfileSpec(spec obs.ClusterLogForwarderSpec, profile obs.CollectorNodeProfile) obs.ClusterLogForwarderSpec {
+	profileSpec := *spec.DeepCopy()
+	inputRefs := sets.NewString(profile.InputRefs...)
+	profileSpec.Inputs = nil
+	for _, input := range spec.Inputs {
+		if input.Type != obs.InputTypeReceiver && (inputRefs.Len() == 0 || inputRefs.Has(input.Name)) {
+			profileSpec.Inputs = append(profileSpec.Inputs, *input.DeepCopy())
+		}
+	}
+
+	inputs := sets.NewString(Inputs(profileSpec.Inputs).Names()...)
+	filters := sets.NewString()
+	outputs := sets.NewString()
+	profileSpec.Pipelines = nil
+	for _, pipeline := range spec.Pipelines {
+		var pipelineInputs []string
+		for _, ref := range pipeline.InputRefs {
+			if inputs.Has(ref) {
+				pipelineInputs = append(pipelineInputs, ref)
+			}
+		}
+		if len(pipelineInputs) == 0 {
+			continue
+		}
+		profilePipeline := *pipeline.DeepCopy()
+		profilePipeline.InputRefs = pipelineInputs
+		profileSpec.Pipelines = append(profileSpec.Pipelines, profilePipeline)
+		filters.Insert(pipeline.FilterRefs...)
+		outputs.Insert(pipeline.OutputRefs...)
+	}
+
+	profileSpec.Filters = nil
+	for _, filter := range spec.Filters {
+		if filters.Has(filter.Name) {
+			profileSpec.Filters = append(profileSpec.Filters, *filter.DeepCopy())
+		}
+	}
+	profileSpec.Outputs = nil
+	for _, output := range spec.Outputs {
+		if outputs.Has(output.Name) {
+			profileSpec.Outputs = append(profileSpec.Outputs, *output.DeepCopy())
+		}
+	}
+	return profileSpec
+}
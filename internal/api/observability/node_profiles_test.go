@@ -0,0 +1,52 @@
+package observability
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("#NodeProfileSpec", func() {
+	var (
+		spec obs.ClusterLogForwarderSpec
+	)
+	BeforeEach(func() {
+		spec = obs.ClusterLogForwarderSpec{
+			Inputs: []obs.InputSpec{
+				{Name: "my-app", Type: obs.InputTypeApplication, Application: &obs.Application{}},
+				{Name: "my-infra", Type: obs.InputTypeInfrastructure, Infrastructure: &obs.Infrastructure{}},
+				{Name: "my-receiver", Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{Type: obs.ReceiverTypeHTTP}},
+			},
+			Filters: []obs.FilterSpec{
+				{Name: "my-app-filter", Type: obs.FilterTypeDetectMultiline},
+				{Name: "my-infra-filter", Type: obs.FilterTypeDetectMultiline},
+			},
+			Outputs: []obs.OutputSpec{
+				{Name: "app-out", Type: obs.OutputTypeHTTP},
+				{Name: "infra-out", Type: obs.OutputTypeHTTP},
+				{Name: "shared-out", Type: obs.OutputTypeHTTP},
+			},
+			Pipelines: []obs.PipelineSpec{
+				{Name: "app", InputRefs: []string{"my-app", "my-receiver"}, FilterRefs: []string{"my-app-filter"}, OutputRefs: []string{"app-out", "shared-out"}},
+				{Name: "infra", InputRefs: []string{"my-infra"}, FilterRefs: []string{"my-infra-filter"}, OutputRefs: []string{"infra-out", "shared-out"}},
+			},
+		}
+	})
+
+	It("should collect all inputs except receivers when the profile does not reference inputs", func() {
+		profileSpec := NodeProfileSpec(spec, obs.CollectorNodeProfile{Name: "gpu"})
+		Expect(Inputs(profileSpec.Inputs).Names()).To(Equal([]string{"my-app", "my-infra"}))
+		Expect(Pipelines(profileSpec.Pipelines).Names()).To(Equal([]string{"app", "infra"}))
+		Expect(profileSpec.Pipelines[0].InputRefs).To(Equal([]string{"my-app"}))
+		Expect(profileSpec.Outputs).To(HaveLen(3))
+		Expect(spec.Pipelines[0].InputRefs).To(Equal([]string{"my-app", "my-receiver"}), "Exp. the spec of the forwarder to not be modified")
+	})
+
+	It("should only keep the pipelines, filters and outputs consuming the inputs of the profile", func() {
+		profileSpec := NodeProfileSpec(spec, obs.CollectorNodeProfile{Name: "infra", InputRefs: []string{"my-infra"}})
+		Expect(Inputs(profileSpec.Inputs).Names()).To(Equal([]string{"my-infra"}))
+		Expect(Pipelines(profileSpec.Pipelines).Names()).To(Equal([]string{"infra"}))
+		Expect(Filters(profileSpec.Filters).Names()).To(Equal([]string{"my-infra-filter"}))
+		Expect(Outputs(profileSpec.Outputs).Names()).To(Equal([]string{"infra-out", "shared-out"}))
+	})
+})
@@ -2,7 +2,10 @@ package observability
 
 import (
 	"fmt"
+	"net/url"
 	"os"
+	"path"
+	"strings"
 
 	log "github.com/ViaQ/logerr/v2/log/static"
 	obsv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
@@ -32,6 +35,16 @@ func (outputs Outputs) Map() map[string]obsv1.OutputSpec {
 	return m
 }
 
+// ReferenceDeadLetter returns true if any other output references the given output as its dead-letter output
+func (outputs Outputs) ReferenceDeadLetter(output obsv1.OutputSpec) bool {
+	for _, o := range outputs {
+		if o.Name != output.Name && o.PreDeliveryRejectionsOutputRef == output.Name {
+			return true
+		}
+	}
+	return false
+}
+
 // ConfigmapNames returns a unique set of unordered configmap names
 func (outputs Outputs) ConfigmapNames() []string {
 	names := set.New[string]()
@@ -39,10 +52,26 @@ func (outputs Outputs) ConfigmapNames() []string {
 		if o.TLS != nil {
 			names.Insert(ConfigmapsForTLS(o.TLS.TLSSpec)...)
 		}
+		for _, ref := range ConfigmapReferences(o) {
+			names.Insert(ref.ConfigMapName)
+		}
 	}
 	return names.UnsortedList()
 }
 
+// ConfigmapReferences returns the configmap keys referenced by the codec of an output
+func ConfigmapReferences(o obsv1.OutputSpec) (refs []*obsv1.ConfigMapReference) {
+	if o.Type == obsv1.OutputTypeHTTP && o.HTTP != nil && o.HTTP.Codec != nil {
+		if o.HTTP.Codec.Protobuf != nil {
+			refs = append(refs, &o.HTTP.Codec.Protobuf.Descriptor)
+		}
+		if o.HTTP.Codec.Avro != nil {
+			refs = append(refs, &o.HTTP.Codec.Avro.Schema)
+		}
+	}
+	return refs
+}
+
 // NeedServiceAccountToken returns true if any output needs to be configured to use a projected service account token
 func (outputs Outputs) NeedServiceAccountToken() bool {
 	for _, o := range outputs {
@@ -53,6 +82,29 @@ func (outputs Outputs) NeedServiceAccountToken() bool {
 	return false
 }
 
+// UnixSocketDirs returns the unique, sorted list of node directories which contain the unix sockets of the socket outputs
+func (outputs Outputs) UnixSocketDirs() []string {
+	dirs := set.New[string]()
+	for _, o := range outputs {
+		if socketPath := UnixSocketPath(o); socketPath != "" {
+			dirs.Insert(path.Dir(socketPath))
+		}
+	}
+	return dirs.SortedList()
+}
+
+// UnixSocketPath returns the path of the unix socket of a socket output with the `unix` scheme or an empty string
+func UnixSocketPath(o obsv1.OutputSpec) string {
+	if o.Type != obsv1.OutputTypeSocket || o.Socket == nil {
+		return ""
+	}
+	u, err := url.Parse(o.Socket.URL)
+	if err != nil || strings.ToLower(u.Scheme) != "unix" {
+		return ""
+	}
+	return u.Path
+}
+
 // needsServiceAccountToken returns true if the output requires service account token projection
 func needsServiceAccountToken(o obsv1.OutputSpec) bool {
 	token := getOutputBearerToken(o)
@@ -181,11 +233,19 @@ func SecretReferences(o obsv1.OutputSpec) []*obsv1.SecretReference {
 		if o.LokiStack != nil {
 			return lokiStackKeys(o.LokiStack.Authentication)
 		}
+	case obsv1.OutputTypeDatadog:
+		if o.Datadog != nil && o.Datadog.Authentication != nil {
+			return []*obsv1.SecretReference{o.Datadog.Authentication.APIKey}
+		}
+	case obsv1.OutputTypeNewRelic:
+		if o.NewRelic != nil && o.NewRelic.Authentication != nil {
+			return []*obsv1.SecretReference{o.NewRelic.Authentication.LicenseKey}
+		}
 	case obsv1.OutputTypeSplunk:
 		if o.Splunk != nil && o.Splunk.Authentication != nil {
 			return []*obsv1.SecretReference{o.Splunk.Authentication.Token}
 		}
-	case obsv1.OutputTypeSyslog:
+	case obsv1.OutputTypeSocket, obsv1.OutputTypeSyslog:
 	default:
 		log.V(0).Error(OutputTypeUnknown(o.Type), "Found unsupported output type while gathering secret names")
 		os.Exit(1)
@@ -30,6 +30,23 @@ var _ = Describe("helpers for output types", func() {
 		})
 
 	})
+
+	Context("#UnixSocketDirs", func() {
+
+		It("should return the unique directories of the unix sockets of the socket outputs", func() {
+			socket := func(name, url string) obsv1.OutputSpec {
+				return obsv1.OutputSpec{Name: name, Type: obsv1.OutputTypeSocket, Socket: &obsv1.Socket{URL: url}}
+			}
+			outputs := Outputs{
+				socket("b", "unix:///var/run/collector/b.sock"),
+				socket("a", "unix:///var/run/collector/a.sock"),
+				socket("tcp", "tcp://collector.example.com:5170"),
+				socket("other", "UNIX:///run/other.sock"),
+			}
+			Expect(outputs.UnixSocketDirs()).To(Equal([]string{"/run", "/var/run/collector"}))
+			Expect(UnixSocketPath(outputs[2])).To(BeEmpty())
+		})
+	})
 })
 
 var _ = Describe("AzureLogsIngestion secret handling", func() {
@@ -0,0 +1,134 @@
+package observability
+
+import (
+	"fmt"
+	"hash/fnv"
+	"reflect"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	secretReferenceType      = reflect.TypeOf(obs.SecretReference{})
+	valueReferenceType       = reflect.TypeOf(obs.ValueReference{})
+	bearerTokenSecretKeyType = reflect.TypeOf(obs.BearerTokenSecretKey{})
+)
+
+// TenantName returns the name identifying the LogForwarder of a tenant in the spec of the forwarder serving it
+func TenantName(tenant obs.LogForwarder) string {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(tenant.Namespace + "/" + tenant.Name))
+	return fmt.Sprintf("tenant-%08x", hash.Sum32())
+}
+
+// TenantSecretName returns the name of the copy of a secret of a tenant in the namespace of the forwarder serving it
+func TenantSecretName(forwarderName string, tenant obs.LogForwarder, secretName string) string {
+	return fmt.Sprintf("%s-%s-%s", forwarderName, TenantName(tenant), secretName)
+}
+
+// TenantForwarder returns the LogForwarder of a tenant as a forwarder of the container logs of its namespace
+func TenantForwarder(tenant obs.LogForwarder) obs.ClusterLogForwarder {
+	spec := tenant.Spec.DeepCopy()
+	forwarder := obs.ClusterLogForwarder{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: tenant.Namespace,
+			Name:      tenant.Name,
+		},
+		Spec: obs.ClusterLogForwarderSpec{
+			Inputs: []obs.InputSpec{
+				{
+					Name: tenant.Name,
+					Type: obs.InputTypeApplication,
+					Application: &obs.Application{
+						Selector: spec.Selector,
+						Includes: []obs.NamespaceContainerSpec{{Namespace: tenant.Namespace}},
+					},
+				},
+			},
+			Outputs: spec.Outputs,
+			Filters: spec.Filters,
+		},
+	}
+	for _, p := range spec.Pipelines {
+		forwarder.Spec.Pipelines = append(forwarder.Spec.Pipelines, obs.PipelineSpec{
+			Name:       p.Name,
+			InputRefs:  []string{tenant.Name},
+			OutputRefs: p.OutputRefs,
+			FilterRefs: p.FilterRefs,
+		})
+	}
+	return forwarder
+}
+
+// TenantSpec returns the inputs, outputs, filters and pipelines of the LogForwarder of a tenant to be added to the spec
+// of the forwarder serving it. The names are prefixed by the name of the tenant and the secrets are replaced by their
+// copies in the namespace of the forwarder
+func TenantSpec(forwarderName string, tenant obs.LogForwarder) obs.ClusterLogForwarderSpec {
+	tenantName := TenantName(tenant)
+	prefixed := func(name string) string {
+		if name == "" {
+			return name
+		}
+		return tenantName + "-" + name
+	}
+	prefixAll := func(names []string) (result []string) {
+		for _, name := range names {
+			result = append(result, prefixed(name))
+		}
+		return result
+	}
+	forwarder := TenantForwarder(tenant)
+	spec := forwarder.Spec
+	spec.Inputs[0].Name = tenantName
+	for i := range spec.Outputs {
+		o := &spec.Outputs[i]
+		o.Name = prefixed(o.Name)
+		o.PreDeliveryRejectionsOutputRef = prefixed(o.PreDeliveryRejectionsOutputRef)
+		renameSecretReferences(reflect.ValueOf(o).Elem(), func(name string) string {
+			return TenantSecretName(forwarderName, tenant, name)
+		})
+	}
+	for i := range spec.Filters {
+		spec.Filters[i].Name = prefixed(spec.Filters[i].Name)
+	}
+	for i := range spec.Pipelines {
+		p := &spec.Pipelines[i]
+		p.Name = prefixed(p.Name)
+		p.InputRefs = []string{tenantName}
+		p.OutputRefs = prefixAll(p.OutputRefs)
+		p.FilterRefs = prefixAll(p.FilterRefs)
+	}
+	return spec
+}
+
+// renameSecretReferences renames the secrets referenced by the exported fields of a value
+func renameSecretReferences(v reflect.Value, rename func(string) string) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			renameSecretReferences(v.Elem(), rename)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			renameSecretReferences(v.Index(i), rename)
+		}
+	case reflect.Struct:
+		switch v.Type() {
+		case secretReferenceType, valueReferenceType:
+			if name := v.FieldByName("SecretName"); name.String() != "" {
+				name.SetString(rename(name.String()))
+			}
+			return
+		case bearerTokenSecretKeyType:
+			name := v.FieldByName("Name")
+			name.SetString(rename(name.String()))
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				renameSecretReferences(v.Field(i), rename)
+			}
+		}
+	}
+}
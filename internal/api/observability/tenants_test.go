@@ -0,0 +1,85 @@
+package observability
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Tenants", func() {
+	var (
+		tenant obs.LogForwarder
+	)
+	BeforeEach(func() {
+		tenant = obs.LogForwarder{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "logs"},
+			Spec: obs.LogForwarderSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				Outputs: []obs.OutputSpec{
+					{
+						Name: "my-http",
+						Type: obs.OutputTypeHTTP,
+						HTTP: &obs.HTTP{
+							URLSpec: obs.URLSpec{URL: "https://logs.team-a.example.com"},
+							Authentication: &obs.HTTPAuthentication{
+								Token: &obs.BearerToken{From: obs.BearerTokenFromSecret, Secret: &obs.BearerTokenSecretKey{Name: "token", Key: "token"}},
+							},
+						},
+						TLS: &obs.OutputTLSSpec{
+							TLSSpec: obs.TLSSpec{CA: &obs.ValueReference{SecretName: "tls", Key: "ca.crt"}},
+						},
+						Limit:                          &obs.LimitSpec{MaxRecordsPerSecond: 500},
+						PreDeliveryRejectionsOutputRef: "my-splunk",
+					},
+					{
+						Name: "my-splunk",
+						Type: obs.OutputTypeSplunk,
+						Splunk: &obs.Splunk{
+							URLSpec:        obs.URLSpec{URL: "https://splunk.team-a.example.com"},
+							Authentication: &obs.SplunkAuthentication{Token: &obs.SecretReference{SecretName: "hec", Key: "token"}},
+						},
+					},
+				},
+				Filters: []obs.FilterSpec{{Name: "multiline", Type: obs.FilterTypeDetectMultiline}},
+				Pipelines: []obs.LogForwarderPipelineSpec{
+					{Name: "to-http", FilterRefs: []string{"multiline"}, OutputRefs: []string{"my-http"}},
+				},
+			},
+		}
+	})
+
+	It("should forward the container logs of the namespace of the tenant", func() {
+		forwarder := TenantForwarder(tenant)
+		Expect(forwarder.Spec.Inputs).To(HaveLen(1))
+		Expect(forwarder.Spec.Inputs[0].Application.Includes).To(Equal([]obs.NamespaceContainerSpec{{Namespace: "team-a"}}))
+		Expect(forwarder.Spec.Inputs[0].Application.Selector).To(Equal(tenant.Spec.Selector))
+		Expect(forwarder.Spec.Pipelines[0].InputRefs).To(Equal([]string{"logs"}))
+	})
+
+	It("should prefix the names of the spec of the tenant and reference the copies of its secrets", func() {
+		name := TenantName(tenant)
+		Expect(name).To(MatchRegexp(`^tenant-[0-9a-f]{8}$`))
+		spec := TenantSpec("collector", tenant)
+		Expect(Inputs(spec.Inputs).Names()).To(Equal([]string{name}))
+		Expect(Outputs(spec.Outputs).Names()).To(Equal([]string{name + "-my-http", name + "-my-splunk"}))
+		Expect(spec.Outputs[0].PreDeliveryRejectionsOutputRef).To(Equal(name + "-my-splunk"))
+		Expect(Filters(spec.Filters).Names()).To(Equal([]string{name + "-multiline"}))
+		Expect(spec.Pipelines).To(Equal([]obs.PipelineSpec{
+			{Name: name + "-to-http", InputRefs: []string{name}, FilterRefs: []string{name + "-multiline"}, OutputRefs: []string{name + "-my-http"}},
+		}))
+		Expect(Outputs(spec.Outputs).SecretNames()).To(ConsistOf(
+			"collector-"+name+"-token",
+			"collector-"+name+"-tls",
+			"collector-"+name+"-hec",
+		))
+		Expect(tenant.Spec.Outputs[0].HTTP.Authentication.Token.Secret.Name).To(Equal("token"), "Exp. the LogForwarder to not be modified")
+	})
+
+	It("should keep the rate limit of the outputs of the tenant", func() {
+		spec := TenantSpec("collector", tenant)
+		Expect(spec.Inputs[0].Application.Tuning).To(BeNil())
+		Expect(spec.Outputs[0].Limit.MaxRecordsPerSecond).To(BeEquivalentTo(500))
+		Expect(spec.Outputs[1].Limit).To(BeNil())
+	})
+})
@@ -38,6 +38,11 @@ func NewTuning(spec obs.OutputSpec) Tuning {
 			t.BaseOutputTuningSpec = spec.S3.Tuning.BaseOutputTuningSpec
 			t.Compression = spec.S3.Tuning.Compression
 		}
+	case obs.OutputTypeDatadog:
+		if spec.Datadog != nil && spec.Datadog.Tuning != nil {
+			t.BaseOutputTuningSpec = spec.Datadog.Tuning.BaseOutputTuningSpec
+			t.Compression = spec.Datadog.Tuning.Compression
+		}
 	case obs.OutputTypeElasticsearch:
 		if spec.Elasticsearch != nil && spec.Elasticsearch.Tuning != nil {
 			t.BaseOutputTuningSpec = spec.Elasticsearch.Tuning.BaseOutputTuningSpec
@@ -69,11 +74,20 @@ func NewTuning(spec obs.OutputSpec) Tuning {
 			t.BaseOutputTuningSpec = spec.LokiStack.Tuning.BaseOutputTuningSpec
 			t.Compression = spec.LokiStack.Tuning.Compression
 		}
+	case obs.OutputTypeNewRelic:
+		if spec.NewRelic != nil && spec.NewRelic.Tuning != nil {
+			t.BaseOutputTuningSpec = spec.NewRelic.Tuning.BaseOutputTuningSpec
+			t.Compression = spec.NewRelic.Tuning.Compression
+		}
 	case obs.OutputTypeSplunk:
 		if spec.Splunk != nil && spec.Splunk.Tuning != nil {
 			t.BaseOutputTuningSpec = spec.Splunk.Tuning.BaseOutputTuningSpec
 			t.Compression = spec.Splunk.Tuning.Compression
 		}
+	case obs.OutputTypeSocket:
+		if spec.Socket != nil && spec.Socket.Tuning != nil {
+			t.DeliveryMode = spec.Socket.Tuning.DeliveryMode
+		}
 	case obs.OutputTypeSyslog:
 		if spec.Syslog != nil && spec.Syslog.Tuning != nil {
 			t.DeliveryMode = spec.Syslog.Tuning.DeliveryMode
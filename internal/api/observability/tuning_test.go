@@ -67,6 +67,24 @@ var _ = Describe("Output", func() {
 				},
 			},
 		}, baseSpec, compression),
+		Entry("with Datadog", obs.OutputSpec{
+			Type: obs.OutputTypeDatadog,
+			Datadog: &obs.Datadog{
+				Tuning: &obs.DatadogTuningSpec{
+					BaseOutputTuningSpec: *baseSpec,
+					Compression:          compression,
+				},
+			},
+		}, baseSpec, compression),
+		Entry("with NewRelic", obs.OutputSpec{
+			Type: obs.OutputTypeNewRelic,
+			NewRelic: &obs.NewRelic{
+				Tuning: &obs.NewRelicTuningSpec{
+					BaseOutputTuningSpec: *baseSpec,
+					Compression:          compression,
+				},
+			},
+		}, baseSpec, compression),
 		Entry("with Elasticsearch", obs.OutputSpec{
 			Type: obs.OutputTypeElasticsearch,
 			Elasticsearch: &obs.Elasticsearch{
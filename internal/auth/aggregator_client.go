@@ -0,0 +1,177 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/reconcile"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// AggregatorClientCertValidity is the validity of the client certificate of the agent tier
+	AggregatorClientCertValidity = 365 * 24 * time.Hour
+
+	// AggregatorClientCertRenewBefore is the remaining validity of the client certificate below which it is reissued
+	AggregatorClientCertRenewBefore = 30 * 24 * time.Hour
+
+	// AggregatorPreviousCAKey is the key of the secret holding the CA of the client certificate it replaced
+	AggregatorPreviousCAKey = "previous-ca-bundle.crt"
+)
+
+// ReconcileAggregatorClientCertificate reconciles the secret holding the client certificate the agent tier presents to
+// the aggregator tier and the configmap of the CA the aggregator tier uses to verify it. The CA is generated for the
+// forwarder and its key is discarded once the client certificate is signed so no other certificate is issued by it.
+// The certificate is reissued along with a new CA when it is missing, invalid or about to expire. The previous CA is
+// trusted along with the new one until it expires so the agents presenting the previous certificate until they are
+// rolled out are not rejected
+func ReconcileAggregatorClientCertificate(k8sClient client.Client, reader client.Reader, namespace, secretName, caConfigMapName, commonName string, owner metav1.OwnerReference) (secret *corev1.Secret, caBundle *corev1.ConfigMap, err error) {
+	current := &corev1.Secret{}
+	key := client.ObjectKey{Namespace: namespace, Name: secretName}
+	found := true
+	if err = k8sClient.Get(context.TODO(), key, current); err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("failed to get %s client certificate secret: %w", secretName, err)
+		}
+		found = false
+	}
+
+	secret = current
+	if !found || !isValidClientCertificate(current.Data, time.Now().Add(AggregatorClientCertRenewBefore)) {
+		var caPEM, certPEM, keyPEM []byte
+		if caPEM, certPEM, keyPEM, err = NewAggregatorClientCertificate(commonName, AggregatorClientCertValidity); err != nil {
+			return nil, nil, err
+		}
+		data := map[string][]byte{
+			constants.ClientCertKey:      certPEM,
+			constants.ClientPrivateKey:   keyPEM,
+			constants.TrustedCABundleKey: caPEM,
+		}
+		if previous := unexpiredCertificates(current.Data[constants.TrustedCABundleKey], time.Now()); found && len(previous) > 0 {
+			data[AggregatorPreviousCAKey] = previous
+		}
+		secret = runtime.NewSecret(namespace, secretName, data)
+		utils.AddOwnerRefToObject(secret, owner)
+		if !found {
+			err = k8sClient.Create(context.TODO(), secret)
+		} else {
+			secret.ResourceVersion = current.ResourceVersion
+			err = k8sClient.Update(context.TODO(), secret)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to reconcile %s client certificate secret: %w", secretName, err)
+		}
+	}
+
+	trusted := append(append([]byte{}, secret.Data[constants.TrustedCABundleKey]...), unexpiredCertificates(secret.Data[AggregatorPreviousCAKey], time.Now())...)
+	caBundle = runtime.NewConfigMap(namespace, caConfigMapName, map[string]string{
+		constants.TrustedCABundleKey: string(trusted),
+	})
+	utils.AddOwnerRefToObject(caBundle, owner)
+	if err = reconcile.Configmap(k8sClient, reader, caBundle); err != nil {
+		return nil, nil, err
+	}
+	return secret, caBundle, nil
+}
+
+// isValidClientCertificate returns true when the client certificate is signed by the CA of the data and is valid until the given time
+func isValidClientCertificate(data map[string][]byte, until time.Time) bool {
+	certBlock, _ := pem.Decode(data[constants.ClientCertKey])
+	if certBlock == nil {
+		return false
+	}
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil || cert.NotAfter.Before(until) || len(data[constants.ClientPrivateKey]) == 0 {
+		return false
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(data[constants.TrustedCABundleKey]) {
+		return false
+	}
+	_, err = cert.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+	return err == nil
+}
+
+// unexpiredCertificates returns the PEM encoded certificates of a bundle which are valid at the given time
+func unexpiredCertificates(bundle []byte, at time.Time) (valid []byte) {
+	for block, rest := pem.Decode(bundle); block != nil; block, rest = pem.Decode(rest) {
+		if cert, err := x509.ParseCertificate(block.Bytes); err == nil && at.Before(cert.NotAfter) {
+			valid = append(valid, pem.EncodeToMemory(block)...)
+		}
+	}
+	return valid
+}
+
+// NewAggregatorClientCertificate returns the PEM encoded CA, client certificate and client key used by the agent tier
+// to authenticate to the aggregator tier. The certificate is only valid for client authentication
+func NewAggregatorClientCertificate(commonName string, validity time.Duration) (caPEM, certPEM, keyPEM []byte, err error) {
+	now := time.Now()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          newSerialNumber(),
+		Subject:               pkix.Name{CommonName: fmt.Sprintf("%s-ca@%d", commonName, now.Unix())},
+		NotBefore:             now.Add(-5 * time.Minute),
+		NotAfter:              now.Add(validity),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	clientTemplate := &x509.Certificate{
+		SerialNumber: newSerialNumber(),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    now.Add(-5 * time.Minute),
+		NotAfter:     now.Add(validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	clientDER, err := x509.CreateCertificate(rand.Reader, clientTemplate, caTemplate, &clientKey.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	clientKeyDER, err := x509.MarshalECPrivateKey(clientKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return encodePEM("CERTIFICATE", caDER), encodePEM("CERTIFICATE", clientDER), encodePEM("EC PRIVATE KEY", clientKeyDER), nil
+}
+
+func newSerialNumber() *big.Int {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 62))
+	if err != nil {
+		return big.NewInt(time.Now().UnixNano())
+	}
+	return serial
+}
+
+func encodePEM(blockType string, der []byte) []byte {
+	var b bytes.Buffer
+	_ = pem.Encode(&b, &pem.Block{Type: blockType, Bytes: der})
+	return b.Bytes()
+}
@@ -0,0 +1,110 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/cluster-logging-operator/internal/auth"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("ReconcileAggregatorClientCertificate", func() {
+
+	const (
+		namespace  = "openshift-logging"
+		secretName = "collector-aggregator-client"
+		caName     = "collector-aggregator-client-ca"
+	)
+
+	var (
+		k8sClient client.Client
+		owner     = metav1.OwnerReference{APIVersion: "v1", Kind: "ClusterLogForwarder", Name: "collector"}
+
+		parse = func(data []byte) *x509.Certificate {
+			block, _ := pem.Decode(data)
+			Expect(block).ToNot(BeNil())
+			cert, err := x509.ParseCertificate(block.Bytes)
+			Expect(err).ToNot(HaveOccurred())
+			return cert
+		}
+	)
+
+	BeforeEach(func() {
+		k8sClient = fake.NewClientBuilder().Build()
+	})
+
+	It("should issue a client certificate which is only valid for client authentication", func() {
+		secret, caBundle, err := auth.ReconcileAggregatorClientCertificate(k8sClient, k8sClient, namespace, secretName, caName, "collector", owner)
+		Expect(err).ToNot(HaveOccurred())
+
+		cert := parse(secret.Data[constants.ClientCertKey])
+		Expect(cert.ExtKeyUsage).To(Equal([]x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}))
+		Expect(caBundle.Data[constants.TrustedCABundleKey]).To(Equal(string(secret.Data[constants.TrustedCABundleKey])))
+
+		roots := x509.NewCertPool()
+		Expect(roots.AppendCertsFromPEM([]byte(caBundle.Data[constants.TrustedCABundleKey]))).To(BeTrue())
+		_, err = cert.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = cert.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}})
+		Expect(err).To(HaveOccurred(), "Exp. the client certificate to not be usable as a serving certificate")
+	})
+
+	It("should keep a valid client certificate", func() {
+		first, _, err := auth.ReconcileAggregatorClientCertificate(k8sClient, k8sClient, namespace, secretName, caName, "collector", owner)
+		Expect(err).ToNot(HaveOccurred())
+		second, _, err := auth.ReconcileAggregatorClientCertificate(k8sClient, k8sClient, namespace, secretName, caName, "collector", owner)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(second.Data).To(Equal(first.Data))
+	})
+
+	It("should reissue a client certificate which is about to expire", func() {
+		caPEM, certPEM, keyPEM, err := auth.NewAggregatorClientCertificate("collector", auth.AggregatorClientCertRenewBefore-time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(k8sClient.Create(context.TODO(), runtime.NewSecret(namespace, secretName, map[string][]byte{
+			constants.ClientCertKey:      certPEM,
+			constants.ClientPrivateKey:   keyPEM,
+			constants.TrustedCABundleKey: caPEM,
+		}))).To(Succeed())
+
+		secret, caBundle, err := auth.ReconcileAggregatorClientCertificate(k8sClient, k8sClient, namespace, secretName, caName, "collector", owner)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret.Data[constants.ClientCertKey]).ToNot(Equal(certPEM))
+		Expect(secret.Data[auth.AggregatorPreviousCAKey]).To(Equal(caPEM))
+
+		roots := x509.NewCertPool()
+		Expect(roots.AppendCertsFromPEM([]byte(caBundle.Data[constants.TrustedCABundleKey]))).To(BeTrue())
+		for _, issued := range [][]byte{certPEM, secret.Data[constants.ClientCertKey]} {
+			_, err = parse(issued).Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+			Expect(err).ToNot(HaveOccurred(), "Exp. the previous and the new client certificates to be trusted by the aggregator")
+		}
+		Expect(parse(secret.Data[constants.ClientCertKey]).NotAfter).To(BeTemporally(">", time.Now().Add(auth.AggregatorClientCertRenewBefore)))
+
+		current := &corev1.Secret{}
+		Expect(k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(secret), current)).To(Succeed())
+		Expect(current.Data).To(Equal(secret.Data))
+	})
+
+	It("should not trust a previous CA which has expired", func() {
+		caPEM, certPEM, keyPEM, err := auth.NewAggregatorClientCertificate("collector", -time.Minute)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(k8sClient.Create(context.TODO(), runtime.NewSecret(namespace, secretName, map[string][]byte{
+			constants.ClientCertKey:      certPEM,
+			constants.ClientPrivateKey:   keyPEM,
+			constants.TrustedCABundleKey: caPEM,
+		}))).To(Succeed())
+
+		secret, caBundle, err := auth.ReconcileAggregatorClientCertificate(k8sClient, k8sClient, namespace, secretName, caName, "collector", owner)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret.Data).ToNot(HaveKey(auth.AggregatorPreviousCAKey))
+		Expect(caBundle.Data[constants.TrustedCABundleKey]).To(Equal(string(secret.Data[constants.TrustedCABundleKey])))
+	})
+})
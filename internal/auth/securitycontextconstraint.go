@@ -3,8 +3,11 @@ package auth
 import (
 	"context"
 	"fmt"
+	"path"
+	"strings"
 
 	security "github.com/openshift/api/security/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/runtime"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
 	corev1 "k8s.io/api/core/v1"
@@ -28,6 +31,10 @@ var (
 	}
 
 	DesiredSCCVolumes = []security.FSType{"configMap", "secret", "emptyDir", "projected"}
+
+	// AllowedNodeLogPaths are the directories of the node below which log files defined by users may be mounted.
+	// The SCC allows or denies hostPath volumes as a whole, so the paths must be verified before they are mounted
+	AllowedNodeLogPaths = []string{constants.NodeLogDir}
 )
 
 func NewSCC() *security.SecurityContextConstraints {
@@ -51,6 +58,17 @@ func NewSCC() *security.SecurityContextConstraints {
 	return scc
 }
 
+// IsAllowedNodeLogPath returns true if the directory is below one of the allowed node log paths
+func IsAllowedNodeLogPath(dir string) bool {
+	dir = path.Clean(dir)
+	for _, allowed := range AllowedNodeLogPaths {
+		if strings.HasPrefix(dir, allowed+"/") {
+			return true
+		}
+	}
+	return false
+}
+
 func RemoveSecurityContextConstraint(k8sClient client.Client, sccName string) error {
 	scc := runtime.NewSCC(sccName)
 
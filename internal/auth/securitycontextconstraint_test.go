@@ -0,0 +1,18 @@
+package auth_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	auth "github.com/openshift/cluster-logging-operator/internal/auth"
+)
+
+var _ = DescribeTable("#IsAllowedNodeLogPath", func(dir string, allowed bool) {
+	Expect(auth.IsAllowedNodeLogPath(dir)).To(Equal(allowed))
+},
+	Entry("should allow a subdirectory of /var/log", "/var/log/myapp", true),
+	Entry("should allow a nested subdirectory of /var/log", "/var/log/myapp/gpu", true),
+	Entry("should not allow /var/log itself", "/var/log", false),
+	Entry("should not allow a directory with a common prefix", "/var/logs/myapp", false),
+	Entry("should not allow a directory escaping /var/log", "/var/log/../../etc", false),
+	Entry("should not allow other directories of the node", "/etc/kubernetes", false),
+)
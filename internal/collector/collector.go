@@ -2,6 +2,7 @@ package collector
 
 import (
 	"fmt"
+	"hash/fnv"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -27,6 +28,7 @@ const (
 	DefaultMaxUnavailable = "100%"
 
 	defaultAudience                            = "openshift"
+	defaultDeploymentReplicas                  = 2
 	clusterLoggingPriorityClassName            = "system-node-critical"
 	metricsVolumeName                          = "metrics"
 	metricsVolumePath                          = "/etc/collector/metrics"
@@ -49,6 +51,8 @@ const (
 	sourceOpenshiftAPIServerPath               = "/var/log/openshift-apiserver"
 	sourceKubeAPIServerName                    = "varlogkubeapiserver"
 	sourceKubeAPIServerPath                    = "/var/log/kube-apiserver"
+	sourceNodeFileNameFmt                      = "nodefile-%08x"
+	unixSocketNameFmt                          = "unixsocket-%08x"
 	tmpVolumeName                              = "tmp"
 	tmpPath                                    = "/tmp"
 )
@@ -70,6 +74,7 @@ type Factory struct {
 	PodLabelVisitor        PodLabelVisitor
 	ResourceNames          *factory.ForwarderResourceNames
 	isDaemonset            bool
+	isAggregator           bool
 	annotations            map[string]string
 }
 
@@ -88,8 +93,14 @@ func (f *Factory) NodeSelector() map[string]string {
 func (f *Factory) Tolerations() []v1.Toleration {
 	return f.CollectorSpec.Tolerations
 }
+
+// Affinity returns the affinity of the collector which excludes the nodes of the node profiles from the collector
+// DaemonSet of the forwarder
 func (f *Factory) Affinity() *v1.Affinity {
-	return f.CollectorSpec.Affinity
+	if !f.isDaemonset {
+		return f.CollectorSpec.Affinity
+	}
+	return excludeNodeProfiles(f.CollectorSpec.Affinity, f.CollectorSpec.NodeProfiles)
 }
 func (f *Factory) MaxUnavailable() intstr.IntOrString {
 	if f.CollectorSpec.MaxUnavailable != nil {
@@ -103,6 +114,41 @@ func (f *Factory) MaxUnavailable() intstr.IntOrString {
 	return intstr.Parse(DefaultMaxUnavailable)
 }
 
+// DeploymentSpec returns the spec of the collector when deployed as a Deployment
+func (f *Factory) DeploymentSpec() obs.CollectorDeploymentSpec {
+	if f.CollectorSpec.Deployment == nil {
+		return obs.CollectorDeploymentSpec{}
+	}
+	return *f.CollectorSpec.Deployment
+}
+
+// isSingleReplica returns true when cluster events are collected which must be done by a single replica
+// to avoid forwarding them more than once
+func (f *Factory) isSingleReplica() bool {
+	return !f.isAggregator && internalobs.Inputs(f.ForwarderSpec.Inputs).HasEventsSource()
+}
+
+// Replicas returns the number of replicas of the collector Deployment or nil when they are managed by a HorizontalPodAutoscaler
+func (f *Factory) Replicas() *int32 {
+	switch {
+	case f.isSingleReplica():
+		return utils.GetPtr(int32(1))
+	case f.Autoscaling() != nil:
+		return nil
+	case f.DeploymentSpec().Replicas != nil:
+		return utils.GetPtr(*f.DeploymentSpec().Replicas)
+	}
+	return utils.GetPtr(int32(defaultDeploymentReplicas))
+}
+
+// Autoscaling returns the autoscaling spec of the collector Deployment or nil when it is not scaled automatically
+func (f *Factory) Autoscaling() *obs.CollectorAutoscalingSpec {
+	if f.isSingleReplica() {
+		return nil
+	}
+	return f.DeploymentSpec().Autoscaling
+}
+
 func New(confHash, clusterID string, collectorSpec *obs.CollectorSpec, secrets internalobs.Secrets, configMaps internalobs.ConfigMaps, forwarderSpec obs.ClusterLogForwarderSpec, resNames *factory.ForwarderResourceNames, isDaemonset bool, annotations map[string]string) *Factory {
 	if collectorSpec == nil {
 		collectorSpec = &obs.CollectorSpec{}
@@ -127,6 +173,21 @@ func New(confHash, clusterID string, collectorSpec *obs.CollectorSpec, secrets i
 	return factory
 }
 
+// NewAggregator returns a factory for the aggregator tier of a two-tier collector which is deployed as a Deployment
+// named after the aggregator resource names of the forwarder
+func NewAggregator(confHash, clusterID string, aggregatorSpec *obs.CollectorAggregatorSpec, secrets internalobs.Secrets, configMaps internalobs.ConfigMaps, forwarderSpec obs.ClusterLogForwarderSpec, resNames *factory.ForwarderResourceNames, annotations map[string]string) *Factory {
+	collectorSpec := &obs.CollectorSpec{}
+	if aggregatorSpec != nil {
+		collectorSpec.Resources = aggregatorSpec.Resources
+		collectorSpec.NodeSelector = aggregatorSpec.NodeSelector
+		collectorSpec.Tolerations = aggregatorSpec.Tolerations
+		collectorSpec.Deployment = aggregatorSpec.Deployment
+	}
+	f := New(confHash, clusterID, collectorSpec, secrets, configMaps, forwarderSpec, resNames.AggregatorResourceNames(), false, annotations)
+	f.isAggregator = true
+	return f
+}
+
 func (f *Factory) NewDaemonSet(namespace, name string, trustedCABundle *v1.ConfigMap, tlsProfileSpec configv1.TLSProfileSpec) *apps.DaemonSet {
 	podSpec := f.NewPodSpec(trustedCABundle, f.ForwarderSpec, f.ClusterID, tlsProfileSpec, namespace)
 	ds := factory.NewDaemonSet(namespace, name, name, constants.CollectorName, constants.VectorName, f.MaxUnavailable(), *podSpec, f.CommonLabelInitializer, f.PodLabelVisitor)
@@ -137,7 +198,17 @@ func (f *Factory) NewDaemonSet(namespace, name string, trustedCABundle *v1.Confi
 
 func (f *Factory) NewDeployment(namespace, name string, trustedCABundle *v1.ConfigMap, tlsProfileSpec configv1.TLSProfileSpec) *apps.Deployment {
 	podSpec := f.NewPodSpec(trustedCABundle, f.ForwarderSpec, f.ClusterID, tlsProfileSpec, namespace)
-	dpl := factory.NewDeployment(namespace, name, constants.CollectorName, constants.VectorName, 2, *podSpec, f.CommonLabelInitializer, f.PodLabelVisitor)
+	dpl := factory.NewDeployment(namespace, name, constants.CollectorName, constants.VectorName, defaultDeploymentReplicas, *podSpec, f.CommonLabelInitializer, f.PodLabelVisitor)
+	runtime.NewDeploymentBuilder(dpl).WithReplicas(f.Replicas())
+	if f.isSingleReplica() {
+		dpl.Spec.Strategy = apps.DeploymentStrategy{Type: apps.RecreateDeploymentStrategyType}
+	}
+	for _, constraint := range f.DeploymentSpec().TopologySpreadConstraints {
+		if constraint.LabelSelector == nil {
+			constraint.LabelSelector = dpl.Spec.Selector.DeepCopy()
+		}
+		dpl.Spec.Template.Spec.TopologySpreadConstraints = append(dpl.Spec.Template.Spec.TopologySpreadConstraints, constraint)
+	}
 	dpl.Spec.Template.Annotations[constants.AnnotationSecretHash] = f.Secrets.Hash64a()
 	dpl.Spec.Template.Annotations[constants.AnnotationConfigMapHash] = f.ConfigMaps.Hash64a()
 	return dpl
@@ -177,6 +248,16 @@ func (f *Factory) NewPodSpec(trustedCABundle *v1.ConfigMap, spec obs.ClusterLogF
 			v1.Volume{Name: sourceOpenshiftAPIServerName, VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: sourceOpenshiftAPIServerPath}}},
 			v1.Volume{Name: sourceKubeAPIServerName, VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: sourceKubeAPIServerPath}}},
 		)
+		for _, dir := range internalobs.Inputs(spec.Inputs).NodeFileDirs() {
+			podSpec.Volumes = append(podSpec.Volumes,
+				v1.Volume{Name: nodeFileVolumeName(dir), VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: dir}}},
+			)
+		}
+		for _, dir := range internalobs.Outputs(spec.Outputs).UnixSocketDirs() {
+			podSpec.Volumes = append(podSpec.Volumes,
+				v1.Volume{Name: unixSocketVolumeName(dir), VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: dir}}},
+			)
+		}
 	}
 
 	secretVolumes := AddSecretVolumes(podSpec, f.Secrets)
@@ -209,8 +290,15 @@ func (f *Factory) NewCollectorContainer(inputs internalobs.Inputs, outputs inter
 			Protocol:      v1.ProtocolTCP,
 		},
 	}
+	if f.isAggregator {
+		collector.Ports = append(collector.Ports, v1.ContainerPort{
+			Name:          constants.AggregatorPortName,
+			ContainerPort: constants.AggregatorPort,
+			Protocol:      v1.ProtocolTCP,
+		})
+	}
 	collector.Env = []v1.EnvVar{
-		{Name: "COLLECTOR_CONF_HASH", Value: f.ConfigHash},
+		{Name: configHashEnvVar, Value: f.ConfigHash},
 		{Name: "K8S_NODE_NAME", ValueFrom: &v1.EnvVarSource{FieldRef: &v1.ObjectFieldSelector{APIVersion: "v1", FieldPath: "spec.nodeName"}}},
 		{Name: "NODE_IPV4", ValueFrom: &v1.EnvVarSource{FieldRef: &v1.ObjectFieldSelector{APIVersion: "v1", FieldPath: "status.hostIP"}}},
 		{Name: "OPENSHIFT_CLUSTER_ID", Value: clusterID},
@@ -245,6 +333,13 @@ func (f *Factory) NewCollectorContainer(inputs internalobs.Inputs, outputs inter
 		if inputs.HasAuditSource(obs.AuditSourceOVN) {
 			collector.VolumeMounts = append(collector.VolumeMounts, v1.VolumeMount{Name: sourceAuditOVNName, ReadOnly: true, MountPath: sourceOVNPath})
 		}
+		for _, dir := range inputs.NodeFileDirs() {
+			collector.VolumeMounts = append(collector.VolumeMounts, v1.VolumeMount{Name: nodeFileVolumeName(dir), ReadOnly: true, MountPath: dir})
+		}
+		// the sockets are written to, so their directories are not mounted read only
+		for _, dir := range outputs.UnixSocketDirs() {
+			collector.VolumeMounts = append(collector.VolumeMounts, v1.VolumeMount{Name: unixSocketVolumeName(dir), MountPath: dir})
+		}
 		AddSecurityContextTo(collector)
 	}
 
@@ -263,6 +358,20 @@ func (f *Factory) NewCollectorContainer(inputs internalobs.Inputs, outputs inter
 	return collector
 }
 
+// nodeFileVolumeName returns a unique volume name for a directory of the node collected by a file source
+func nodeFileVolumeName(dir string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(dir))
+	return fmt.Sprintf(sourceNodeFileNameFmt, h.Sum32())
+}
+
+// unixSocketVolumeName returns a unique volume name for a directory of the node which contains the socket of an output
+func unixSocketVolumeName(dir string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(dir))
+	return fmt.Sprintf(unixSocketNameFmt, h.Sum32())
+}
+
 func sanitizeVolumeName(input string) string {
 	return strings.ReplaceAll(input, ".", "")
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/utils"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
 
+	apps "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -426,6 +427,61 @@ var _ = Describe("Factory#Daemonset", func() {
 					Expect(podSpec.Volumes).To(HaveLen(16))
 				})
 
+				It("should mount the directories of the node files read-only", func() {
+					podSpec = *factory.NewPodSpec(nil, obs.ClusterLogForwarderSpec{
+						Inputs: []obs.InputSpec{
+							{
+								Name: "gpu",
+								Type: obs.InputTypeInfrastructure,
+								Infrastructure: &obs.Infrastructure{
+									Sources: []obs.InfrastructureSource{obs.InfrastructureSourceFile},
+									Files: &obs.NodeFiles{
+										Includes: []string{"/var/log/gpu/*.log", "/var/log/gpu/driver/*.log"},
+									},
+								},
+							},
+						},
+					}, "1234", tls.GetClusterTLSProfileSpec(nil), constants.OpenshiftNS)
+					name := nodeFileVolumeName("/var/log/gpu")
+					Expect(podSpec.Volumes).To(IncludeVolume(v1.Volume{
+						Name: name,
+						VolumeSource: v1.VolumeSource{
+							HostPath: &v1.HostPathVolumeSource{
+								Path: "/var/log/gpu"}}}))
+					Expect(podSpec.Containers[0].VolumeMounts).To(IncludeVolumeMount(
+						v1.VolumeMount{
+							Name:      name,
+							ReadOnly:  true,
+							MountPath: "/var/log/gpu"}))
+					Expect(podSpec.Volumes).ToNot(IncludeVolume(v1.Volume{
+						Name: nodeFileVolumeName("/var/log/gpu/driver"),
+						VolumeSource: v1.VolumeSource{
+							HostPath: &v1.HostPathVolumeSource{
+								Path: "/var/log/gpu/driver"}}}))
+				})
+
+				It("should mount the directories of the unix sockets of the outputs writable", func() {
+					podSpec = *factory.NewPodSpec(nil, obs.ClusterLogForwarderSpec{
+						Outputs: []obs.OutputSpec{
+							{
+								Name:   "local",
+								Type:   obs.OutputTypeSocket,
+								Socket: &obs.Socket{URL: "unix:///var/run/collector/logs.sock"},
+							},
+						},
+					}, "1234", tls.GetClusterTLSProfileSpec(nil), constants.OpenshiftNS)
+					name := unixSocketVolumeName("/var/run/collector")
+					Expect(podSpec.Volumes).To(IncludeVolume(v1.Volume{
+						Name: name,
+						VolumeSource: v1.VolumeSource{
+							HostPath: &v1.HostPathVolumeSource{
+								Path: "/var/run/collector"}}}))
+					Expect(podSpec.Containers[0].VolumeMounts).To(IncludeVolumeMount(
+						v1.VolumeMount{
+							Name:      name,
+							MountPath: "/var/run/collector"}))
+				})
+
 				It("should mount all volumes for output configmaps", func() {
 					Expect(podSpec.Volumes).To(IncludeVolume(
 						v1.Volume{
@@ -751,6 +807,12 @@ var _ = Describe("Factory#Deployment", func() {
 			Expect(actDpl.Spec.Template.Annotations).To(HaveKey(constants.AnnotationConfigMapHash))
 			Expect(actDpl.Spec.Template.Annotations).To(HaveKey(targetAnnotation))
 		})
+		It("should have a single replica that is recreated when collecting cluster events", func() {
+			factory.ForwarderSpec.Inputs = []obs.InputSpec{{Name: "events", Type: obs.InputTypeEvents, Events: &obs.EventsInput{}}}
+			actDpl := *factory.NewDeployment(constants.OpenshiftNS, "test", nil, tls.GetClusterTLSProfileSpec(nil))
+			Expect(*actDpl.Spec.Replicas).To(BeEquivalentTo(1))
+			Expect(actDpl.Spec.Strategy.Type).To(Equal(apps.RecreateDeploymentStrategyType))
+		})
 	})
 
 })
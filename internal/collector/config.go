@@ -8,6 +8,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/runtime"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
 	"github.com/openshift/cluster-logging-operator/internal/utils/comparators"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -15,7 +16,14 @@ import (
 // ReconcileCollectorConfig reconciles a collector config specifically for the collector defined by the factory
 func (f *Factory) ReconcileCollectorConfig(k8sClient client.Client, reader client.Reader, namespace, collectorConfig string, owner metav1.OwnerReference) error {
 	log.V(3).Info("Updating ConfigMap and Secrets")
-	configMap := runtime.NewConfigMap(
+	configMap := f.NewCollectorConfig(namespace, collectorConfig)
+	utils.AddOwnerRefToObject(configMap, owner)
+	return reconcile.Configmap(k8sClient, reader, configMap, comparators.CompareLabels)
+}
+
+// NewCollectorConfig returns the configmap of the collector config defined by the factory
+func (f *Factory) NewCollectorConfig(namespace, collectorConfig string) *corev1.ConfigMap {
+	return runtime.NewConfigMap(
 		namespace,
 		f.ResourceNames.ConfigMap,
 		map[string]string{
@@ -23,7 +31,4 @@ func (f *Factory) ReconcileCollectorConfig(k8sClient client.Client, reader clien
 			vector.RunVectorFile: fmt.Sprintf(vector.RunVectorScript, vector.GetDataPath(namespace, f.ResourceNames.ForwarderName)),
 		},
 		f.CommonLabelInitializer)
-
-	utils.AddOwnerRefToObject(configMap, owner)
-	return reconcile.Configmap(k8sClient, reader, configMap, comparators.CompareLabels)
 }
@@ -0,0 +1,81 @@
+package collector
+
+import (
+	"github.com/openshift/cluster-logging-operator/internal/collector/common"
+	"github.com/openshift/cluster-logging-operator/internal/collector/vector"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	configValidationContainerName = "validate"
+	configValidationPath          = "/etc/vector"
+
+	// configValidationDeadlineSeconds bounds the time to schedule the pod, pull the image and validate the configs
+	configValidationDeadlineSeconds int64 = 300
+)
+
+// NewConfigValidationConfigMap returns the configmap of the configs to be validated by the collector keyed by
+// the name of their file
+func (f *Factory) NewConfigValidationConfigMap(namespace string, configs map[string]string) *corev1.ConfigMap {
+	return runtime.NewConfigMap(namespace, f.ResourceNames.ConfigValidation, configs, f.CommonLabelInitializer)
+}
+
+// NewConfigValidationJob returns the job which validates each config of the config validation configmap with the
+// collector. The output of a failed validation is the termination message of the pod of the job
+func (f *Factory) NewConfigValidationJob(namespace, configHash string) *batchv1.Job {
+	container := runtime.NewContainer(configValidationContainerName, utils.GetComponentImage(f.ImageName), corev1.PullIfNotPresent, f.CollectorSpec.Resources)
+	container.TerminationMessagePolicy = corev1.TerminationMessageFallbackToLogsOnError
+	container.Command = []string{"/bin/bash", "-c"}
+	container.Args = []string{vector.ValidateVectorScript}
+	// the configs interpolate these environment variables of the collector
+	container.Env = []corev1.EnvVar{
+		{Name: "OPENSHIFT_CLUSTER_ID", Value: f.ClusterID},
+		{Name: "VECTOR_SELF_NODE_NAME", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{APIVersion: "v1", FieldPath: "spec.nodeName"}}},
+	}
+	container.VolumeMounts = []corev1.VolumeMount{
+		{Name: common.ConfigVolumeName, ReadOnly: true, MountPath: configValidationPath},
+	}
+	container.SecurityContext = &corev1.SecurityContext{
+		AllowPrivilegeEscalation: utils.GetPtr(false),
+		Capabilities: &corev1.Capabilities{
+			Drop: []corev1.Capability{"ALL"},
+		},
+		SeccompProfile: &corev1.SeccompProfile{
+			Type: corev1.SeccompProfileTypeRuntimeDefault,
+		},
+	}
+
+	// the pod is not labeled as a collector to keep it from being selected by the services of the collector
+	pod := runtime.NewPod(namespace, f.ResourceNames.ConfigValidation)
+	f.PodLabelVisitor(pod)
+
+	job := runtime.NewJob(namespace, f.ResourceNames.ConfigValidation, f.CommonLabelInitializer)
+	job.Annotations = map[string]string{
+		constants.AnnotationConfigHash: configHash,
+	}
+	job.Spec = batchv1.JobSpec{
+		BackoffLimit:          utils.GetPtr[int32](0),
+		ActiveDeadlineSeconds: utils.GetPtr(configValidationDeadlineSeconds),
+		Template: corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: pod.Labels,
+			},
+			Spec: corev1.PodSpec{
+				RestartPolicy:                corev1.RestartPolicyNever,
+				ServiceAccountName:           f.ResourceNames.ServiceAccount,
+				AutomountServiceAccountToken: utils.GetPtr(false),
+				NodeSelector:                 utils.EnsureLinuxNodeSelector(nil),
+				Containers:                   []corev1.Container{*container},
+				Volumes: []corev1.Volume{
+					{Name: common.ConfigVolumeName, VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: f.ResourceNames.ConfigValidation}}}},
+				},
+			},
+		},
+	}
+	return job
+}
@@ -0,0 +1,68 @@
+package collector
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/cluster-logging-operator/internal/collector/common"
+	"github.com/openshift/cluster-logging-operator/internal/collector/vector"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	coreFactory "github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	obsruntime "github.com/openshift/cluster-logging-operator/internal/runtime/observability"
+	v1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Factory#NewConfigValidationJob", func() {
+	const (
+		name      = "test"
+		namespace = "mynamespace"
+	)
+	var (
+		factory *Factory
+	)
+	BeforeEach(func() {
+		factory = &Factory{
+			ImageName:     constants.VectorName,
+			ClusterID:     "12345",
+			Visit:         vector.CollectorVisitor,
+			ResourceNames: coreFactory.ResourceNames(*obsruntime.NewClusterLogForwarder(namespace, name, runtime.Initialize)),
+			CommonLabelInitializer: func(o runtime.Object) {
+				runtime.SetCommonLabels(o, constants.VectorName, name, constants.CollectorName)
+			},
+			PodLabelVisitor: vector.PodLogExcludeLabel,
+		}
+	})
+
+	It("should validate the configs of the config validation configmap once", func() {
+		job := factory.NewConfigValidationJob(namespace, "abc")
+		Expect(job.Name).To(Equal(factory.ResourceNames.ConfigValidation))
+		Expect(job.Annotations).To(HaveKeyWithValue(constants.AnnotationConfigHash, "abc"))
+		Expect(*job.Spec.BackoffLimit).To(BeZero())
+		Expect(job.Spec.ActiveDeadlineSeconds).ToNot(BeNil())
+
+		podSpec := job.Spec.Template.Spec
+		Expect(podSpec.RestartPolicy).To(Equal(v1.RestartPolicyNever))
+		Expect(podSpec.ServiceAccountName).To(Equal(factory.ResourceNames.ServiceAccount))
+		Expect(podSpec.Volumes).To(HaveLen(1))
+		Expect(podSpec.Volumes[0].ConfigMap.Name).To(Equal(factory.ResourceNames.ConfigValidation))
+
+		Expect(podSpec.Containers).To(HaveLen(1))
+		container := podSpec.Containers[0]
+		Expect(container.TerminationMessagePolicy).To(Equal(v1.TerminationMessageFallbackToLogsOnError))
+		Expect(container.Args).To(ConsistOf(vector.ValidateVectorScript))
+		Expect(container.VolumeMounts).To(ConsistOf(v1.VolumeMount{Name: common.ConfigVolumeName, ReadOnly: true, MountPath: configValidationPath}))
+		Expect(container.Env).To(ContainElement(v1.EnvVar{Name: "OPENSHIFT_CLUSTER_ID", Value: "12345"}))
+	})
+
+	It("should not label the pod as a collector selected by the services of the collector", func() {
+		job := factory.NewConfigValidationJob(namespace, "abc")
+		Expect(job.Labels).To(HaveKeyWithValue(constants.LabelK8sComponent, constants.CollectorName))
+		Expect(job.Spec.Template.Labels).To(Equal(map[string]string{"vector.dev/exclude": "true"}))
+	})
+
+	It("should key the configs of the config validation configmap by their file name", func() {
+		configMap := factory.NewConfigValidationConfigMap(namespace, map[string]string{"test-config.toml": "config"})
+		Expect(configMap.Name).To(Equal(factory.ResourceNames.ConfigValidation))
+		Expect(configMap.Data).To(Equal(map[string]string{"test-config.toml": "config"}))
+	})
+})
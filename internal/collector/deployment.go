@@ -3,31 +3,175 @@ package collector
 import (
 	"context"
 	"fmt"
-	corev1 "k8s.io/api/core/v1"
 
 	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/reconcile"
 	"github.com/openshift/cluster-logging-operator/internal/runtime"
 	"github.com/openshift/cluster-logging-operator/internal/tls"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	defaultAutoscalingMinReplicas            = 1
+	defaultTargetCPUUtilizationPercentage    = 80
+	defaultPodDisruptionBudgetMaxUnavailable = 1
+	autoscalingMetricBufferUsageName         = "vector_buffer_byte_size"
+	deploymentKind                           = "Deployment"
+	deploymentAPIVersion                     = "apps/v1"
+
+	// autoscalingMetricEventsInName is the per second rate of vector_component_received_events_total which is exposed
+	// without the _total suffix by the default rules of prometheus-adapter
+	autoscalingMetricEventsInName = "vector_component_received_events"
+)
+
 // ReconcileDeployment reconciles a deployment specifically for the collector defined by the factory
+// along with its HorizontalPodAutoscaler and PodDisruptionBudget
 func (f *Factory) ReconcileDeployment(k8sClient client.Client, namespace string, trustedCABundle *corev1.ConfigMap, owner metav1.OwnerReference) error {
 	tlsProfile, _ := tls.FetchAPIServerTlsProfile(k8sClient)
-	desired := f.NewDeployment(namespace, f.ResourceNames.DaemonSetName(), trustedCABundle, tls.GetClusterTLSProfileSpec(tlsProfile))
+	name := f.ResourceNames.DaemonSetName()
+	desired := f.NewDeployment(namespace, name, trustedCABundle, tls.GetClusterTLSProfileSpec(tlsProfile))
 	utils.AddOwnerRefToObject(desired, owner)
-	return reconcile.Deployment(k8sClient, desired)
+	if err := reconcile.Deployment(k8sClient, desired); err != nil {
+		return err
+	}
+
+	if hpa := f.NewHorizontalPodAutoscaler(namespace, name); hpa != nil {
+		utils.AddOwnerRefToObject(hpa, owner)
+		if err := reconcile.HorizontalPodAutoscaler(k8sClient, hpa); err != nil {
+			return err
+		}
+	} else if err := removeHorizontalPodAutoscaler(k8sClient, namespace, name); err != nil {
+		return err
+	}
+
+	if pdb := f.NewPodDisruptionBudget(namespace, name); pdb != nil {
+		utils.AddOwnerRefToObject(pdb, owner)
+		return reconcile.PodDisruptionBudget(k8sClient, pdb)
+	}
+	return removePodDisruptionBudget(k8sClient, namespace, name)
+}
+
+// NewHorizontalPodAutoscaler stubs the HorizontalPodAutoscaler of the collector deployment or returns nil
+// when the deployment is not scaled automatically
+func (f *Factory) NewHorizontalPodAutoscaler(namespace, name string) *autoscalingv2.HorizontalPodAutoscaler {
+	spec := f.Autoscaling()
+	if spec == nil {
+		return nil
+	}
+	minReplicas := int32(defaultAutoscalingMinReplicas)
+	if spec.MinReplicas != nil {
+		minReplicas = *spec.MinReplicas
+	}
+	hpa := runtime.NewHorizontalPodAutoscaler(namespace, name, f.CommonLabelInitializer)
+	hpa.Spec = autoscalingv2.HorizontalPodAutoscalerSpec{
+		ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+			APIVersion: deploymentAPIVersion,
+			Kind:       deploymentKind,
+			Name:       name,
+		},
+		MinReplicas: utils.GetPtr(minReplicas),
+		MaxReplicas: spec.MaxReplicas,
+		Metrics:     []autoscalingv2.MetricSpec{autoscalingMetric(*spec)},
+	}
+	return hpa
+}
+
+// autoscalingMetric returns the metric spec of the HorizontalPodAutoscaler for the autoscaling spec
+func autoscalingMetric(spec obs.CollectorAutoscalingSpec) autoscalingv2.MetricSpec {
+	podsMetric := func(name string, selector *metav1.LabelSelector) autoscalingv2.MetricSpec {
+		target := autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType}
+		if spec.TargetAverageValue != nil {
+			target.AverageValue = utils.GetPtr(spec.TargetAverageValue.DeepCopy())
+		}
+		return autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{Name: name, Selector: selector},
+				Target: target,
+			},
+		}
+	}
+	switch spec.Metric {
+	case obs.CollectorAutoscalingMetricBufferUsage:
+		return podsMetric(autoscalingMetricBufferUsageName, nil)
+	case obs.CollectorAutoscalingMetricEventsIn:
+		// only the events received by the sources so events are not counted again by each transform and sink
+		return podsMetric(autoscalingMetricEventsInName, &metav1.LabelSelector{
+			MatchLabels: map[string]string{"component_kind": "source"},
+		})
+	}
+	utilization := int32(defaultTargetCPUUtilizationPercentage)
+	if spec.TargetCPUUtilizationPercentage != nil {
+		utilization = *spec.TargetCPUUtilizationPercentage
+	}
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ResourceMetricSourceType,
+		Resource: &autoscalingv2.ResourceMetricSource{
+			Name: corev1.ResourceCPU,
+			Target: autoscalingv2.MetricTarget{
+				Type:               autoscalingv2.UtilizationMetricType,
+				AverageUtilization: utils.GetPtr(utilization),
+			},
+		},
+	}
+}
+
+// NewPodDisruptionBudget stubs the PodDisruptionBudget of the collector deployment or returns nil
+// when none is spec'd
+func (f *Factory) NewPodDisruptionBudget(namespace, name string) *policyv1.PodDisruptionBudget {
+	spec := f.DeploymentSpec().PodDisruptionBudget
+	if spec == nil {
+		return nil
+	}
+	pdb := runtime.NewPodDisruptionBudget(namespace, name, f.CommonLabelInitializer)
+	pdb.Spec.Selector = &metav1.LabelSelector{
+		MatchLabels: runtime.Selectors(name, constants.CollectorName, constants.VectorName),
+	}
+	switch {
+	case spec.MinAvailable != nil:
+		pdb.Spec.MinAvailable = utils.GetPtr(*spec.MinAvailable)
+	case spec.MaxUnavailable != nil:
+		pdb.Spec.MaxUnavailable = utils.GetPtr(*spec.MaxUnavailable)
+	default:
+		pdb.Spec.MaxUnavailable = utils.GetPtr(intstr.FromInt32(defaultPodDisruptionBudgetMaxUnavailable))
+	}
+	return pdb
 }
 
+// RemoveDeployment removes the collector deployment along with its HorizontalPodAutoscaler and PodDisruptionBudget
 func RemoveDeployment(k8sClient client.Client, namespace, name string) (err error) {
 	log.V(3).Info("Removing collector deployment", "namespace", namespace, "name", name)
 	ds := runtime.NewDeployment(namespace, name)
 	if err = k8sClient.Delete(context.TODO(), ds); err != nil && !errors.IsNotFound(err) {
 		return fmt.Errorf("failure deleting deployment %s/%s: %v", namespace, name, err)
 	}
+	if err = removeHorizontalPodAutoscaler(k8sClient, namespace, name); err != nil {
+		return err
+	}
+	return removePodDisruptionBudget(k8sClient, namespace, name)
+}
+
+func removeHorizontalPodAutoscaler(k8sClient client.Client, namespace, name string) error {
+	hpa := runtime.NewHorizontalPodAutoscaler(namespace, name)
+	if err := k8sClient.Delete(context.TODO(), hpa); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failure deleting horizontalpodautoscaler %s/%s: %v", namespace, name, err)
+	}
+	return nil
+}
+
+func removePodDisruptionBudget(k8sClient client.Client, namespace, name string) error {
+	pdb := runtime.NewPodDisruptionBudget(namespace, name)
+	if err := k8sClient.Delete(context.TODO(), pdb); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failure deleting poddisruptionbudget %s/%s: %v", namespace, name, err)
+	}
 	return nil
 }
@@ -0,0 +1,214 @@
+package collector
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/collector/vector"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	coreFactory "github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	obsruntime "github.com/openshift/cluster-logging-operator/internal/runtime/observability"
+	"github.com/openshift/cluster-logging-operator/internal/tls"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	apps "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ = Describe("Factory#Deployment", func() {
+	const name = "test"
+	var (
+		factory *Factory
+	)
+	BeforeEach(func() {
+		factory = &Factory{
+			ImageName:     constants.VectorName,
+			Visit:         vector.CollectorVisitor,
+			ResourceNames: coreFactory.ResourceNames(*obsruntime.NewClusterLogForwarder(constants.OpenshiftNS, name, runtime.Initialize)),
+			CommonLabelInitializer: func(o runtime.Object) {
+				runtime.SetCommonLabels(o, constants.VectorName, name, constants.CollectorName)
+			},
+			PodLabelVisitor: vector.PodLogExcludeLabel,
+			ForwarderSpec: obs.ClusterLogForwarderSpec{
+				Inputs: []obs.InputSpec{{Name: "myreceiver", Type: obs.InputTypeReceiver}},
+			},
+		}
+	})
+
+	Context("#NewDeployment", func() {
+		It("should default the number of replicas", func() {
+			dpl := factory.NewDeployment(constants.OpenshiftNS, name, nil, tls.GetClusterTLSProfileSpec(nil))
+			Expect(*dpl.Spec.Replicas).To(BeEquivalentTo(defaultDeploymentReplicas))
+			Expect(dpl.Spec.Strategy.Type).ToNot(Equal(apps.RecreateDeploymentStrategyType))
+		})
+
+		It("should use the spec'd number of replicas", func() {
+			factory.CollectorSpec.Deployment = &obs.CollectorDeploymentSpec{Replicas: utils.GetPtr(int32(5))}
+			dpl := factory.NewDeployment(constants.OpenshiftNS, name, nil, tls.GetClusterTLSProfileSpec(nil))
+			Expect(*dpl.Spec.Replicas).To(BeEquivalentTo(5))
+		})
+
+		It("should leave the replicas to the HorizontalPodAutoscaler when autoscaling", func() {
+			factory.CollectorSpec.Deployment = &obs.CollectorDeploymentSpec{Autoscaling: &obs.CollectorAutoscalingSpec{MaxReplicas: 4}}
+			dpl := factory.NewDeployment(constants.OpenshiftNS, name, nil, tls.GetClusterTLSProfileSpec(nil))
+			Expect(dpl.Spec.Replicas).To(BeNil())
+		})
+
+		It("should run a single replica when collecting cluster events regardless of the spec", func() {
+			factory.ForwarderSpec.Inputs = append(factory.ForwarderSpec.Inputs, obs.InputSpec{Name: "events", Type: obs.InputTypeEvents, Events: &obs.EventsInput{}})
+			factory.CollectorSpec.Deployment = &obs.CollectorDeploymentSpec{Autoscaling: &obs.CollectorAutoscalingSpec{MaxReplicas: 4}}
+			dpl := factory.NewDeployment(constants.OpenshiftNS, name, nil, tls.GetClusterTLSProfileSpec(nil))
+			Expect(*dpl.Spec.Replicas).To(BeEquivalentTo(1))
+			Expect(factory.NewHorizontalPodAutoscaler(constants.OpenshiftNS, name)).To(BeNil())
+		})
+
+		It("should default the label selector of the topology spread constraints to the collector pods", func() {
+			factory.CollectorSpec.Deployment = &obs.CollectorDeploymentSpec{
+				TopologySpreadConstraints: []v1.TopologySpreadConstraint{
+					{MaxSkew: 1, TopologyKey: "topology.kubernetes.io/zone", WhenUnsatisfiable: v1.ScheduleAnyway},
+				},
+			}
+			dpl := factory.NewDeployment(constants.OpenshiftNS, name, nil, tls.GetClusterTLSProfileSpec(nil))
+			Expect(dpl.Spec.Template.Spec.TopologySpreadConstraints).To(HaveLen(1))
+			Expect(dpl.Spec.Template.Spec.TopologySpreadConstraints[0].LabelSelector).To(Equal(dpl.Spec.Selector))
+		})
+	})
+
+	Context("#NewHorizontalPodAutoscaler", func() {
+		It("should not stub an autoscaler when autoscaling is not spec'd", func() {
+			Expect(factory.NewHorizontalPodAutoscaler(constants.OpenshiftNS, name)).To(BeNil())
+		})
+
+		It("should scale the deployment on CPU utilization by default", func() {
+			factory.CollectorSpec.Deployment = &obs.CollectorDeploymentSpec{Autoscaling: &obs.CollectorAutoscalingSpec{MaxReplicas: 4}}
+			hpa := factory.NewHorizontalPodAutoscaler(constants.OpenshiftNS, name)
+			Expect(hpa.Spec.ScaleTargetRef).To(Equal(autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: name}))
+			Expect(*hpa.Spec.MinReplicas).To(BeEquivalentTo(1))
+			Expect(hpa.Spec.MaxReplicas).To(BeEquivalentTo(4))
+			Expect(hpa.Spec.Metrics).To(Equal([]autoscalingv2.MetricSpec{
+				{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricSource{
+						Name: v1.ResourceCPU,
+						Target: autoscalingv2.MetricTarget{
+							Type:               autoscalingv2.UtilizationMetricType,
+							AverageUtilization: utils.GetPtr(int32(defaultTargetCPUUtilizationPercentage)),
+						},
+					},
+				},
+			}))
+		})
+
+		It("should scale the deployment on the buffer usage of the collector", func() {
+			target := resource.MustParse("100Mi")
+			factory.CollectorSpec.Deployment = &obs.CollectorDeploymentSpec{Autoscaling: &obs.CollectorAutoscalingSpec{
+				MinReplicas:        utils.GetPtr(int32(2)),
+				MaxReplicas:        6,
+				Metric:             obs.CollectorAutoscalingMetricBufferUsage,
+				TargetAverageValue: &target,
+			}}
+			hpa := factory.NewHorizontalPodAutoscaler(constants.OpenshiftNS, name)
+			Expect(*hpa.Spec.MinReplicas).To(BeEquivalentTo(2))
+			Expect(hpa.Spec.Metrics).To(Equal([]autoscalingv2.MetricSpec{
+				{
+					Type: autoscalingv2.PodsMetricSourceType,
+					Pods: &autoscalingv2.PodsMetricSource{
+						Metric: autoscalingv2.MetricIdentifier{Name: "vector_buffer_byte_size"},
+						Target: autoscalingv2.MetricTarget{
+							Type:         autoscalingv2.AverageValueMetricType,
+							AverageValue: &target,
+						},
+					},
+				},
+			}))
+		})
+
+		It("should scale the deployment on the rate of events received by the sources of the collector", func() {
+			target := resource.MustParse("5k")
+			factory.CollectorSpec.Deployment = &obs.CollectorDeploymentSpec{Autoscaling: &obs.CollectorAutoscalingSpec{
+				MaxReplicas:        6,
+				Metric:             obs.CollectorAutoscalingMetricEventsIn,
+				TargetAverageValue: &target,
+			}}
+			hpa := factory.NewHorizontalPodAutoscaler(constants.OpenshiftNS, name)
+			Expect(hpa.Spec.Metrics).To(Equal([]autoscalingv2.MetricSpec{
+				{
+					Type: autoscalingv2.PodsMetricSourceType,
+					Pods: &autoscalingv2.PodsMetricSource{
+						Metric: autoscalingv2.MetricIdentifier{
+							Name:     "vector_component_received_events",
+							Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"component_kind": "source"}},
+						},
+						Target: autoscalingv2.MetricTarget{
+							Type:         autoscalingv2.AverageValueMetricType,
+							AverageValue: &target,
+						},
+					},
+				},
+			}))
+		})
+	})
+
+	Context("#NewPodDisruptionBudget", func() {
+		It("should not stub a budget when none is spec'd", func() {
+			Expect(factory.NewPodDisruptionBudget(constants.OpenshiftNS, name)).To(BeNil())
+		})
+
+		It("should select the collector pods and default maxUnavailable", func() {
+			factory.CollectorSpec.Deployment = &obs.CollectorDeploymentSpec{PodDisruptionBudget: &obs.CollectorPodDisruptionBudgetSpec{}}
+			dpl := factory.NewDeployment(constants.OpenshiftNS, name, nil, tls.GetClusterTLSProfileSpec(nil))
+			pdb := factory.NewPodDisruptionBudget(constants.OpenshiftNS, name)
+			Expect(pdb.Spec.Selector).To(Equal(dpl.Spec.Selector))
+			Expect(pdb.Spec.MaxUnavailable).To(Equal(utils.GetPtr(intstr.FromInt32(1))))
+			Expect(pdb.Spec.MinAvailable).To(BeNil())
+		})
+
+		It("should use the spec'd minAvailable", func() {
+			minAvailable := intstr.Parse("50%")
+			factory.CollectorSpec.Deployment = &obs.CollectorDeploymentSpec{PodDisruptionBudget: &obs.CollectorPodDisruptionBudgetSpec{MinAvailable: &minAvailable}}
+			pdb := factory.NewPodDisruptionBudget(constants.OpenshiftNS, name)
+			Expect(pdb.Spec.MinAvailable).To(Equal(&minAvailable))
+			Expect(pdb.Spec.MaxUnavailable).To(BeNil())
+		})
+	})
+})
+
+var _ = Describe("Factory#NewAggregator", func() {
+	const name = "test"
+	var (
+		resNames *coreFactory.ForwarderResourceNames
+		spec     obs.ClusterLogForwarderSpec
+	)
+	BeforeEach(func() {
+		resNames = coreFactory.ResourceNames(*obsruntime.NewClusterLogForwarder(constants.OpenshiftNS, name, runtime.Initialize))
+		spec = obs.ClusterLogForwarderSpec{
+			Inputs: []obs.InputSpec{
+				{Name: "application", Type: obs.InputTypeApplication},
+				{Name: "events", Type: obs.InputTypeEvents, Events: &obs.EventsInput{}},
+			},
+		}
+	})
+
+	It("should deploy the aggregator tier using the aggregator resource names", func() {
+		aggregatorNames := resNames.AggregatorResourceNames()
+		factory := NewAggregator("hash", "clusterID", &obs.CollectorAggregatorSpec{NodeSelector: map[string]string{"foo": "bar"}}, nil, nil, spec, resNames, nil)
+		dpl := factory.NewDeployment(constants.OpenshiftNS, aggregatorNames.CommonName, nil, tls.GetClusterTLSProfileSpec(nil))
+
+		Expect(dpl.Name).To(Equal(name + "-aggregator"))
+		Expect(dpl.Spec.Selector.MatchLabels).To(HaveKeyWithValue(constants.LabelK8sInstance, aggregatorNames.ForwarderName))
+		Expect(dpl.Spec.Template.Spec.NodeSelector).To(HaveKeyWithValue("foo", "bar"))
+		Expect(dpl.Spec.Template.Spec.Volumes).To(ContainElement(v1.Volume{Name: metricsVolumeName, VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: aggregatorNames.SecretMetrics}}}))
+		Expect(dpl.Spec.Template.Spec.Containers[0].Ports).To(ContainElement(v1.ContainerPort{Name: constants.AggregatorPortName, ContainerPort: constants.AggregatorPort, Protocol: v1.ProtocolTCP}))
+	})
+
+	It("should scale the aggregator tier when the agents collect cluster events", func() {
+		factory := NewAggregator("hash", "clusterID", nil, nil, nil, spec, resNames, nil)
+		dpl := factory.NewDeployment(constants.OpenshiftNS, resNames.AggregatorResourceNames().CommonName, nil, tls.GetClusterTLSProfileSpec(nil))
+		Expect(*dpl.Spec.Replicas).To(BeEquivalentTo(defaultDeploymentReplicas))
+		Expect(dpl.Spec.Strategy.Type).ToNot(Equal(apps.RecreateDeploymentStrategyType))
+	})
+})
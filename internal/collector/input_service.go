@@ -21,11 +21,10 @@ func (f *Factory) ReconcileInputServices(k8sClient kubernetes.Client, k8sReader
 	}
 
 	for _, input := range f.ForwarderSpec.Inputs {
-		var listenPort int32
 		serviceName := f.ResourceNames.GenerateInputServiceName(input.Name)
 		if input.Receiver != nil {
-			listenPort = input.Receiver.Port
-			if err := network.ReconcileInputService(k8sClient, namespace, serviceName, f.ResourceNames.CommonName, serviceName, listenPort, listenPort, input.Receiver.Type, owner, visitors); err != nil {
+			ports := network.InputServicePorts(*input.Receiver)
+			if err := network.ReconcileInputService(k8sClient, namespace, serviceName, f.ResourceNames.CommonName, serviceName, ports, input.Receiver.Type, owner, visitors); err != nil {
 				return err
 			}
 		}
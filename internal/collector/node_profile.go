@@ -0,0 +1,89 @@
+package collector
+
+import (
+	"sort"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	v1 "k8s.io/api/core/v1"
+)
+
+// NewNodeProfile returns a factory for the collector deployed as a DaemonSet on the nodes of a node profile which is
+// named after the node profile resource names of the forwarder
+func NewNodeProfile(confHash, clusterID string, collectorSpec *obs.CollectorSpec, profile obs.CollectorNodeProfile, secrets internalobs.Secrets, configMaps internalobs.ConfigMaps, profileSpec obs.ClusterLogForwarderSpec, resNames *factory.ForwarderResourceNames, annotations map[string]string) *Factory {
+	spec := &obs.CollectorSpec{}
+	if collectorSpec != nil {
+		spec = collectorSpec.DeepCopy()
+	}
+	nodeSelector := map[string]string{}
+	for key, value := range spec.NodeSelector {
+		nodeSelector[key] = value
+	}
+	for key, value := range profile.NodeSelector {
+		nodeSelector[key] = value
+	}
+	spec.NodeSelector = nodeSelector
+	if profile.Resources != nil {
+		spec.Resources = profile.Resources
+	}
+	spec.Tolerations = append(spec.Tolerations, profile.Tolerations...)
+	spec.Rollout = nil
+	spec.NodeProfiles = nil
+
+	f := New(confHash, clusterID, spec, secrets, configMaps, profileSpec, resNames.NodeProfileResourceNames(profile.Name), true, annotations)
+	initializeLabels := f.CommonLabelInitializer
+	f.CommonLabelInitializer = func(o runtime.Object) {
+		initializeLabels(o)
+		utils.AddLabels(runtime.Meta(o), map[string]string{
+			constants.LabelNodeProfile:          profile.Name,
+			constants.LabelNodeProfileForwarder: resNames.ForwarderName,
+		})
+	}
+	return f
+}
+
+// excludeNodeProfiles returns the affinity of the collector which does not schedule the collector on the nodes of the
+// node profiles. A node is excluded by a profile when any label of the node selector of the profile does not match,
+// which is added to each of the required node selector terms of the affinity
+func excludeNodeProfiles(affinity *v1.Affinity, profiles []obs.CollectorNodeProfile) *v1.Affinity {
+	if len(profiles) == 0 {
+		return affinity
+	}
+	result := &v1.Affinity{}
+	if affinity != nil {
+		result = affinity.DeepCopy()
+	}
+	if result.NodeAffinity == nil {
+		result.NodeAffinity = &v1.NodeAffinity{}
+	}
+	terms := []v1.NodeSelectorTerm{{}}
+	if required := result.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; required != nil && len(required.NodeSelectorTerms) > 0 {
+		terms = required.NodeSelectorTerms
+	}
+	for _, profile := range profiles {
+		keys := make([]string, 0, len(profile.NodeSelector))
+		for key := range profile.NodeSelector {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		var excluded []v1.NodeSelectorTerm
+		for _, term := range terms {
+			for _, key := range keys {
+				excludedTerm := term.DeepCopy()
+				excludedTerm.MatchExpressions = append(excludedTerm.MatchExpressions, v1.NodeSelectorRequirement{
+					Key:      key,
+					Operator: v1.NodeSelectorOpNotIn,
+					Values:   []string{profile.NodeSelector[key]},
+				})
+				excluded = append(excluded, *excludedTerm)
+			}
+		}
+		terms = excluded
+	}
+	result.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &v1.NodeSelector{NodeSelectorTerms: terms}
+	return result
+}
@@ -0,0 +1,101 @@
+package collector
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	coreFactory "github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	obsruntime "github.com/openshift/cluster-logging-operator/internal/runtime/observability"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("Collector node profiles", func() {
+	var (
+		resourceNames *coreFactory.ForwarderResourceNames
+		collectorSpec *obs.CollectorSpec
+		gpuProfile    = obs.CollectorNodeProfile{
+			Name:         "gpu",
+			NodeSelector: map[string]string{"node-role.kubernetes.io/gpu": ""},
+			Resources: &v1.ResourceRequirements{
+				Limits: v1.ResourceList{v1.ResourceMemory: resource.MustParse("4Gi")},
+			},
+			Tolerations: []v1.Toleration{{Key: "nvidia.com/gpu", Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule}},
+		}
+		infraProfile = obs.CollectorNodeProfile{
+			Name:         "infra",
+			NodeSelector: map[string]string{"node-role.kubernetes.io/infra": "", "zone": "a"},
+		}
+	)
+	BeforeEach(func() {
+		resourceNames = coreFactory.ResourceNames(*obsruntime.NewClusterLogForwarder("mynamespace", "test", runtime.Initialize))
+		collectorSpec = &obs.CollectorSpec{
+			NodeSelector: map[string]string{"kubernetes.io/os": "linux"},
+			Tolerations:  []v1.Toleration{{Key: "node-role.kubernetes.io/master", Operator: v1.TolerationOpExists}},
+			Resources: &v1.ResourceRequirements{
+				Limits: v1.ResourceList{v1.ResourceMemory: resource.MustParse("1Gi")},
+			},
+			NodeProfiles: []obs.CollectorNodeProfile{gpuProfile, infraProfile},
+		}
+	})
+
+	Context("#NewNodeProfile", func() {
+		It("should deploy the collector with the resources and tolerations of the profile on its nodes", func() {
+			f := NewNodeProfile("abc", "12345", collectorSpec, gpuProfile, nil, nil, obs.ClusterLogForwarderSpec{}, resourceNames, nil)
+			Expect(f.ResourceNames.DaemonSetName()).To(Equal("test-gpu"))
+			Expect(f.ResourceNames.ConfigMap).To(Equal("test-gpu-config"))
+			Expect(f.NodeSelector()).To(Equal(map[string]string{"kubernetes.io/os": "linux", "node-role.kubernetes.io/gpu": ""}))
+			Expect(f.Tolerations()).To(HaveLen(2))
+			Expect(f.CollectorResourceRequirements()).To(Equal(*gpuProfile.Resources))
+			Expect(f.Affinity()).To(BeNil(), "Exp. the profile to not exclude the nodes of the profiles")
+			Expect(collectorSpec.NodeSelector).To(HaveLen(1), "Exp. the spec of the collector to not be modified")
+		})
+
+		It("should label the resources of the profile with the profile and the forwarder", func() {
+			f := NewNodeProfile("abc", "12345", collectorSpec, infraProfile, nil, nil, obs.ClusterLogForwarderSpec{}, resourceNames, nil)
+			configMap := f.NewCollectorConfig("mynamespace", "config")
+			Expect(configMap.Labels).To(HaveKeyWithValue(constants.LabelNodeProfile, "infra"))
+			Expect(configMap.Labels).To(HaveKeyWithValue(constants.LabelNodeProfileForwarder, "test"))
+			Expect(configMap.Labels).To(HaveKeyWithValue(constants.LabelK8sInstance, "test-infra"))
+		})
+	})
+
+	Context("#Affinity", func() {
+		It("should exclude the nodes of the profiles from the collector of the forwarder", func() {
+			f := New("abc", "12345", collectorSpec, nil, nil, obs.ClusterLogForwarderSpec{}, resourceNames, true, nil)
+			terms := f.Affinity().NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+			notIn := func(key, value string) v1.NodeSelectorRequirement {
+				return v1.NodeSelectorRequirement{Key: key, Operator: v1.NodeSelectorOpNotIn, Values: []string{value}}
+			}
+			Expect(terms).To(ConsistOf(
+				v1.NodeSelectorTerm{MatchExpressions: []v1.NodeSelectorRequirement{notIn("node-role.kubernetes.io/gpu", ""), notIn("node-role.kubernetes.io/infra", "")}},
+				v1.NodeSelectorTerm{MatchExpressions: []v1.NodeSelectorRequirement{notIn("node-role.kubernetes.io/gpu", ""), notIn("zone", "a")}},
+			))
+		})
+
+		It("should exclude the nodes of the profiles from each required term of the affinity of the collector", func() {
+			collectorSpec.NodeProfiles = []obs.CollectorNodeProfile{gpuProfile}
+			arch := v1.NodeSelectorRequirement{Key: "kubernetes.io/arch", Operator: v1.NodeSelectorOpIn, Values: []string{"amd64"}}
+			collectorSpec.Affinity = &v1.Affinity{
+				NodeAffinity: &v1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+						NodeSelectorTerms: []v1.NodeSelectorTerm{{MatchExpressions: []v1.NodeSelectorRequirement{arch}}},
+					},
+				},
+			}
+			f := New("abc", "12345", collectorSpec, nil, nil, obs.ClusterLogForwarderSpec{}, resourceNames, true, nil)
+			terms := f.Affinity().NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+			Expect(terms).To(HaveLen(1))
+			Expect(terms[0].MatchExpressions).To(HaveLen(2))
+			Expect(terms[0].MatchExpressions[0]).To(Equal(arch))
+			Expect(collectorSpec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions).To(HaveLen(1), "Exp. the spec of the collector to not be modified")
+		})
+
+		It("should not exclude nodes from a collector deployment", func() {
+			f := New("abc", "12345", collectorSpec, nil, nil, obs.ClusterLogForwarderSpec{}, resourceNames, false, nil)
+			Expect(f.Affinity()).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,189 @@
+package collector
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// DefaultCanaryPercentage is the percentage of canary nodes when not defined by spec.collector.rollout
+	DefaultCanaryPercentage int32 = 10
+
+	// DefaultSoakPeriod is the soak period of the canary collectors when not defined by spec.collector.rollout
+	DefaultSoakPeriod = 10 * time.Minute
+
+	configHashEnvVar = "COLLECTOR_CONF_HASH"
+)
+
+// failingWaitingReasons are the reasons of a waiting collector container which fail a canary rollout
+var failingWaitingReasons = sets.New[string](
+	"CrashLoopBackOff",
+	"CreateContainerConfigError",
+	"CreateContainerError",
+	"ErrImagePull",
+	"ImagePullBackOff",
+	"InvalidImageName",
+	"RunContainerError",
+)
+
+// Rollout returns the canary rollout spec of the collector or nil when the collector is not a DaemonSet
+func (f *Factory) Rollout() *obs.CollectorRolloutSpec {
+	if !f.isDaemonset {
+		return nil
+	}
+	return f.CollectorSpec.Rollout
+}
+
+// NewConfigRevision returns the configmap of the revision of the collector config identified by its hash
+func (f *Factory) NewConfigRevision(namespace, configHash string, data map[string]string) *corev1.ConfigMap {
+	configMap := runtime.NewConfigMap(namespace, f.ResourceNames.ConfigRevision(configHash), data, f.CommonLabelInitializer)
+	configMap.Labels[constants.LabelConfigRevision] = configHash
+	return configMap
+}
+
+// WithConfigRevision returns a copy of the factory whose collector mounts the revision of the collector config
+// identified by its hash
+func (f *Factory) WithConfigRevision(configHash string) *Factory {
+	revision := *f
+	resourceNames := *f.ResourceNames
+	resourceNames.ConfigMap = f.ResourceNames.ConfigRevision(configHash)
+	revision.ResourceNames = &resourceNames
+	revision.ConfigHash = configHash
+	return &revision
+}
+
+// NewCanary returns a factory for the canary collectors of a canary rollout which run the revision of the collector
+// config identified by its hash as a separate DaemonSet on the canary nodes. The canary collectors share the data path
+// of the collector to resume reading the logs of the nodes where the collector stopped
+func (f *Factory) NewCanary(configHash string, nodes []string) *Factory {
+	canary := f.WithConfigRevision(configHash)
+	resourceNames := *canary.ResourceNames.CanaryResourceNames()
+	canary.ResourceNames = &resourceNames
+	canary.CollectorSpec = *f.CollectorSpec.DeepCopy()
+	canary.CollectorSpec.Affinity = selectNodes(f.Affinity(), corev1.NodeSelectorOpIn, nodes)
+	canary.CollectorSpec.NodeProfiles = nil
+	canary.CollectorSpec.Rollout = nil
+	canary.CommonLabelInitializer = func(o runtime.Object) {
+		runtime.SetCommonLabels(o, constants.VectorName, resourceNames.CommonName, constants.CollectorName)
+	}
+	return canary
+}
+
+// WithoutNodes returns a copy of the factory whose collector is not scheduled on the nodes
+func (f *Factory) WithoutNodes(nodes []string) *Factory {
+	without := *f
+	without.CollectorSpec = *f.CollectorSpec.DeepCopy()
+	without.CollectorSpec.Affinity = selectNodes(f.CollectorSpec.Affinity, corev1.NodeSelectorOpNotIn, nodes)
+	return &without
+}
+
+// selectNodes returns the affinity which adds the requirement of the node names to each of the required node
+// selector terms of the affinity
+func selectNodes(affinity *corev1.Affinity, operator corev1.NodeSelectorOperator, nodes []string) *corev1.Affinity {
+	result := &corev1.Affinity{}
+	if affinity != nil {
+		result = affinity.DeepCopy()
+	}
+	if result.NodeAffinity == nil {
+		result.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	terms := []corev1.NodeSelectorTerm{{}}
+	if required := result.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; required != nil && len(required.NodeSelectorTerms) > 0 {
+		terms = required.NodeSelectorTerms
+	}
+	for i := range terms {
+		terms[i].MatchFields = append(terms[i].MatchFields, corev1.NodeSelectorRequirement{
+			Key:      metav1.ObjectNameField,
+			Operator: operator,
+			Values:   sets.List(sets.New(nodes...)),
+		})
+	}
+	result.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{NodeSelectorTerms: terms}
+	return result
+}
+
+// ConfigHashOf returns the hash of the config of a collector pod spec
+func ConfigHashOf(podSpec corev1.PodSpec) string {
+	for _, container := range podSpec.Containers {
+		if container.Name != constants.CollectorName {
+			continue
+		}
+		for _, env := range container.Env {
+			if env.Name == configHashEnvVar {
+				return env.Value
+			}
+		}
+	}
+	return ""
+}
+
+// SoakPeriod returns the soak period of the canary collectors
+func SoakPeriod(spec obs.CollectorRolloutSpec) time.Duration {
+	if spec.SoakPeriod == nil {
+		return DefaultSoakPeriod
+	}
+	return spec.SoakPeriod.Duration
+}
+
+// CanaryNodes returns the canary percentage of the nodes running a collector. The nodes are sorted by name to select
+// the same nodes on every evaluation of the rollout
+func CanaryNodes(spec obs.CollectorRolloutSpec, nodes []string) sets.Set[string] {
+	if len(nodes) == 0 {
+		return sets.New[string]()
+	}
+	percentage := DefaultCanaryPercentage
+	if spec.CanaryPercentage != nil {
+		percentage = *spec.CanaryPercentage
+	}
+	sorted := sets.List(sets.New(nodes...))
+	count := int(math.Ceil(float64(len(sorted)) * float64(percentage) / 100))
+	count = max(1, min(count, len(sorted)))
+	return sets.New(sorted[:count]...)
+}
+
+// EvaluateCanary evaluates the health of the canary collector pods. The canary is ready when there is at least one
+// pod, all pods run the config identified by the hash and are ready. A non-empty failure is returned when a pod
+// restarted more than the tolerated restarts or its collector container is failing to run.
+func EvaluateCanary(spec obs.CollectorRolloutSpec, pods []corev1.Pod, configHash string) (ready bool, failure string) {
+	maxRestarts := int32(0)
+	if spec.MaxRestarts != nil {
+		maxRestarts = *spec.MaxRestarts
+	}
+	ready = len(pods) > 0
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil || ConfigHashOf(pod.Spec) != configHash {
+			ready = false
+			continue
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.RestartCount > maxRestarts {
+				return false, fmt.Sprintf("collector pod %s on node %s restarted %d times", pod.Name, pod.Spec.NodeName, status.RestartCount)
+			}
+			if waiting := status.State.Waiting; waiting != nil && failingWaitingReasons.Has(waiting.Reason) {
+				return false, strings.TrimSpace(fmt.Sprintf("collector pod %s on node %s is waiting: %s %s", pod.Name, pod.Spec.NodeName, waiting.Reason, waiting.Message))
+			}
+		}
+		if !isPodReady(pod) {
+			ready = false
+		}
+	}
+	return ready, ""
+}
+
+func isPodReady(pod corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+package collector
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	configv1 "github.com/openshift/api/config/v1"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	coreFactory "github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	obsruntime "github.com/openshift/cluster-logging-operator/internal/runtime/observability"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Collector rollout", func() {
+
+	Context("#CanaryNodes", func() {
+		nodes := []string{"node-d", "node-b", "node-a", "node-c", "node-e"}
+
+		It("should select the default percentage of the nodes and at least one node", func() {
+			Expect(CanaryNodes(obs.CollectorRolloutSpec{}, nodes).UnsortedList()).To(ConsistOf("node-a"))
+		})
+		It("should select the percentage of the nodes sorted by name", func() {
+			spec := obs.CollectorRolloutSpec{CanaryPercentage: utils.GetPtr(int32(50))}
+			Expect(CanaryNodes(spec, nodes).UnsortedList()).To(ConsistOf("node-a", "node-b", "node-c"))
+		})
+		It("should select no nodes when there are none", func() {
+			Expect(CanaryNodes(obs.CollectorRolloutSpec{}, nil).Len()).To(BeZero())
+		})
+	})
+
+	Context("#EvaluateCanary", func() {
+		newPod := func(configHash string, ready bool, restarts int32, waiting string) v1.Pod {
+			pod := v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "collector-abcde"},
+				Spec: v1.PodSpec{
+					NodeName: "node-a",
+					Containers: []v1.Container{
+						{Name: constants.CollectorName, Env: []v1.EnvVar{{Name: configHashEnvVar, Value: configHash}}},
+					},
+				},
+			}
+			status := v1.ConditionFalse
+			if ready {
+				status = v1.ConditionTrue
+			}
+			pod.Status.Conditions = []v1.PodCondition{{Type: v1.PodReady, Status: status}}
+			containerStatus := v1.ContainerStatus{Name: constants.CollectorName, RestartCount: restarts}
+			if waiting != "" {
+				containerStatus.State.Waiting = &v1.ContainerStateWaiting{Reason: waiting}
+			}
+			pod.Status.ContainerStatuses = []v1.ContainerStatus{containerStatus}
+			return pod
+		}
+
+		It("should be ready when the pods are ready with the config", func() {
+			Expect(EvaluateCanary(obs.CollectorRolloutSpec{}, []v1.Pod{newPod("abc", true, 0, "")}, "abc")).To(BeTrue())
+		})
+		It("should not be ready without pods", func() {
+			Expect(EvaluateCanary(obs.CollectorRolloutSpec{}, nil, "abc")).To(BeFalse())
+		})
+		It("should not be ready when a pod runs a different config", func() {
+			Expect(EvaluateCanary(obs.CollectorRolloutSpec{}, []v1.Pod{newPod("xyz", true, 0, "")}, "abc")).To(BeFalse())
+		})
+		It("should fail when a pod restarts more than tolerated", func() {
+			pods := []v1.Pod{newPod("abc", true, 2, "")}
+			_, failure := EvaluateCanary(obs.CollectorRolloutSpec{MaxRestarts: utils.GetPtr(int32(1))}, pods, "abc")
+			Expect(failure).To(Equal("collector pod collector-abcde on node node-a restarted 2 times"))
+			ready, failure := EvaluateCanary(obs.CollectorRolloutSpec{MaxRestarts: utils.GetPtr(int32(2))}, pods, "abc")
+			Expect(ready).To(BeTrue())
+			Expect(failure).To(BeEmpty())
+		})
+		It("should fail when the collector is crashing", func() {
+			_, failure := EvaluateCanary(obs.CollectorRolloutSpec{}, []v1.Pod{newPod("abc", false, 0, "CrashLoopBackOff")}, "abc")
+			Expect(failure).To(ContainSubstring("is waiting: CrashLoopBackOff"))
+		})
+	})
+
+	Context("#WithConfigRevision", func() {
+		It("should mount the revision of the config", func() {
+			factory := &Factory{
+				ConfigHash:    "current",
+				ResourceNames: coreFactory.ResourceNames(*obsruntime.NewClusterLogForwarder("mynamespace", "test", runtime.Initialize)),
+			}
+			revision := factory.WithConfigRevision("0123456789abcdef")
+			Expect(revision.ConfigHash).To(Equal("0123456789abcdef"))
+			Expect(revision.ResourceNames.ConfigMap).To(Equal("test-config-0123456789"))
+			Expect(factory.ResourceNames.ConfigMap).To(Equal("test-config"), "Exp. the factory to not be modified")
+			Expect(factory.ConfigHash).To(Equal("current"))
+		})
+	})
+
+	Context("#NewCanary", func() {
+		var factory *Factory
+		BeforeEach(func() {
+			resourceNames := coreFactory.ResourceNames(*obsruntime.NewClusterLogForwarder("mynamespace", "test", runtime.Initialize))
+			factory = New("current", "clusterid", &obs.CollectorSpec{
+				Rollout:      &obs.CollectorRolloutSpec{},
+				NodeProfiles: []obs.CollectorNodeProfile{{Name: "infra", NodeSelector: map[string]string{"infra": "true"}}},
+			}, nil, nil, obs.ClusterLogForwarderSpec{}, resourceNames, true, nil)
+		})
+
+		It("should run the revision of the config on the canary nodes with the data path of the collector", func() {
+			canary := factory.NewCanary("0123456789abcdef", []string{"node-b", "node-a"})
+			Expect(canary.ResourceNames.DaemonSetName()).To(Equal("test-canary"))
+			Expect(canary.ResourceNames.ConfigMap).To(Equal("test-config-0123456789"))
+			Expect(canary.ResourceNames.ForwarderName).To(Equal("test"), "Exp. the data path of the collector")
+			Expect(canary.Rollout()).To(BeNil())
+			terms := canary.Affinity().NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+			Expect(terms).To(HaveLen(1))
+			Expect(terms[0].MatchFields).To(ConsistOf(v1.NodeSelectorRequirement{Key: "metadata.name", Operator: v1.NodeSelectorOpIn, Values: []string{"node-a", "node-b"}}))
+
+			ds := canary.NewDaemonSet("mynamespace", canary.ResourceNames.DaemonSetName(), nil, configv1.TLSProfileSpec{})
+			Expect(ds.Spec.Selector.MatchLabels).To(HaveKeyWithValue(constants.LabelK8sInstance, "test-canary"))
+			Expect(ds.Spec.Template.Labels).To(HaveKeyWithValue(constants.LabelK8sInstance, "test-canary"))
+		})
+
+		It("should exclude the canary nodes from the collector", func() {
+			without := factory.WithoutNodes([]string{"node-a"})
+			for _, term := range without.Affinity().NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+				Expect(term.MatchFields).To(ConsistOf(v1.NodeSelectorRequirement{Key: "metadata.name", Operator: v1.NodeSelectorOpNotIn, Values: []string{"node-a"}}))
+			}
+			Expect(factory.CollectorSpec.Affinity).To(BeNil(), "Exp. the factory to not be modified")
+		})
+	})
+})
@@ -22,6 +22,12 @@ const (
 //go:embed run-vector.sh
 var RunVectorScript string
 
+// ValidateVectorScript is the script for validating the configs mounted into the Vector container before they are
+// rolled out to the collector
+//
+//go:embed validate-vector.sh
+var ValidateVectorScript string
+
 func GetDataPath(namespace, forwarderName string) string {
 	//legacy installation
 	if constants.OpenshiftNS == namespace && constants.SingletonName == forwarderName {
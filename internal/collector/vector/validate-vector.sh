@@ -0,0 +1,10 @@
+#!/bin/bash
+
+set -uo pipefail
+
+# Validate each config without the environment checks of its components (e.g. connectivity to sinks, existence of
+# the data directory) which depend upon the node and secrets of the collector
+for config in /etc/vector/*.toml; do
+  echo "Validating ${config}"
+  /usr/bin/vector validate --no-environment --config-toml "${config}" || exit 1
+done
@@ -3,11 +3,6 @@ package constants
 const (
 	AnnotationDebugOutput = "logging.openshift.io/debug-output"
 
-	// AnnotationEnableCollectorAsDeployment is to enable deploying the collector as a deployment
-	// instead of a daemonset to support the HCP use case of using the collector for collecting
-	// audit logs via a webhook.
-	AnnotationEnableCollectorAsDeployment = "logging.openshift.io/dev-preview-enable-collector-as-deployment"
-
 	// AnnotationVectorLogLevel is used to set the log level of vector.
 	// Log level can be one of: trace, debug, info, warn, error, off.
 	// CLO's default log level for vector is `warn`: https://issues.redhat.com/browse/LOG-3435
@@ -16,6 +11,26 @@ const (
 	AnnotationSecretHash    = "observability.openshift.io/secret-hash"
 	AnnotationConfigMapHash = "observability.openshift.io/configmap-hash"
 
+	// AnnotationConfigHash is the hash of the configs validated by the config validation job of the collector
+	AnnotationConfigHash = "observability.openshift.io/config-hash"
+
+	// AnnotationRolloutStableConfigHash is the hash of the config rolled out to all collectors of a DaemonSet
+	AnnotationRolloutStableConfigHash = "observability.openshift.io/rollout-stable-config-hash"
+	// AnnotationRolloutCanaryConfigHash is the hash of the config rolled out to the canary collectors of a DaemonSet
+	AnnotationRolloutCanaryConfigHash = "observability.openshift.io/rollout-canary-config-hash"
+	// AnnotationRolloutCanaryStarted is the time the canary rollout of a config started
+	AnnotationRolloutCanaryStarted = "observability.openshift.io/rollout-canary-started"
+	// AnnotationRolloutCanaryReady is the time since which the canary collectors are ready
+	AnnotationRolloutCanaryReady = "observability.openshift.io/rollout-canary-ready"
+	// AnnotationRolloutCanaryNodes is the comma separated list of the nodes of the canary collectors
+	AnnotationRolloutCanaryNodes = "observability.openshift.io/rollout-canary-nodes"
+	// AnnotationRolloutRolledBackConfigHash is the hash of the last config rolled back from the canary collectors
+	AnnotationRolloutRolledBackConfigHash = "observability.openshift.io/rollout-rolled-back-config-hash"
+	// AnnotationRolloutRolledBackReason is the reason the last config was rolled back from the canary collectors
+	AnnotationRolloutRolledBackReason = "observability.openshift.io/rollout-rolled-back-reason"
+	// AnnotationRolloutRolledBackGeneration is the generation of the forwarder when the last config was rolled back
+	AnnotationRolloutRolledBackGeneration = "observability.openshift.io/rollout-rolled-back-generation"
+
 	// AnnotationMaxUnavailable (Deprecated) configures the maximum number of DaemonSet pods that can be unavailable during a rolling update.
 	// This can be an absolute number (e.g., 1) or a percentage (e.g., 10%). Default is 100%.
 	AnnotationMaxUnavailable = "observability.openshift.io/max-unavailable-rollout"
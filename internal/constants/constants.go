@@ -49,6 +49,11 @@ const (
 	LogfilesmetricexporterPort        = int32(2112)
 	MetricsPortName                   = "metrics"
 	MetricsPort                       = int32(24231)
+	AggregatorName                    = "aggregator"
+	AggregatorPortName                = "aggregator"
+	AggregatorPort                    = int32(6000)
+	ServiceCABundleConfigMapName      = "openshift-service-ca.crt"
+	ServiceCABundleKey                = "service-ca.crt"
 	MetricsCollectionProfileFull      = "full"
 	MetricsCollectionProfileMinimal   = "minimal"
 	MetricsCollectionProfileTelemetry = "telemetry"
@@ -66,11 +71,14 @@ const (
 	VectorImageEnvVar         = "RELATED_IMAGE_VECTOR"
 	LogfilesmetricImageEnvVar = "RELATED_IMAGE_LOG_FILE_METRIC_EXPORTER"
 
+	// NodeLogDir is the directory of the node which contains the log files read by the collector
+	NodeLogDir      = "/var/log"
 	ContainerLogDir = "/var/log/containers"
 	PodLogDir       = "/var/log/pods"
 
 	// Annotation Names
 	AnnotationServingCertSecretName = "service.beta.openshift.io/serving-cert-secret-name"
+	AnnotationInjectCABundle        = "service.beta.openshift.io/inject-cabundle"
 
 	ClusterLogging         = "cluster-logging"
 	ClusterLoggingOperator = "cluster-logging-operator"
@@ -16,6 +16,18 @@ const (
 
 	LabelMetricsCollectionProfile = "monitoring.openshift.io/collection-profile"
 
+	// LabelConfigRevision identifies a revision of the config of the collector by its hash
+	LabelConfigRevision = "observability.openshift.io/config-revision"
+
+	// LabelNodeProfile identifies the node profile of a collector deployed on the nodes of the profile
+	LabelNodeProfile = "observability.openshift.io/node-profile"
+
+	// LabelNodeProfileForwarder identifies the forwarder of a collector deployed on the nodes of a node profile
+	LabelNodeProfileForwarder = "observability.openshift.io/node-profile-forwarder"
+
+	// LabelTenantForwarder identifies the forwarder of the copy of a secret referenced by the LogForwarder of a tenant
+	LabelTenantForwarder = "observability.openshift.io/tenant-forwarder"
+
 	ServiceTypeMetrics = "metrics"
 	ServiceTypeInput   = "input"
 )
@@ -0,0 +1,151 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/auth"
+	"github.com/openshift/cluster-logging-operator/internal/collector"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/network"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/runtime/service"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ReconcileAggregator deploys the generated config and the aggregator tier of a two-tier collector which receives logs
+// from the collectors on the nodes and forwards them to the outputs
+func ReconcileAggregator(context internalcontext.ForwarderContext, aggregatorConfig string, aggregatorFactory *collector.Factory, trustedCABundle *corev1.ConfigMap, ownerRef metav1.OwnerReference) (err error) {
+	aggregatorNames := aggregatorFactory.ResourceNames
+
+	if err = aggregatorFactory.ReconcileCollectorConfig(context.Client, context.Reader, context.Forwarder.Namespace, aggregatorConfig, ownerRef); err != nil {
+		log.Error(err, "aggregator.ReconcileCollectorConfig")
+		return err
+	}
+
+	if err = aggregatorFactory.ReconcileDeployment(context.Client, context.Forwarder.Namespace, trustedCABundle, ownerRef); err != nil {
+		log.Error(err, "aggregator.ReconcileDeployment")
+		return err
+	}
+
+	networkPolicyName := fmt.Sprintf("%s-%s", constants.CollectorName, aggregatorNames.CommonName)
+	if context.Forwarder.Spec.Collector.NetworkPolicy != nil {
+		if err = network.ReconcileAggregatorNetworkPolicy(context.Client, context.Forwarder.Namespace, networkPolicyName, aggregatorNames.ForwarderName, constants.CollectorName, context.Forwarder.Spec.Collector.NetworkPolicy.RuleSet, context.Forwarder.Spec.Outputs, ownerRef, aggregatorFactory.CommonLabelInitializer); err != nil {
+			log.Error(err, "aggregator.ReconcileNetworkPolicy")
+			return err
+		}
+	} else if err = network.RemoveNetworkPolicy(context.Client, context.Forwarder.Namespace, networkPolicyName); err != nil {
+		log.Error(err, "aggregator.RemoveNetworkPolicy")
+		return err
+	}
+
+	if err = network.ReconcileAggregatorService(context.Client, context.Forwarder.Namespace, aggregatorNames.CommonName, aggregatorNames.ForwarderName, constants.CollectorName, aggregatorNames.SecretMetrics, ownerRef, aggregatorFactory.CommonLabelInitializer); err != nil {
+		log.Error(err, "aggregator.ReconcileService")
+		return err
+	}
+
+	return reconcileServiceMonitors(context.Client, context.Forwarder.Namespace, aggregatorNames.CommonName, ownerRef)
+}
+
+// generateAggregator generates the config of the aggregator tier and the factory of its resources
+func generateAggregator(context internalcontext.ForwarderContext, resourceNames *factory.ForwarderResourceNames, options framework.Options) (aggregatorConfig string, aggregatorFactory *collector.Factory, err error) {
+	options[framework.OptionCollectorTier] = framework.CollectorTierAggregator
+	if aggregatorConfig, err = GenerateConfig(context.Client, *context.Forwarder, *resourceNames, context.Secrets, options); err != nil {
+		log.V(9).Error(err, "aggregator.GenerateConfig")
+		return "", nil, err
+	}
+	log.V(3).Info("Generated aggregator config", "config", aggregatorConfig)
+	var aggregatorConfHash string
+	if aggregatorConfHash, err = utils.CalculateMD5Hash(aggregatorConfig); err != nil {
+		log.Error(err, "unable to calculate MD5 hash")
+		return "", nil, err
+	}
+
+	aggregatorFactory = collector.NewAggregator(
+		aggregatorConfHash,
+		context.ClusterID,
+		context.Forwarder.Spec.Collector.Aggregator,
+		context.Secrets, context.ConfigMaps,
+		context.Forwarder.Spec,
+		resourceNames,
+		context.Forwarder.Annotations,
+	)
+	return aggregatorConfig, aggregatorFactory, nil
+}
+
+// reconcileAggregatorClientCertificate reconciles the client certificate of the agent tier and the CA the aggregator
+// tier uses to verify it and adds them to the secrets and configmaps of the forwarder
+func reconcileAggregatorClientCertificate(context internalcontext.ForwarderContext, resourceNames *factory.ForwarderResourceNames, ownerRef metav1.OwnerReference) error {
+	secret, caBundle, err := auth.ReconcileAggregatorClientCertificate(context.Client, context.Reader, context.Forwarder.Namespace, resourceNames.AggregatorClientSecret, resourceNames.AggregatorClientCA, resourceNames.CommonName, ownerRef)
+	if err != nil {
+		return err
+	}
+	context.Secrets[secret.Name] = secret
+	context.ConfigMaps[caBundle.Name] = caBundle
+	return nil
+}
+
+// agentResources returns the secrets, configmaps and spec of the agent tier of a two-tier collector. The agent only reads
+// the inputs and forwards them to the aggregator tier so it is only given what its inputs reference, the client certificate
+// and the service CA used to verify the aggregator
+func agentResources(context internalcontext.ForwarderContext, resourceNames *factory.ForwarderResourceNames, spec obs.ClusterLogForwarderSpec) (internalobs.Secrets, internalobs.ConfigMaps, obs.ClusterLogForwarderSpec) {
+	inputs := internalobs.Inputs(spec.Inputs)
+	secretNames := append(inputs.SecretNames(), resourceNames.AggregatorClientSecret)
+	if inputs.HasEventsSource() {
+		secretNames = append(secretNames, resourceNames.ServiceAccountTokenSecret)
+	}
+	secrets := internalobs.Secrets{}
+	for _, name := range secretNames {
+		if secret, found := context.Secrets[name]; found {
+			secrets[name] = secret
+		}
+	}
+	configMaps := internalobs.ConfigMaps{}
+	for _, name := range append(inputs.ConfigmapNames(), constants.ServiceCABundleConfigMapName) {
+		if configMap, found := context.ConfigMaps[name]; found {
+			configMaps[name] = configMap
+		}
+	}
+	spec.Outputs = nil
+	spec.Pipelines = nil
+	spec.Filters = nil
+	return secrets, configMaps, spec
+}
+
+// RemoveAggregator removes the aggregator tier when the collector no longer forwards to it
+func RemoveAggregator(k8Client client.Client, namespace string, resourceNames *factory.ForwarderResourceNames) error {
+	aggregatorNames := resourceNames.AggregatorResourceNames()
+	if err := collector.RemoveDeployment(k8Client, namespace, aggregatorNames.CommonName); err != nil {
+		return err
+	}
+	if err := network.RemoveNetworkPolicy(k8Client, namespace, fmt.Sprintf("%s-%s", constants.CollectorName, aggregatorNames.CommonName)); err != nil {
+		return err
+	}
+	if err := service.Delete(k8Client, namespace, aggregatorNames.CommonName); err != nil {
+		return err
+	}
+	objects := []client.Object{
+		runtime.NewConfigMap(namespace, aggregatorNames.ConfigMap, nil),
+		runtime.NewSecret(namespace, resourceNames.AggregatorClientSecret, nil),
+		runtime.NewConfigMap(namespace, resourceNames.AggregatorClientCA, nil),
+		runtime.NewServiceMonitor(namespace, aggregatorNames.CommonName),
+		runtime.NewServiceMonitor(namespace, constants.MetricsCollectionProfileMinimal+"-"+aggregatorNames.CommonName),
+		runtime.NewServiceMonitor(namespace, constants.MetricsCollectionProfileTelemetry+"-"+aggregatorNames.CommonName),
+	}
+	for _, o := range objects {
+		if err := k8Client.Delete(context.TODO(), o); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failure deleting %s/%s: %v", namespace, o.GetName(), err)
+		}
+	}
+	return nil
+}
@@ -3,11 +3,17 @@ package observability
 import (
 	"context"
 	"encoding/json"
+	goerrors "errors"
+	"fmt"
+	"slices"
 	"time"
 
 	v1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -29,11 +35,13 @@ import (
 	"github.com/openshift/cluster-logging-operator/version"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/set"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
 const (
@@ -49,6 +57,16 @@ var (
 	}
 
 	defaultRequeue = ctrl.Result{}
+
+	// configValidationRequeue to check the result of the config validation job when its status change is missed
+	configValidationRequeue = ctrl.Result{
+		RequeueAfter: time.Second * 30,
+	}
+
+	// rolloutRequeue to evaluate the health of the canary collectors during a rollout of the collector config
+	rolloutRequeue = ctrl.Result{
+		RequeueAfter: time.Second * 30,
+	}
 )
 
 // ClusterLogForwarderReconciler reconciles a ClusterLogForwarder object
@@ -112,26 +130,80 @@ func (r *ClusterLogForwarderReconciler) Reconcile(_ context.Context, req ctrl.Re
 			if deleteErr := collector.Remove(cxt.Client, cxt.Forwarder.Namespace, cxt.Forwarder.Name); deleteErr != nil {
 				log.V(0).Error(deleteErr, "Unable to remove collector deployment")
 			}
+			if deleteErr := RemoveNodeProfiles(cxt.Client, cxt.Forwarder.Namespace, factory.ResourceNames(*cxt.Forwarder), nil); deleteErr != nil {
+				log.V(0).Error(deleteErr, "Unable to remove the collectors of the node profiles")
+			}
 		}
 		return defaultRequeue, err
 	}
 
+	tenants, err := mergeTenants(cxt)
+	if err != nil {
+		readyCond.Reason = obsv1.ReasonInitializationFailed
+		readyCond.Message = err.Error()
+		return defaultRequeue, err
+	}
+
+	if cxt.Forwarder.Spec.ManagementState == obsv1.ManagementStatePreview {
+		readyCond.Status = obsv1.ConditionUnknown
+		readyCond.Reason = obsv1.ReasonManagementStatePreview
+		changes, previewErr := PreviewCollector(cxt)
+		if previewErr != nil {
+			log.V(2).Error(previewErr, "preview error")
+			readyCond.Message = previewErr.Error()
+			return defaultRequeue, previewErr
+		}
+		updated := 0
+		for _, change := range changes {
+			if change.Operation != controllerutil.OperationResultNone {
+				updated++
+			}
+		}
+		readyCond.Message = fmt.Sprintf("Updates are previewed in configmap %q when the managementState is Preview: %d of %d resources would change",
+			factory.ResourceNames(*cxt.Forwarder).Preview, updated, len(changes))
+		return defaultRequeue, nil
+	}
+
 	if err = RemoveStaleWorkload(cxt.Client, cxt.Forwarder); err != nil {
 		readyCond.Reason = obsv1.ReasonFailureToRemoveStaleWorkload
 		readyCond.Message = err.Error()
 		return defaultRequeue, err
 	}
 
+	if err = reconcileTenants(cxt, tenants, utils.AsOwner(cxt.Forwarder)); err != nil {
+		readyCond.Reason = obsv1.ReasonDeploymentError
+		readyCond.Message = err.Error()
+		return defaultRequeue, err
+	}
+
 	reconcileErr := ReconcileCollector(cxt, r.PollInterval, r.TimeOut)
-	if reconcileErr != nil {
+	var validationErr *ConfigValidationError
+	var unavailableErr *ConfigValidationUnavailableError
+	switch {
+	case goerrors.Is(reconcileErr, ErrConfigValidationPending), goerrors.As(reconcileErr, &unavailableErr):
+		readyCond.Status = obsv1.ConditionUnknown
+		readyCond.Reason = obsv1.ReasonConfigValidationPending
+		readyCond.Message = reconcileErr.Error()
+		return configValidationRequeue, nil
+	case goerrors.As(reconcileErr, &validationErr):
+		readyCond.Reason = obsv1.ReasonConfigValidationFailure
+		readyCond.Message = reconcileErr.Error()
+		return defaultRequeue, nil
+	case reconcileErr != nil:
 		log.V(2).Error(reconcileErr, "reconcile error")
 		readyCond.Reason = obsv1.ReasonDeploymentError
 		readyCond.Message = reconcileErr.Error()
 		return defaultRequeue, reconcileErr
 	}
+	if err = RemovePreview(cxt.Client, cxt.Forwarder.Namespace, factory.ResourceNames(*cxt.Forwarder)); err != nil {
+		log.V(2).Error(err, "Unable to remove the preview")
+	}
 	readyCond.Reason = obsv1.ReasonReconciliationComplete
 	readyCond.Status = obsv1.ConditionTrue
 
+	if rollout := meta.FindStatusCondition(cxt.Forwarder.Status.Conditions, obsv1.ConditionTypeRollout); rollout != nil && rollout.Status == obsv1.ConditionUnknown {
+		return rolloutRequeue, nil
+	}
 	return periodicRequeue, nil
 }
 
@@ -213,6 +285,7 @@ func initialize(cxt internalcontext.ForwarderContext) (internalcontext.Forwarder
 		cxt.ClusterVersion = clusterVersion
 		cxt.ClusterID = clusterID
 	}
+	cxt.ClusterRegion = version.ClusterRegion(cxt.Reader)
 	return cxt, nil
 }
 
@@ -223,6 +296,9 @@ func (r *ClusterLogForwarderReconciler) SetupWithManager(mgr ctrl.Manager) error
 		Owns(&corev1.ConfigMap{}).
 		Owns(&appsv1.DaemonSet{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Owns(&appsv1.Deployment{}).
+		Owns(&autoscalingv2.HorizontalPodAutoscaler{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Owns(&policyv1.PodDisruptionBudget{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Owns(&batchv1.Job{}).
 		Owns(&networkingv1.NetworkPolicy{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Owns(&rbacv1.Role{}).
 		Owns(&rbacv1.RoleBinding{}).
@@ -234,9 +310,103 @@ func (r *ClusterLogForwarderReconciler) SetupWithManager(mgr ctrl.Manager) error
 			handler.EnqueueRequestsFromMapFunc(r.mapAPIServerToClusterLogForwarders),
 			builder.WithPredicates(tls.APIServerTLSProfileChangedPredicate(true)),
 		).
+		Watches(
+			&obsv1.LogForwarder{},
+			handler.EnqueueRequestsFromMapFunc(r.mapLogForwarderToClusterLogForwarders),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.mapTenantSecretToClusterLogForwarder),
+		).
+		Watches(
+			&corev1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(r.mapNamespaceToClusterLogForwarders),
+			builder.WithPredicates(predicate.LabelChangedPredicate{}),
+		).
 		Complete(r)
 }
 
+// mapTenantSecretToClusterLogForwarder maps changes of the secrets referenced by the LogForwarders of a namespace to the
+// ClusterLogForwarder serving it which copies them
+func (r *ClusterLogForwarderReconciler) mapTenantSecretToClusterLogForwarder(ctx context.Context, obj client.Object) []ctrl.Request {
+	k8sClient := r.NewForwarderContext().Client
+	logForwarders := &obsv1.LogForwarderList{}
+	if err := k8sClient.List(ctx, logForwarders, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.Error(err, "Failed to list LogForwarders", "namespace", obj.GetNamespace())
+		return nil
+	}
+	referenced := false
+	for _, logForwarder := range logForwarders.Items {
+		if slices.Contains(internalobs.Outputs(logForwarder.Spec.Outputs).SecretNames(), obj.GetName()) {
+			referenced = true
+			break
+		}
+	}
+	if !referenced {
+		return nil
+	}
+	namespace := &corev1.Namespace{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: obj.GetNamespace()}, namespace); err != nil {
+		log.Error(err, "Failed to get the namespace of a LogForwarder", "namespace", obj.GetNamespace())
+		return nil
+	}
+	clfList := &obsv1.ClusterLogForwarderList{}
+	if err := k8sClient.List(ctx, clfList); err != nil {
+		log.Error(err, "Failed to list ClusterLogForwarders")
+		return nil
+	}
+	host := servingForwarder(*namespace, clfList.Items)
+	if host == nil {
+		return nil
+	}
+	log.V(3).Info("LogForwarder secret changed, enqueuing the serving ClusterLogForwarder", "namespace", obj.GetNamespace(), "name", obj.GetName(), "forwarder", host.Name)
+	return []ctrl.Request{
+		{
+			NamespacedName: types.NamespacedName{
+				Name:      host.Name,
+				Namespace: host.Namespace,
+			},
+		},
+	}
+}
+
+// mapNamespaceToClusterLogForwarders maps label changes of a namespace with LogForwarders to the ClusterLogForwarders with
+// a tenancy since the forwarder serving the namespace may change
+func (r *ClusterLogForwarderReconciler) mapNamespaceToClusterLogForwarders(ctx context.Context, obj client.Object) []ctrl.Request {
+	logForwarders := &obsv1.LogForwarderList{}
+	if err := r.NewForwarderContext().Client.List(ctx, logForwarders, client.InNamespace(obj.GetName())); err != nil {
+		log.Error(err, "Failed to list LogForwarders", "namespace", obj.GetName())
+		return nil
+	}
+	if len(logForwarders.Items) == 0 {
+		return nil
+	}
+	return r.mapLogForwarderToClusterLogForwarders(ctx, obj)
+}
+
+// mapLogForwarderToClusterLogForwarders maps LogForwarder changes to the ClusterLogForwarders with a tenancy
+func (r *ClusterLogForwarderReconciler) mapLogForwarderToClusterLogForwarders(ctx context.Context, obj client.Object) []ctrl.Request {
+	clfList := &obsv1.ClusterLogForwarderList{}
+	if err := r.NewForwarderContext().Client.List(ctx, clfList); err != nil {
+		log.Error(err, "Failed to list ClusterLogForwarders")
+		return nil
+	}
+	var requests []ctrl.Request
+	for _, clf := range clfList.Items {
+		if clf.Spec.Tenancy != nil {
+			requests = append(requests, ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      clf.Name,
+					Namespace: clf.Namespace,
+				},
+			})
+		}
+	}
+	log.V(3).Info("LogForwarder changed, enqueuing ClusterLogForwarders", "namespace", obj.GetNamespace(), "name", obj.GetName(), "count", len(requests))
+	return requests
+}
+
 // mapAPIServerToClusterLogForwarders maps APIServer changes to all ClusterLogForwarders
 func (r *ClusterLogForwarderReconciler) mapAPIServerToClusterLogForwarders(ctx context.Context, obj client.Object) []ctrl.Request {
 	if !tls.IsClusterAPIServer(obj) {
@@ -270,7 +440,7 @@ func validateForwarder(forwarderContext internalcontext.ForwarderContext) (valid
 	if valid = internalobs.IsValidSpec(*forwarderContext.Forwarder); !valid {
 		validCond.Status = obsv1.ConditionFalse
 		validCond.Reason = obsv1.ReasonValidationFailure
-		validCond.Message = "one or more conditions [inputs, outputs, pipelines, filters] have failed validation"
+		validCond.Message = "one or more conditions [inputs, outputs, pipelines, filters, node profiles] have failed validation"
 	}
 	internalobs.SetCondition(&forwarderContext.Forwarder.Status.Conditions, validCond)
 	return valid
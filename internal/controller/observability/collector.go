@@ -21,10 +21,12 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/metrics"
 	"github.com/openshift/cluster-logging-operator/internal/network"
 	"github.com/openshift/cluster-logging-operator/internal/reconcile"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
 	"github.com/openshift/cluster-logging-operator/internal/runtime/serviceaccount"
 	"github.com/openshift/cluster-logging-operator/internal/tls"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -45,9 +47,13 @@ func ReconcileCollector(context internalcontext.ForwarderContext, pollInterval,
 	if context.AdditionalContext != nil {
 		options = context.AdditionalContext
 	}
+	if context.ClusterRegion != "" {
+		options[framework.OptionClusterRegion] = context.ClusterRegion
+	}
 
-	if internalobs.Outputs(context.Forwarder.Spec.Outputs).NeedServiceAccountToken() {
+	if internalobs.Outputs(context.Forwarder.Spec.Outputs).NeedServiceAccountToken() || internalobs.Inputs(context.Forwarder.Spec.Inputs).HasEventsSource() {
 		// temporarily create SA token until collector is capable of dynamically reloading a projected serviceaccount token
+		// the token is also used to list cluster events from the API server
 		var sa *corev1.ServiceAccount
 		sa, err = serviceaccount.Get(context.Client, context.Forwarder.Namespace, context.Forwarder.Spec.ServiceAccount.Name)
 		if err != nil {
@@ -93,6 +99,15 @@ func ReconcileCollector(context internalcontext.ForwarderContext, pollInterval,
 		}
 	}
 
+	useAggregator := internalobs.UseAggregator(*context.Forwarder)
+	aggregatorOptions := initializeAggregator(&context, options)
+	if useAggregator {
+		if err = reconcileAggregatorClientCertificate(context, resourceNames, ownerRef); err != nil {
+			log.Error(err, "reconcileAggregatorClientCertificate")
+			return err
+		}
+	}
+
 	var collectorConfig string
 	if collectorConfig, err = GenerateConfig(context.Client, *context.Forwarder, *resourceNames, context.Secrets, options); err != nil {
 		log.V(9).Error(err, "collector.GenerateConfig")
@@ -108,30 +123,81 @@ func ReconcileCollector(context internalcontext.ForwarderContext, pollInterval,
 
 	isDaemonSet := !internalobs.DeployAsDeployment(*context.Forwarder)
 	log.V(3).Info("Deploying as DaemonSet", "isDaemonSet", isDaemonSet)
+	secrets, configMaps, spec := internalobs.Secrets(context.Secrets), internalobs.ConfigMaps(context.ConfigMaps), context.Forwarder.Spec
+	if useAggregator {
+		secrets, configMaps, spec = agentResources(context, resourceNames, spec)
+	}
 	collectorFactory := collector.New(
 		collectorConfHash,
 		context.ClusterID,
 		context.Forwarder.Spec.Collector,
-		context.Secrets, context.ConfigMaps,
-		context.Forwarder.Spec,
+		secrets, configMaps,
+		spec,
 		resourceNames,
 		isDaemonSet,
 		context.Forwarder.Annotations,
 	)
 
+	var aggregatorConfig string
+	var aggregatorFactory *collector.Factory
+	if useAggregator {
+		if aggregatorConfig, aggregatorFactory, err = generateAggregator(context, resourceNames, aggregatorOptions); err != nil {
+			return err
+		}
+	}
+
+	var profiles []nodeProfile
+	if profiles, err = generateNodeProfiles(context, resourceNames, options); err != nil {
+		return err
+	}
+
+	// keep running the deployed config until the collector accepts the generated config
+	if context.ValidateConfig {
+		configs := []*corev1.ConfigMap{collectorFactory.NewCollectorConfig(context.Forwarder.Namespace, collectorConfig)}
+		if useAggregator {
+			configs = append(configs, aggregatorFactory.NewCollectorConfig(context.Forwarder.Namespace, aggregatorConfig))
+		}
+		for _, profile := range profiles {
+			configs = append(configs, profile.factory.NewCollectorConfig(context.Forwarder.Namespace, profile.config))
+		}
+		if err = ValidateCollectorConfig(context, collectorFactory, ownerRef, configs...); err != nil {
+			log.V(3).Error(err, "ValidateCollectorConfig")
+			return err
+		}
+	}
+
+	// keep the deployed config to be able to roll back the canary collectors to it
+	if collectorFactory.Rollout() != nil {
+		if err = snapshotConfigRevision(context, collectorFactory, ownerRef); err != nil {
+			log.Error(err, "snapshotConfigRevision")
+			return err
+		}
+	}
+
 	if err = collectorFactory.ReconcileCollectorConfig(context.Client, context.Reader, context.Forwarder.Namespace, collectorConfig, ownerRef); err != nil {
 		log.Error(err, "collector.ReconcileCollectorConfig")
 		return
 	}
 
-	reconcileWorkload := collectorFactory.ReconcileDaemonset
-	if !isDaemonSet {
-		reconcileWorkload = collectorFactory.ReconcileDeployment
-	}
+	if collectorFactory.Rollout() != nil {
+		if err := reconcileRollout(context, collectorFactory, collectorConfig, trustedCABundle, ownerRef); err != nil {
+			log.Error(err, "Error reconciling the rollout of the collector")
+			return err
+		}
+	} else {
+		reconcileWorkload := collectorFactory.ReconcileDaemonset
+		if !isDaemonSet {
+			reconcileWorkload = collectorFactory.ReconcileDeployment
+		}
 
-	if err := reconcileWorkload(context.Client, context.Forwarder.Namespace, trustedCABundle, ownerRef); err != nil {
-		log.Error(err, "Error reconciling the deployment of the collector")
-		return err
+		if err := reconcileWorkload(context.Client, context.Forwarder.Namespace, trustedCABundle, ownerRef); err != nil {
+			log.Error(err, "Error reconciling the deployment of the collector")
+			return err
+		}
+		if err := RemoveRollout(context, resourceNames); err != nil {
+			log.Error(err, "RemoveRollout")
+			return err
+		}
 	}
 
 	if err := collectorFactory.ReconcileInputServices(context.Client, context.Reader, context.Forwarder.Namespace, ownerRef, collectorFactory.CommonLabelInitializer); err != nil {
@@ -140,7 +206,12 @@ func ReconcileCollector(context internalcontext.ForwarderContext, pollInterval,
 	}
 	networkPolicyName := fmt.Sprintf("%s-%s", constants.CollectorName, resourceNames.CommonName)
 	// Reconcile NetworkPolicy for the collector daemonset
-	if context.Forwarder.Spec.Collector != nil && context.Forwarder.Spec.Collector.NetworkPolicy != nil {
+	if context.Forwarder.Spec.Collector != nil && context.Forwarder.Spec.Collector.NetworkPolicy != nil && useAggregator {
+		if err := network.ReconcileAgentNetworkPolicy(context.Client, context.Forwarder.Namespace, networkPolicyName, context.Forwarder.Name, constants.CollectorName, context.Forwarder.Spec.Collector.NetworkPolicy.RuleSet, context.Forwarder.Spec.Inputs, ownerRef, collectorFactory.CommonLabelInitializer); err != nil {
+			log.Error(err, "collector.ReconcileAgentNetworkPolicy")
+			return err
+		}
+	} else if context.Forwarder.Spec.Collector != nil && context.Forwarder.Spec.Collector.NetworkPolicy != nil {
 		if err := network.ReconcileClusterLogForwarderNetworkPolicy(context.Client, context.Forwarder.Namespace, networkPolicyName, context.Forwarder.Name, constants.CollectorName, context.Forwarder.Spec.Collector.NetworkPolicy.RuleSet, context.Forwarder.Spec.Outputs, context.Forwarder.Spec.Inputs, ownerRef, collectorFactory.CommonLabelInitializer); err != nil {
 			log.Error(err, "collector.ReconcileNetworkPolicy")
 			return err
@@ -158,20 +229,70 @@ func ReconcileCollector(context internalcontext.ForwarderContext, pollInterval,
 		log.Error(err, "collector.ReconcileService")
 		return err
 	}
-	metricsSelector := metrics.BuildSelector(constants.CollectorName, resourceNames.CommonName)
-	if err := metrics.ReconcileServiceMonitor(context.Client, context.Forwarder.Namespace, resourceNames.CommonName, resourceNames.CommonName, ownerRef, metricsSelector, constants.MetricsPortName, metrics.FullRelabelConfigs, constants.MetricsCollectionProfileFull); err != nil {
+	if err := reconcileServiceMonitors(context.Client, context.Forwarder.Namespace, resourceNames.CommonName, ownerRef); err != nil {
+		return err
+	}
+
+	for _, profile := range profiles {
+		if err := ReconcileNodeProfile(context, profile, useAggregator, trustedCABundle, ownerRef); err != nil {
+			log.Error(err, "ReconcileNodeProfile")
+			return err
+		}
+	}
+	if err := RemoveNodeProfiles(context.Client, context.Forwarder.Namespace, resourceNames, internalobs.NodeProfiles(*context.Forwarder)); err != nil {
+		log.Error(err, "RemoveNodeProfiles")
+		return err
+	}
+
+	if useAggregator {
+		if err := ReconcileAggregator(context, aggregatorConfig, aggregatorFactory, trustedCABundle, ownerRef); err != nil {
+			log.Error(err, "ReconcileAggregator")
+			return err
+		}
+	} else if err := RemoveAggregator(context.Client, context.Forwarder.Namespace, resourceNames); err != nil {
+		log.Error(err, "RemoveAggregator")
+		return err
+	}
+
+	return nil
+}
+
+// initializeAggregator initializes the context and the options of the collector when it forwards to an aggregator tier
+// and returns the options of the aggregator or nil when the aggregator is not used
+func initializeAggregator(context *internalcontext.ForwarderContext, options framework.Options) (aggregatorOptions framework.Options) {
+	if internalobs.UseAggregator(*context.Forwarder) {
+		// the service CA bundle is injected into every namespace and is mounted by both tiers to verify each other
+		if context.ConfigMaps == nil {
+			context.ConfigMaps = map[string]*corev1.ConfigMap{}
+		}
+		context.ConfigMaps[constants.ServiceCABundleConfigMapName] = runtime.NewConfigMap(context.Forwarder.Namespace, constants.ServiceCABundleConfigMapName, nil)
+		aggregatorOptions = framework.Options{}
+		for key, value := range options {
+			aggregatorOptions[key] = value
+		}
+		options[framework.OptionCollectorTier] = framework.CollectorTierAgent
+	}
+
+	options[framework.OptionConfigMaps] = internalobs.ConfigMaps(context.ConfigMaps)
+	return aggregatorOptions
+}
+
+// reconcileServiceMonitors reconciles the ServiceMonitors of each metrics collection profile for the metrics service
+// of the collector
+func reconcileServiceMonitors(k8Client client.Client, namespace, commonName string, ownerRef metav1.OwnerReference) error {
+	metricsSelector := metrics.BuildSelector(constants.CollectorName, commonName)
+	if err := metrics.ReconcileServiceMonitor(k8Client, namespace, commonName, commonName, ownerRef, metricsSelector, constants.MetricsPortName, metrics.FullRelabelConfigs, constants.MetricsCollectionProfileFull); err != nil {
 		log.Error(err, "collector.ReconcileServiceMonitor full")
 		return err
 	}
-	if err := metrics.ReconcileServiceMonitor(context.Client, context.Forwarder.Namespace, constants.MetricsCollectionProfileMinimal+"-"+resourceNames.CommonName, resourceNames.CommonName, ownerRef, metricsSelector, constants.MetricsPortName, metrics.CollectorMinimalRelabelConfigs, constants.MetricsCollectionProfileMinimal); err != nil {
+	if err := metrics.ReconcileServiceMonitor(k8Client, namespace, constants.MetricsCollectionProfileMinimal+"-"+commonName, commonName, ownerRef, metricsSelector, constants.MetricsPortName, metrics.CollectorMinimalRelabelConfigs, constants.MetricsCollectionProfileMinimal); err != nil {
 		log.Error(err, "collector.ReconcileServiceMonitor minimal")
 		return err
 	}
-	if err := metrics.ReconcileServiceMonitor(context.Client, context.Forwarder.Namespace, constants.MetricsCollectionProfileTelemetry+"-"+resourceNames.CommonName, resourceNames.CommonName, ownerRef, metricsSelector, constants.MetricsPortName, metrics.CollectorTelemetryRelabelConfigs, constants.MetricsCollectionProfileTelemetry); err != nil {
+	if err := metrics.ReconcileServiceMonitor(k8Client, namespace, constants.MetricsCollectionProfileTelemetry+"-"+commonName, commonName, ownerRef, metricsSelector, constants.MetricsPortName, metrics.CollectorTelemetryRelabelConfigs, constants.MetricsCollectionProfileTelemetry); err != nil {
 		log.Error(err, "collector.ReconcileServiceMonitor telemetry")
 		return err
 	}
-
 	return nil
 }
 
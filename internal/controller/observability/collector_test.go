@@ -2,6 +2,7 @@ package observability_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -30,6 +32,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	cli "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
 // The intent of these tests is to verify the nuances of the round trip reconciliation process for outcomes such as:
@@ -72,7 +75,6 @@ var _ = Describe("Reconciling the Collector", func() {
 			}
 		})
 		receiverForwarder = obsruntime.NewClusterLogForwarder(namespaceName, clfName, runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
-			clf.Annotations = map[string]string{constants.AnnotationEnableCollectorAsDeployment: ""}
 			clf.Spec = obs.ClusterLogForwarderSpec{
 				Inputs: []obs.InputSpec{
 					{
@@ -219,6 +221,553 @@ var _ = Describe("Reconciling the Collector", func() {
 				}
 			}
 		})
+		It("should deploy an aggregator tier when spec'd and remove it when no longer spec'd", func() {
+			clf := obsruntime.NewClusterLogForwarder(namespaceName, clfName, runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+				clf.Spec = obs.ClusterLogForwarderSpec{
+					Inputs: []obs.InputSpec{
+						{
+							Name:        string(obs.InputTypeApplication),
+							Type:        obs.InputTypeApplication,
+							Application: &obs.Application{},
+						},
+					},
+					Outputs: []obs.OutputSpec{
+						{
+							Name: "my-http",
+							Type: obs.OutputTypeHTTP,
+							HTTP: &obs.HTTP{URLSpec: obs.URLSpec{URL: "https://somewhere"}},
+							TLS: &obs.OutputTLSSpec{
+								TLSSpec: obs.TLSSpec{
+									CA: &obs.ValueReference{Key: constants.TrustedCABundleKey, SecretName: "my-http-secret"},
+								},
+							},
+						},
+					},
+					Pipelines: []obs.PipelineSpec{
+						{
+							Name:       "my-pipeline",
+							InputRefs:  []string{string(obs.InputTypeApplication)},
+							OutputRefs: []string{"my-http"},
+						},
+					},
+					Collector: &obs.CollectorSpec{
+						Aggregator: &obs.CollectorAggregatorSpec{},
+					},
+					ServiceAccount: obs.ServiceAccount{
+						Name: saName,
+					},
+				}
+			})
+			beforeEach()
+			outputSecret := runtime.NewSecret(namespaceName, "my-http-secret", map[string][]byte{constants.TrustedCABundleKey: []byte("ca")})
+			Expect(observability.ReconcileCollector(apicontext.ForwarderContext{
+				Client:     client,
+				Reader:     client,
+				Forwarder:  clf,
+				ClusterID:  clusterID,
+				Secrets:    map[string]*corev1.Secret{outputSecret.Name: outputSecret},
+				ConfigMaps: map[string]*corev1.ConfigMap{},
+			}, 1*time.Millisecond, 1*time.Millisecond)).Should(Succeed())
+			aggregatorNames := resourceNames.AggregatorResourceNames()
+
+			clientSecret := &corev1.Secret{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: resourceNames.AggregatorClientSecret, Namespace: namespaceName}, clientSecret)).Should(Succeed(), "Exp. to create the client certificate of the agent tier")
+			Expect(clientSecret.Data).To(HaveKey(constants.ClientCertKey))
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: resourceNames.AggregatorClientCA, Namespace: namespaceName}, &corev1.ConfigMap{})).Should(Succeed(), "Exp. to create the CA of the agent tier client certificate")
+
+			ds := &appsv1.DaemonSet{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: clfName, Namespace: namespaceName}, ds)).Should(Succeed())
+			Expect(ds.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("Name", "config-openshift-service-cacrt")))
+			Expect(ds.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("Name", resourceNames.AggregatorClientSecret)))
+			Expect(ds.Spec.Template.Spec.Volumes).ToNot(ContainElement(HaveField("Name", outputSecret.Name)), "Exp. the agent tier to not mount the secrets of the outputs")
+			Expect(ds.Spec.Template.Spec.Volumes).ToNot(ContainElement(HaveField("Name", "config-"+resourceNames.AggregatorClientCA)))
+
+			config := &corev1.ConfigMap{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: resourceNames.ConfigMap, Namespace: namespaceName}, config)).Should(Succeed())
+			Expect(config.Data["vector.toml"]).To(ContainSubstring(aggregatorNames.CommonName + "." + namespaceName + ".svc:6000"))
+			Expect(config.Data["vector.toml"]).ToNot(ContainSubstring("output_my_http"))
+
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: aggregatorNames.ConfigMap, Namespace: namespaceName}, config)).Should(Succeed())
+			Expect(config.Data["vector.toml"]).To(ContainSubstring("input_aggregator_route"))
+			Expect(config.Data["vector.toml"]).To(ContainSubstring("output_my_http"))
+
+			key := types.NamespacedName{Name: aggregatorNames.CommonName, Namespace: namespaceName}
+			deployment := &appsv1.Deployment{}
+			Expect(client.Get(context.TODO(), key, deployment)).Should(Succeed(), "Exp. to create the aggregator Deployment")
+			Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("Name", outputSecret.Name)))
+			Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("Name", "config-"+resourceNames.AggregatorClientCA)))
+			service := &corev1.Service{}
+			Expect(client.Get(context.TODO(), key, service)).Should(Succeed(), "Exp. to create the aggregator Service")
+			Expect(service.Annotations[constants.AnnotationServingCertSecretName]).To(Equal(aggregatorNames.SecretMetrics))
+			Expect(client.Get(context.TODO(), key, &monitoringv1.ServiceMonitor{})).Should(Succeed(), "Exp. to create the aggregator ServiceMonitor")
+
+			clf.Spec.Collector = nil
+			reconcileCollector(clf)
+			Expect(client.Get(context.TODO(), key, &appsv1.Deployment{})).ShouldNot(Succeed(), "Exp. to remove the aggregator Deployment")
+			Expect(client.Get(context.TODO(), key, &corev1.Service{})).ShouldNot(Succeed(), "Exp. to remove the aggregator Service")
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: resourceNames.AggregatorClientSecret, Namespace: namespaceName}, &corev1.Secret{})).ShouldNot(Succeed(), "Exp. to remove the client certificate of the agent tier")
+		})
+		It("should preview the changes to the collector without deploying it", func() {
+			clf := obsruntime.NewClusterLogForwarder(namespaceName, clfName, runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+				clf.Spec = obs.ClusterLogForwarderSpec{
+					Inputs: []obs.InputSpec{
+						{
+							Name:        string(obs.InputTypeApplication),
+							Type:        obs.InputTypeApplication,
+							Application: &obs.Application{},
+						},
+					},
+					Outputs: []obs.OutputSpec{
+						{
+							Name: "my-http",
+							Type: obs.OutputTypeHTTP,
+							HTTP: &obs.HTTP{URLSpec: obs.URLSpec{URL: "http://somewhere"}},
+						},
+					},
+					Pipelines: []obs.PipelineSpec{
+						{
+							Name:       "my-pipeline",
+							InputRefs:  []string{string(obs.InputTypeApplication)},
+							OutputRefs: []string{"my-http"},
+						},
+					},
+					ServiceAccount: obs.ServiceAccount{
+						Name: saName,
+					},
+				}
+			})
+			beforeEach()
+			previewCollector := func() []observability.ResourceChange {
+				changes, err := observability.PreviewCollector(apicontext.ForwarderContext{
+					Client:    client,
+					Reader:    client,
+					Forwarder: clf,
+					ClusterID: clusterID,
+					Secrets:   map[string]*corev1.Secret{},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				return changes
+			}
+			operations := func(changes []observability.ResourceChange) map[string]controllerutil.OperationResult {
+				results := map[string]controllerutil.OperationResult{}
+				for _, change := range changes {
+					results[change.Kind+"/"+change.Name] = change.Operation
+				}
+				return results
+			}
+
+			Expect(operations(previewCollector())).To(Equal(map[string]controllerutil.OperationResult{
+				"ConfigMap/" + resourceNames.ConfigMap: controllerutil.OperationResultCreated,
+				"DaemonSet/" + clfName:                 controllerutil.OperationResultCreated,
+				"Service/" + resourceNames.CommonName:  controllerutil.OperationResultCreated,
+			}))
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: clfName, Namespace: namespaceName}, &appsv1.DaemonSet{})).ShouldNot(Succeed(), "Exp. to not deploy the collector")
+			preview := &corev1.ConfigMap{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: resourceNames.Preview, Namespace: namespaceName}, preview)).Should(Succeed())
+			Expect(preview.Data["vector.toml"]).To(ContainSubstring("http://somewhere"))
+			Expect(preview.Data[observability.PreviewChangesKey]).To(ContainSubstring("operation: created"))
+
+			reconcileCollector(clf)
+			for _, operation := range operations(previewCollector()) {
+				Expect(operation).To(Equal(controllerutil.OperationResultNone))
+			}
+
+			clf.Spec.Outputs[0].HTTP.URL = "http://elsewhere"
+			changes := previewCollector()
+			Expect(operations(changes)).To(HaveKeyWithValue("ConfigMap/"+resourceNames.ConfigMap, controllerutil.OperationResultUpdated))
+			Expect(operations(changes)).To(HaveKeyWithValue("DaemonSet/"+clfName, controllerutil.OperationResultUpdated))
+			Expect(changes[0].Diff).To(ContainSubstring("-uri = \"http://somewhere\""))
+			Expect(changes[0].Diff).To(ContainSubstring("+uri = \"http://elsewhere\""))
+
+			Expect(observability.RemovePreview(client, namespaceName, resourceNames)).To(Succeed())
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: resourceNames.Preview, Namespace: namespaceName}, preview)).ShouldNot(Succeed())
+		})
+		It("should validate the generated config with the collector before rolling it out", func() {
+			clf := obsruntime.NewClusterLogForwarder(namespaceName, clfName, runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+				clf.Spec = obs.ClusterLogForwarderSpec{
+					Inputs: []obs.InputSpec{
+						{
+							Name:        string(obs.InputTypeApplication),
+							Type:        obs.InputTypeApplication,
+							Application: &obs.Application{},
+						},
+					},
+					Outputs: []obs.OutputSpec{
+						{
+							Name: "my-http",
+							Type: obs.OutputTypeHTTP,
+							HTTP: &obs.HTTP{URLSpec: obs.URLSpec{URL: "http://somewhere"}},
+						},
+					},
+					Pipelines: []obs.PipelineSpec{
+						{
+							Name:       "my-pipeline",
+							InputRefs:  []string{string(obs.InputTypeApplication)},
+							OutputRefs: []string{"my-http"},
+						},
+					},
+					ServiceAccount: obs.ServiceAccount{
+						Name: saName,
+					},
+				}
+			})
+			beforeEach()
+			validateCollector := func() error {
+				return observability.ReconcileCollector(apicontext.ForwarderContext{
+					Client:         client,
+					Reader:         client,
+					Forwarder:      clf,
+					ClusterID:      clusterID,
+					Secrets:        map[string]*corev1.Secret{},
+					ValidateConfig: true,
+				}, 1*time.Millisecond, 1*time.Millisecond)
+			}
+			jobKey := types.NamespacedName{Name: resourceNames.ConfigValidation, Namespace: namespaceName}
+			completeJob := func(conditionType batchv1.JobConditionType, message string) {
+				job := &batchv1.Job{}
+				Expect(client.Get(context.TODO(), jobKey, job)).Should(Succeed())
+				job.Status.Conditions = append(job.Status.Conditions, batchv1.JobCondition{Type: conditionType, Status: corev1.ConditionTrue})
+				Expect(client.Status().Update(context.TODO(), job)).Should(Succeed())
+				if message != "" {
+					pod := runtime.NewPod(namespaceName, job.Name+"-abcde")
+					pod.Labels = map[string]string{batchv1.ControllerUidLabel: string(job.UID)}
+					pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+						{State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 78, Message: message}}},
+					}
+					Expect(client.Create(context.TODO(), pod)).Should(Succeed())
+				}
+			}
+			config := &corev1.ConfigMap{}
+			configKey := types.NamespacedName{Name: resourceNames.ConfigMap, Namespace: namespaceName}
+
+			Expect(validateCollector()).To(MatchError(observability.ErrConfigValidationPending))
+			Expect(client.Get(context.TODO(), configKey, config)).ShouldNot(Succeed(), "Exp. to not deploy the config before it is validated")
+			Expect(client.Get(context.TODO(), jobKey, &batchv1.Job{})).Should(Succeed())
+			Expect(client.Get(context.TODO(), jobKey, config)).Should(Succeed())
+			Expect(config.Data[resourceNames.ConfigMap+".toml"]).To(ContainSubstring("http://somewhere"))
+
+			completeJob(batchv1.JobComplete, "")
+			Expect(validateCollector()).To(Succeed())
+			Expect(client.Get(context.TODO(), configKey, config)).Should(Succeed())
+			Expect(config.Data["vector.toml"]).To(ContainSubstring("http://somewhere"))
+			Expect(client.Get(context.TODO(), jobKey, &batchv1.Job{})).ShouldNot(Succeed(), "Exp. to remove the job once the config is accepted")
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: clfName, Namespace: namespaceName}, &appsv1.DaemonSet{})).Should(Succeed())
+
+			Expect(validateCollector()).To(Succeed(), "Exp. to not validate the deployed config again")
+			Expect(client.Get(context.TODO(), jobKey, &batchv1.Job{})).ShouldNot(Succeed())
+
+			clf.Spec.Outputs[0].HTTP.URL = "http://elsewhere"
+			Expect(validateCollector()).To(MatchError(observability.ErrConfigValidationPending))
+			completeJob(batchv1.JobFailed, "x Transform \"pipeline_my_pipeline\": invalid VRL")
+			err := validateCollector()
+			var validationErr *observability.ConfigValidationError
+			Expect(errors.As(err, &validationErr)).To(BeTrue())
+			Expect(validationErr.Output).To(Equal("x Transform \"pipeline_my_pipeline\": invalid VRL"))
+			Expect(client.Get(context.TODO(), configKey, config)).Should(Succeed())
+			Expect(config.Data["vector.toml"]).To(ContainSubstring("http://somewhere"), "Exp. to keep the last known good config")
+			Expect(validateCollector()).To(MatchError(validationErr), "Exp. to not validate the rejected config again")
+
+			// the fake client neither assigns the UID of the jobs nor deletes their pods
+			Expect(client.Delete(context.TODO(), runtime.NewPod(namespaceName, jobKey.Name+"-abcde"))).Should(Succeed())
+			clf.Spec.Outputs[0].HTTP.URL = "http://yet-elsewhere"
+			Expect(validateCollector()).To(MatchError(observability.ErrConfigValidationPending))
+			job := &batchv1.Job{}
+			Expect(client.Get(context.TODO(), jobKey, job)).Should(Succeed())
+			job.Status.Conditions = append(job.Status.Conditions, batchv1.JobCondition{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "DeadlineExceeded"})
+			Expect(client.Status().Update(context.TODO(), job)).Should(Succeed())
+			pod := runtime.NewPod(namespaceName, job.Name+"-fghij")
+			pod.Labels = map[string]string{batchv1.ControllerUidLabel: string(job.UID)}
+			pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+				{State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}}},
+			}
+			Expect(client.Create(context.TODO(), pod)).Should(Succeed())
+			err = validateCollector()
+			var unavailableErr *observability.ConfigValidationUnavailableError
+			Expect(errors.As(err, &unavailableErr)).To(BeTrue(), "Exp. a failure of the job to not reject the config")
+			Expect(unavailableErr.Reason).To(ContainSubstring("DeadlineExceeded"))
+			Expect(unavailableErr.Reason).To(ContainSubstring("ImagePullBackOff"))
+			Expect(client.Get(context.TODO(), jobKey, &batchv1.Job{})).ShouldNot(Succeed(), "Exp. to remove the failed job")
+			Expect(validateCollector()).To(MatchError(observability.ErrConfigValidationPending), "Exp. to validate the config again")
+			Expect(client.Get(context.TODO(), jobKey, &batchv1.Job{})).Should(Succeed())
+		})
+		It("should roll out a changed config to the canary nodes and promote or roll it back", func() {
+			clf := obsruntime.NewClusterLogForwarder(namespaceName, clfName, runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+				clf.Spec = obs.ClusterLogForwarderSpec{
+					Collector: &obs.CollectorSpec{
+						Rollout: &obs.CollectorRolloutSpec{
+							CanaryNodeSelector: map[string]string{"canary": "true"},
+							SoakPeriod:         &metav1.Duration{Duration: time.Hour},
+						},
+					},
+					Inputs: []obs.InputSpec{
+						{
+							Name:        string(obs.InputTypeApplication),
+							Type:        obs.InputTypeApplication,
+							Application: &obs.Application{},
+						},
+					},
+					Outputs: []obs.OutputSpec{
+						{
+							Name: "my-http",
+							Type: obs.OutputTypeHTTP,
+							HTTP: &obs.HTTP{URLSpec: obs.URLSpec{URL: "http://somewhere"}},
+						},
+					},
+					Pipelines: []obs.PipelineSpec{
+						{
+							Name:       "my-pipeline",
+							InputRefs:  []string{string(obs.InputTypeApplication)},
+							OutputRefs: []string{"my-http"},
+						},
+					},
+					ServiceAccount: obs.ServiceAccount{
+						Name: saName,
+					},
+				}
+			})
+			beforeEach()
+			for _, name := range []string{"node-a", "node-b", "node-c"} {
+				node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+				if name == "node-a" {
+					node.Labels = map[string]string{"canary": "true"}
+				}
+				Expect(client.Create(context.TODO(), node)).Should(Succeed())
+			}
+			dsKey := types.NamespacedName{Name: clfName, Namespace: namespaceName}
+			canaryKey := types.NamespacedName{Name: resourceNames.CanaryResourceNames().DaemonSetName(), Namespace: namespaceName}
+			daemonSet := func() *appsv1.DaemonSet {
+				ds := &appsv1.DaemonSet{}
+				Expect(client.Get(context.TODO(), dsKey, ds)).Should(Succeed())
+				return ds
+			}
+			canaryDaemonSet := func() *appsv1.DaemonSet {
+				ds := &appsv1.DaemonSet{}
+				if err := client.Get(context.TODO(), canaryKey, ds); err != nil {
+					return nil
+				}
+				return ds
+			}
+			configHash := func(spec corev1.PodSpec) string {
+				for _, env := range spec.Containers[0].Env {
+					if env.Name == "COLLECTOR_CONF_HASH" {
+						return env.Value
+					}
+				}
+				return ""
+			}
+			nodeNames := func(ds *appsv1.DaemonSet) []corev1.NodeSelectorRequirement {
+				return ds.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchFields
+			}
+			// collectorPods returns the pods of the collector and canary DaemonSets by node
+			collectorPods := func(key types.NamespacedName) map[string]corev1.Pod {
+				pods := &corev1.PodList{}
+				Expect(client.List(context.TODO(), pods, cli.InNamespace(namespaceName), cli.MatchingLabels{constants.LabelK8sInstance: key.Name})).Should(Succeed())
+				byNode := map[string]corev1.Pod{}
+				for _, pod := range pods.Items {
+					byNode[pod.Spec.NodeName] = pod
+				}
+				return byNode
+			}
+			// runPod runs a pod of the template of a DaemonSet on a node like the DaemonSet controller
+			runPod := func(ds *appsv1.DaemonSet, node string, restarts int32) {
+				pod := runtime.NewPod(namespaceName, fmt.Sprintf("%s-%s-%s", ds.Name, node, configHash(ds.Spec.Template.Spec)[:5]))
+				pod.Labels = ds.Spec.Template.Labels
+				pod.Spec = ds.Spec.Template.Spec
+				pod.Spec.NodeName = node
+				pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+				pod.Status.ContainerStatuses = []corev1.ContainerStatus{{Name: constants.CollectorName, Ready: true, RestartCount: restarts}}
+				Expect(client.Create(context.TODO(), pod)).Should(Succeed())
+			}
+			// stopPod deletes the pod of an instance on a node like the DaemonSet controller
+			stopPod := func(key types.NamespacedName, node string) {
+				pod := collectorPods(key)[node]
+				Expect(client.Delete(context.TODO(), &pod)).Should(Succeed())
+			}
+			rolloutCondition := func() *metav1.Condition {
+				for i, condition := range clf.Status.Conditions {
+					if condition.Type == obs.ConditionTypeRollout {
+						return &clf.Status.Conditions[i]
+					}
+				}
+				return nil
+			}
+
+			reconcileCollector(clf)
+			stable := configHash(daemonSet().Spec.Template.Spec)
+			Expect(daemonSet().Spec.Template.Spec.Volumes).To(ContainElement(HaveField("ConfigMap.LocalObjectReference.Name", resourceNames.ConfigRevision(stable))))
+			Expect(rolloutCondition().Status).To(Equal(obs.ConditionTrue))
+			for _, node := range []string{"node-a", "node-b", "node-c"} {
+				runPod(daemonSet(), node, 0)
+			}
+
+			By("excluding the canary nodes from the collectors of the stable config")
+			clf.Spec.Outputs[0].HTTP.URL = "http://elsewhere"
+			reconcileCollector(clf)
+			ds := daemonSet()
+			Expect(configHash(ds.Spec.Template.Spec)).To(Equal(stable), "Exp. the template to keep the stable config")
+			Expect(ds.Spec.UpdateStrategy.Type).To(Equal(appsv1.OnDeleteDaemonSetStrategyType))
+			Expect(nodeNames(ds)).To(ConsistOf(corev1.NodeSelectorRequirement{Key: "metadata.name", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"node-a"}}))
+			canary := ds.Annotations[constants.AnnotationRolloutCanaryConfigHash]
+			Expect(canary).ToNot(BeEmpty())
+			Expect(canary).ToNot(Equal(stable))
+			Expect(ds.Annotations).To(HaveKeyWithValue(constants.AnnotationRolloutStableConfigHash, stable))
+			Expect(ds.Annotations).To(HaveKeyWithValue(constants.AnnotationRolloutCanaryNodes, "node-a"))
+			Expect(canaryDaemonSet()).To(BeNil(), "Exp. the canary to wait for the collector of the canary node to stop")
+			Expect(rolloutCondition().Status).To(Equal(obs.ConditionUnknown))
+			Expect(rolloutCondition().Reason).To(Equal(obs.ReasonRolloutCanary))
+
+			By("rolling out the changed config to the canary nodes as a separate DaemonSet")
+			stopPod(dsKey, "node-a")
+			reconcileCollector(clf)
+			canaryDS := canaryDaemonSet()
+			Expect(canaryDS).ToNot(BeNil())
+			Expect(configHash(canaryDS.Spec.Template.Spec)).To(Equal(canary))
+			Expect(nodeNames(canaryDS)).To(ConsistOf(corev1.NodeSelectorRequirement{Key: "metadata.name", Operator: corev1.NodeSelectorOpIn, Values: []string{"node-a"}}))
+			Expect(canaryDS.Spec.Selector.MatchLabels).To(HaveKeyWithValue(constants.LabelK8sInstance, canaryKey.Name))
+			Expect(client.Get(context.TODO(), canaryKey, &corev1.Service{})).Should(Succeed(), "Exp. a service for the metrics of the canary collectors")
+			runPod(canaryDS, "node-a", 0)
+			reconcileCollector(clf)
+			Expect(rolloutCondition().Status).To(Equal(obs.ConditionUnknown), "Exp. the canary to soak")
+			Expect(daemonSet().Annotations).To(HaveKey(constants.AnnotationRolloutCanaryReady))
+
+			By("recreating a collector which is not a canary with the stable config")
+			stopPod(dsKey, "node-b")
+			runPod(daemonSet(), "node-b", 0)
+			Expect(configHash(collectorPods(dsKey)["node-b"].Spec)).To(Equal(stable))
+
+			By("promoting the config once the canary collectors are healthy for the soak period")
+			clf.Spec.Collector.Rollout.SoakPeriod = &metav1.Duration{}
+			reconcileCollector(clf)
+			ds = daemonSet()
+			Expect(configHash(ds.Spec.Template.Spec)).To(Equal(canary))
+			Expect(ds.Spec.UpdateStrategy.Type).To(Equal(appsv1.RollingUpdateDaemonSetStrategyType))
+			Expect(ds.Spec.Template.Spec.Affinity).To(BeNil())
+			Expect(ds.Annotations).ToNot(HaveKey(constants.AnnotationRolloutCanaryConfigHash))
+			Expect(canaryDaemonSet()).To(BeNil(), "Exp. the canary DaemonSet to be removed")
+			Expect(client.Get(context.TODO(), canaryKey, &corev1.Service{})).ShouldNot(Succeed())
+			Expect(rolloutCondition().Status).To(Equal(obs.ConditionTrue))
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: resourceNames.ConfigRevision(stable), Namespace: namespaceName}, &corev1.ConfigMap{})).ShouldNot(Succeed(), "Exp. the previous revision to be removed")
+			stopPod(canaryKey, "node-a")
+			runPod(ds, "node-a", 0)
+
+			By("rolling back a config whose canary collectors restart")
+			clf.Spec.Collector.Rollout.SoakPeriod = &metav1.Duration{Duration: time.Hour}
+			clf.Spec.Outputs[0].HTTP.URL = "http://nowhere"
+			reconcileCollector(clf)
+			stopPod(dsKey, "node-a")
+			reconcileCollector(clf)
+			runPod(canaryDaemonSet(), "node-a", 1)
+			reconcileCollector(clf)
+			ds = daemonSet()
+			Expect(configHash(ds.Spec.Template.Spec)).To(Equal(canary), "Exp. the collectors to keep the stable config")
+			Expect(ds.Spec.Template.Spec.Affinity).To(BeNil(), "Exp. the canary nodes to run the stable config again")
+			Expect(ds.Spec.UpdateStrategy.Type).To(Equal(appsv1.OnDeleteDaemonSetStrategyType), "Exp. to not restart the collectors of the stable config")
+			Expect(canaryDaemonSet()).To(BeNil(), "Exp. the canary DaemonSet to be removed")
+			Expect(rolloutCondition().Status).To(Equal(obs.ConditionFalse))
+			Expect(rolloutCondition().Reason).To(Equal(obs.ReasonRolledBack))
+			Expect(rolloutCondition().Message).To(ContainSubstring("restarted 1 times"))
+			stopPod(canaryKey, "node-a")
+			runPod(ds, "node-a", 0)
+
+			reconcileCollector(clf)
+			Expect(daemonSet().Annotations).ToNot(HaveKey(constants.AnnotationRolloutCanaryConfigHash), "Exp. to not roll out the rejected config again")
+			Expect(canaryDaemonSet()).To(BeNil())
+			Expect(rolloutCondition().Status).To(Equal(obs.ConditionFalse))
+
+			By("retrying the rolled back config once the spec of the forwarder changed")
+			clf.Generation++
+			reconcileCollector(clf)
+			ds = daemonSet()
+			Expect(ds.Annotations).To(HaveKey(constants.AnnotationRolloutCanaryConfigHash))
+			Expect(ds.Annotations).ToNot(HaveKey(constants.AnnotationRolloutRolledBackConfigHash))
+			Expect(rolloutCondition().Status).To(Equal(obs.ConditionUnknown))
+		})
+		It("should deploy a collector for each node profile and remove it when no longer spec'd", func() {
+			clf := obsruntime.NewClusterLogForwarder(namespaceName, clfName, runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+				clf.Spec = obs.ClusterLogForwarderSpec{
+					Collector: &obs.CollectorSpec{
+						NodeProfiles: []obs.CollectorNodeProfile{
+							{
+								Name:         "infra",
+								NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""},
+								Tolerations:  []corev1.Toleration{{Key: "node-role.kubernetes.io/infra", Operator: corev1.TolerationOpExists}},
+								InputRefs:    []string{string(obs.InputTypeInfrastructure)},
+							},
+						},
+					},
+					Inputs: []obs.InputSpec{
+						{
+							Name:        string(obs.InputTypeApplication),
+							Type:        obs.InputTypeApplication,
+							Application: &obs.Application{},
+						},
+						{
+							Name:           string(obs.InputTypeInfrastructure),
+							Type:           obs.InputTypeInfrastructure,
+							Infrastructure: &obs.Infrastructure{Sources: []obs.InfrastructureSource{obs.InfrastructureSourceContainer}},
+						},
+					},
+					Outputs: []obs.OutputSpec{
+						{
+							Name: "app-out",
+							Type: obs.OutputTypeHTTP,
+							HTTP: &obs.HTTP{URLSpec: obs.URLSpec{URL: "http://app.somewhere"}},
+						},
+						{
+							Name: "infra-out",
+							Type: obs.OutputTypeHTTP,
+							HTTP: &obs.HTTP{URLSpec: obs.URLSpec{URL: "http://infra.somewhere"}},
+						},
+					},
+					Pipelines: []obs.PipelineSpec{
+						{
+							Name:       "app",
+							InputRefs:  []string{string(obs.InputTypeApplication)},
+							OutputRefs: []string{"app-out"},
+						},
+						{
+							Name:       "infra",
+							InputRefs:  []string{string(obs.InputTypeInfrastructure)},
+							OutputRefs: []string{"infra-out"},
+						},
+					},
+					ServiceAccount: obs.ServiceAccount{
+						Name: saName,
+					},
+				}
+			})
+			beforeEach()
+			reconcileCollector(clf)
+
+			profileNames := resourceNames.NodeProfileResourceNames("infra")
+			profileKey := types.NamespacedName{Name: profileNames.DaemonSetName(), Namespace: namespaceName}
+			ds := &appsv1.DaemonSet{}
+			Expect(client.Get(context.TODO(), profileKey, ds)).Should(Succeed())
+			Expect(ds.Labels).To(HaveKeyWithValue(constants.LabelNodeProfile, "infra"))
+			Expect(ds.Spec.Template.Spec.NodeSelector).To(HaveKeyWithValue("node-role.kubernetes.io/infra", ""))
+			Expect(ds.Spec.Template.Spec.Tolerations).To(ContainElement(HaveField("Key", "node-role.kubernetes.io/infra")))
+			config := &corev1.ConfigMap{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: profileNames.ConfigMap, Namespace: namespaceName}, config)).Should(Succeed())
+			Expect(config.Data["vector.toml"]).To(ContainSubstring("http://infra.somewhere"))
+			Expect(config.Data["vector.toml"]).ToNot(ContainSubstring("http://app.somewhere"), "Exp. the profile to only collect its inputs")
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: profileNames.CommonName, Namespace: namespaceName}, &corev1.Service{})).Should(Succeed())
+
+			forwarderDS := &appsv1.DaemonSet{}
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: clfName, Namespace: namespaceName}, forwarderDS)).Should(Succeed())
+			Expect(forwarderDS.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(ConsistOf(
+				corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{
+					{Key: "node-role.kubernetes.io/infra", Operator: corev1.NodeSelectorOpNotIn, Values: []string{""}},
+				}},
+			), "Exp. the collector of the forwarder to not run on the nodes of the profile")
+
+			clf.Spec.Collector.NodeProfiles = nil
+			reconcileCollector(clf)
+			Expect(client.Get(context.TODO(), profileKey, &appsv1.DaemonSet{})).ShouldNot(Succeed())
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: profileNames.ConfigMap, Namespace: namespaceName}, &corev1.ConfigMap{})).ShouldNot(Succeed())
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: profileNames.CommonName, Namespace: namespaceName}, &corev1.Service{})).ShouldNot(Succeed())
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: clfName, Namespace: namespaceName}, forwarderDS)).Should(Succeed())
+			Expect(forwarderDS.Spec.Template.Spec.Affinity).To(BeNil())
+		})
 		DescribeTable("should deploy resources to support metrics collection", func(clf *obs.ClusterLogForwarder) {
 			beforeEach()
 			reconcileCollector(clf)
@@ -0,0 +1,203 @@
+package observability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	"github.com/openshift/cluster-logging-operator/internal/collector"
+	"github.com/openshift/cluster-logging-operator/internal/collector/vector"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/reconcile"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"github.com/openshift/cluster-logging-operator/internal/utils/comparators"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ErrConfigValidationPending is returned while the collector validates the generated config
+var ErrConfigValidationPending = errors.New("waiting for the collector to validate the generated config")
+
+// ConfigValidationError is returned when the collector rejects the generated config
+type ConfigValidationError struct {
+	// Output is the output of the failed validation
+	Output string
+}
+
+func (e *ConfigValidationError) Error() string {
+	return fmt.Sprintf("the collector rejected the generated config: %s", e.Output)
+}
+
+// ConfigValidationUnavailableError is returned when the job failed before the collector validated the generated
+// config, e.g. when its pod could not be scheduled, its image could not be pulled or it exceeded its deadline. The
+// config is validated again by a new job
+type ConfigValidationUnavailableError struct {
+	// Reason is the reason the job failed
+	Reason string
+}
+
+func (e *ConfigValidationUnavailableError) Error() string {
+	return fmt.Sprintf("the collector was unable to validate the generated config and will retry: %s", e.Reason)
+}
+
+// ValidateCollectorConfig validates the desired config maps of the collector with a job before they are rolled out.
+// It returns nil when the configs are deployed or accepted by the collector, ErrConfigValidationPending while the job
+// validates them, a ConfigValidationError when the collector rejects them and a ConfigValidationUnavailableError when
+// the job failed before the collector validated them
+func ValidateCollectorConfig(forwarderContext internalcontext.ForwarderContext, collectorFactory *collector.Factory, owner metav1.OwnerReference, desired ...*corev1.ConfigMap) error {
+	namespace := forwarderContext.Forwarder.Namespace
+	configs := map[string]string{}
+	deployed := true
+	for _, configMap := range desired {
+		configs[configMap.Name+".toml"] = configMap.Data[vector.ConfigFile]
+		current := &corev1.ConfigMap{}
+		if err := forwarderContext.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: configMap.Name}, current); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+			deployed = false
+		} else if current.Data[vector.ConfigFile] != configMap.Data[vector.ConfigFile] {
+			deployed = false
+		}
+	}
+	if deployed {
+		return RemoveConfigValidation(forwarderContext.Client, namespace, collectorFactory.ResourceNames)
+	}
+
+	configHash, err := utils.CalculateMD5Hash(joinConfigs(configs))
+	if err != nil {
+		return err
+	}
+
+	job := &batchv1.Job{}
+	key := types.NamespacedName{Namespace: namespace, Name: collectorFactory.ResourceNames.ConfigValidation}
+	if err = forwarderContext.Client.Get(context.TODO(), key, job); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err == nil && job.Annotations[constants.AnnotationConfigHash] == configHash {
+		switch {
+		case jobHasCondition(job, batchv1.JobComplete):
+			log.V(3).Info("The collector accepted the generated config", "namespace", namespace, "job", job.Name)
+			return RemoveConfigValidation(forwarderContext.Client, namespace, collectorFactory.ResourceNames)
+		case jobHasCondition(job, batchv1.JobFailed):
+			output, rejected := configValidationOutput(forwarderContext.Reader, job)
+			if rejected {
+				return &ConfigValidationError{Output: output}
+			}
+			log.V(3).Info("The config validation job failed before the collector validated the generated config", "namespace", namespace, "job", job.Name, "reason", output)
+			if err = deleteConfigValidationJob(forwarderContext.Client, namespace, key.Name); err != nil {
+				return err
+			}
+			return &ConfigValidationUnavailableError{Reason: output}
+		}
+		return ErrConfigValidationPending
+	}
+
+	// the configs changed since the job was created, so validate them with a new job
+	if err = deleteConfigValidationJob(forwarderContext.Client, namespace, key.Name); err != nil {
+		return err
+	}
+	configMap := collectorFactory.NewConfigValidationConfigMap(namespace, configs)
+	utils.AddOwnerRefToObject(configMap, owner)
+	if err = reconcile.Configmap(forwarderContext.Client, forwarderContext.Reader, configMap, comparators.CompareLabels); err != nil {
+		return err
+	}
+	job = collectorFactory.NewConfigValidationJob(namespace, configHash)
+	utils.AddOwnerRefToObject(job, owner)
+	log.V(3).Info("Validating the generated config with the collector", "namespace", namespace, "job", job.Name)
+	if err = forwarderContext.Client.Create(context.TODO(), job); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failure creating job %s/%s: %v", namespace, job.Name, err)
+	}
+	return ErrConfigValidationPending
+}
+
+// RemoveConfigValidation removes the job and configmap which validate the config of the collector
+func RemoveConfigValidation(k8Client client.Client, namespace string, resourceNames *factory.ForwarderResourceNames) error {
+	if err := deleteConfigValidationJob(k8Client, namespace, resourceNames.ConfigValidation); err != nil {
+		return err
+	}
+	configMap := runtime.NewConfigMap(namespace, resourceNames.ConfigValidation, nil)
+	if err := k8Client.Delete(context.TODO(), configMap); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failure deleting configmap %s/%s: %v", namespace, configMap.Name, err)
+	}
+	return nil
+}
+
+// deleteConfigValidationJob deletes the config validation job and its pods
+func deleteConfigValidationJob(k8Client client.Client, namespace, name string) error {
+	job := runtime.NewJob(namespace, name)
+	if err := k8Client.Delete(context.TODO(), job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failure deleting job %s/%s: %v", namespace, name, err)
+	}
+	return nil
+}
+
+// configValidationOutput returns the termination message of the collector when it exited with a failure, which means
+// it rejected the configs, or the reason the job failed before the collector validated them otherwise
+func configValidationOutput(reader client.Reader, job *batchv1.Job) (output string, rejected bool) {
+	pods := &corev1.PodList{}
+	if err := reader.List(context.TODO(), pods, client.InNamespace(job.Namespace), client.MatchingLabels{batchv1.ControllerUidLabel: string(job.UID)}); err != nil {
+		log.V(3).Error(err, "Unable to list the pods of the config validation job", "namespace", job.Namespace, "job", job.Name)
+	}
+	reasons := []string{}
+	for _, pod := range pods.Items {
+		for _, status := range pod.Status.ContainerStatuses {
+			switch {
+			case status.State.Terminated != nil:
+				terminated := status.State.Terminated
+				message := strings.TrimSpace(terminated.Message)
+				if terminated.ExitCode != 0 && terminated.Reason != "OOMKilled" && message != "" {
+					return message, true
+				}
+				reasons = append(reasons, strings.TrimSpace(fmt.Sprintf("container %s: %s", terminated.Reason, message)))
+			case status.State.Waiting != nil:
+				reasons = append(reasons, strings.TrimSpace(fmt.Sprintf("container %s: %s", status.State.Waiting.Reason, status.State.Waiting.Message)))
+			}
+		}
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse {
+				reasons = append(reasons, fmt.Sprintf("pod %s: %s", condition.Reason, condition.Message))
+			}
+		}
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed {
+			reasons = append([]string{strings.TrimSpace(condition.Reason + " " + condition.Message)}, reasons...)
+		}
+	}
+	return strings.Join(reasons, "; "), false
+}
+
+func jobHasCondition(job *batchv1.Job, conditionType batchv1.JobConditionType) bool {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == conditionType && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// joinConfigs joins the configs in the order of their names to hash them
+func joinConfigs(configs map[string]string) string {
+	names := make([]string, 0, len(configs))
+	for name := range configs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	builder := strings.Builder{}
+	for _, name := range names {
+		builder.WriteString(name)
+		builder.WriteString(configs[name])
+	}
+	return builder.String()
+}
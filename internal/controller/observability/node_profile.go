@@ -0,0 +1,158 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/collector"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/network"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/runtime/service"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// nodeProfile is the generated config and the factory of the resources of the collector deployed on the nodes of a
+// node profile
+type nodeProfile struct {
+	config  string
+	factory *collector.Factory
+}
+
+// generateNodeProfiles generates the config of the collector of each node profile from the spec of the forwarder
+// reduced to the inputs of the profile
+func generateNodeProfiles(context internalcontext.ForwarderContext, resourceNames *factory.ForwarderResourceNames, options framework.Options) (profiles []nodeProfile, err error) {
+	for _, profile := range internalobs.NodeProfiles(*context.Forwarder) {
+		forwarder := *context.Forwarder
+		forwarder.Spec = internalobs.NodeProfileSpec(context.Forwarder.Spec, profile)
+		var config string
+		if config, err = GenerateConfig(context.Client, forwarder, *resourceNames.NodeProfileResourceNames(profile.Name), context.Secrets, options); err != nil {
+			log.V(9).Error(err, "nodeProfile.GenerateConfig", "profile", profile.Name)
+			return nil, err
+		}
+		log.V(3).Info("Generated node profile config", "profile", profile.Name, "config", config)
+		var confHash string
+		if confHash, err = utils.CalculateMD5Hash(config); err != nil {
+			log.Error(err, "unable to calculate MD5 hash")
+			return nil, err
+		}
+		secrets, configMaps, profileSpec := internalobs.Secrets(context.Secrets), internalobs.ConfigMaps(context.ConfigMaps), forwarder.Spec
+		if internalobs.UseAggregator(*context.Forwarder) {
+			secrets, configMaps, profileSpec = agentResources(context, resourceNames, profileSpec)
+		}
+		profileFactory := collector.NewNodeProfile(
+			confHash,
+			context.ClusterID,
+			context.Forwarder.Spec.Collector,
+			profile,
+			secrets, configMaps,
+			profileSpec,
+			resourceNames,
+			context.Forwarder.Annotations,
+		)
+		profiles = append(profiles, nodeProfile{config: config, factory: profileFactory})
+	}
+	return profiles, nil
+}
+
+// ReconcileNodeProfile deploys the generated config and the collector DaemonSet on the nodes of a node profile
+func ReconcileNodeProfile(context internalcontext.ForwarderContext, profile nodeProfile, useAggregator bool, trustedCABundle *corev1.ConfigMap, ownerRef metav1.OwnerReference) (err error) {
+	profileFactory := profile.factory
+	profileNames := profileFactory.ResourceNames
+	namespace := context.Forwarder.Namespace
+
+	if err = profileFactory.ReconcileCollectorConfig(context.Client, context.Reader, namespace, profile.config, ownerRef); err != nil {
+		log.Error(err, "nodeProfile.ReconcileCollectorConfig")
+		return err
+	}
+
+	if err = profileFactory.ReconcileDaemonset(context.Client, namespace, trustedCABundle, ownerRef); err != nil {
+		log.Error(err, "nodeProfile.ReconcileDaemonset")
+		return err
+	}
+
+	networkPolicyName := fmt.Sprintf("%s-%s", constants.CollectorName, profileNames.CommonName)
+	profileSpec := profileFactory.ForwarderSpec
+	switch {
+	case context.Forwarder.Spec.Collector.NetworkPolicy != nil && useAggregator:
+		if err = network.ReconcileAgentNetworkPolicy(context.Client, namespace, networkPolicyName, profileNames.ForwarderName, constants.CollectorName, context.Forwarder.Spec.Collector.NetworkPolicy.RuleSet, profileSpec.Inputs, ownerRef, profileFactory.CommonLabelInitializer); err != nil {
+			log.Error(err, "nodeProfile.ReconcileAgentNetworkPolicy")
+			return err
+		}
+	case context.Forwarder.Spec.Collector.NetworkPolicy != nil:
+		if err = network.ReconcileClusterLogForwarderNetworkPolicy(context.Client, namespace, networkPolicyName, profileNames.ForwarderName, constants.CollectorName, context.Forwarder.Spec.Collector.NetworkPolicy.RuleSet, profileSpec.Outputs, profileSpec.Inputs, ownerRef, profileFactory.CommonLabelInitializer); err != nil {
+			log.Error(err, "nodeProfile.ReconcileNetworkPolicy")
+			return err
+		}
+	default:
+		if err = network.RemoveNetworkPolicy(context.Client, namespace, networkPolicyName); err != nil {
+			log.Error(err, "nodeProfile.RemoveNetworkPolicy")
+			return err
+		}
+	}
+
+	if err = network.ReconcileService(context.Client, namespace, profileNames.CommonName, profileNames.ForwarderName, constants.CollectorName, constants.MetricsPortName, profileNames.SecretMetrics, constants.MetricsPort, ownerRef, profileFactory.CommonLabelInitializer); err != nil {
+		log.Error(err, "nodeProfile.ReconcileService")
+		return err
+	}
+	return reconcileServiceMonitors(context.Client, namespace, profileNames.CommonName, ownerRef)
+}
+
+// RemoveNodeProfiles removes the collectors of the node profiles of the forwarder which are not kept
+func RemoveNodeProfiles(k8Client client.Client, namespace string, resourceNames *factory.ForwarderResourceNames, keep []obs.CollectorNodeProfile) error {
+	kept := sets.New[string]()
+	for _, profile := range keep {
+		kept.Insert(profile.Name)
+	}
+	daemonSets := &appsv1.DaemonSetList{}
+	if err := k8Client.List(context.TODO(), daemonSets, client.InNamespace(namespace), client.MatchingLabels{constants.LabelNodeProfileForwarder: resourceNames.ForwarderName}); err != nil {
+		return err
+	}
+	for _, ds := range daemonSets.Items {
+		profile := ds.Labels[constants.LabelNodeProfile]
+		if profile == "" || kept.Has(profile) {
+			continue
+		}
+		log.V(3).Info("Removing the collector of a node profile", "namespace", namespace, "profile", profile)
+		if err := removeNodeProfile(k8Client, namespace, resourceNames.NodeProfileResourceNames(profile)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func removeNodeProfile(k8Client client.Client, namespace string, profileNames *factory.ForwarderResourceNames) error {
+	if err := collector.Remove(k8Client, namespace, profileNames.DaemonSetName()); err != nil {
+		return err
+	}
+	if err := network.RemoveNetworkPolicy(k8Client, namespace, fmt.Sprintf("%s-%s", constants.CollectorName, profileNames.CommonName)); err != nil {
+		return err
+	}
+	if err := service.Delete(k8Client, namespace, profileNames.CommonName); err != nil {
+		return err
+	}
+	objects := []client.Object{
+		runtime.NewConfigMap(namespace, profileNames.ConfigMap, nil),
+		runtime.NewServiceMonitor(namespace, profileNames.CommonName),
+		runtime.NewServiceMonitor(namespace, constants.MetricsCollectionProfileMinimal+"-"+profileNames.CommonName),
+		runtime.NewServiceMonitor(namespace, constants.MetricsCollectionProfileTelemetry+"-"+profileNames.CommonName),
+	}
+	for _, o := range objects {
+		if err := k8Client.Delete(context.TODO(), o); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failure deleting %s/%s: %v", namespace, o.GetName(), err)
+		}
+	}
+	return nil
+}
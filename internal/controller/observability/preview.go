@@ -0,0 +1,267 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/collector"
+	"github.com/openshift/cluster-logging-operator/internal/collector/aws"
+	"github.com/openshift/cluster-logging-operator/internal/collector/vector"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/network"
+	"github.com/openshift/cluster-logging-operator/internal/reconcile"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/tls"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"github.com/openshift/cluster-logging-operator/internal/utils/comparators"
+	"github.com/openshift/cluster-logging-operator/internal/utils/comparators/configmaps"
+	"github.com/openshift/cluster-logging-operator/internal/utils/comparators/objects"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// PreviewAggregatorConfigKey is the key of the preview configmap for the generated config of the aggregator tier
+	PreviewAggregatorConfigKey = "aggregator.toml"
+
+	// PreviewChangesKey is the key of the preview configmap for the changes to the deployed resources
+	PreviewChangesKey = "changes.yaml"
+)
+
+// ResourceChange is the change to a deployed resource of the collector when the forwarder is managed
+type ResourceChange struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+
+	// Operation is one of 'created', 'updated' or 'unchanged'
+	Operation controllerutil.OperationResult `json:"operation"`
+
+	// Diff of the deployed (-) and desired (+) resource
+	Diff string `json:"diff,omitempty"`
+}
+
+// PreviewCollector generates the config and the resources of the collector without deploying them and reconciles the
+// preview configmap with the generated config and the changes to the deployed resources
+func PreviewCollector(context internalcontext.ForwarderContext) (changes []ResourceChange, err error) {
+	ownerRef := utils.AsOwner(context.Forwarder)
+	resourceNames := factory.ResourceNames(*context.Forwarder)
+	namespace := context.Forwarder.Namespace
+
+	options := framework.Options{}
+	if context.AdditionalContext != nil {
+		options = context.AdditionalContext
+	}
+	if context.ClusterRegion != "" {
+		options[framework.OptionClusterRegion] = context.ClusterRegion
+	}
+
+	if internalobs.Outputs(context.Forwarder.Spec.Outputs).NeedServiceAccountToken() || internalobs.Inputs(context.Forwarder.Spec.Inputs).HasEventsSource() {
+		if saTokenSecret := fetchPreviewObject(context.Reader, &corev1.Secret{}, namespace, resourceNames.ServiceAccountTokenSecret); saTokenSecret != nil {
+			context.Secrets[saTokenSecret.Name] = saTokenSecret
+		}
+		options[framework.OptionServiceAccountTokenSecretName] = resourceNames.ServiceAccountTokenSecret
+	}
+	trustedCABundle := fetchPreviewObject(context.Reader, &corev1.ConfigMap{}, namespace, resourceNames.CaTrustBundle)
+	if aws.RequiresProfilesConfigMap(context.Forwarder.Spec.Outputs) {
+		if awsCredsFile := fetchPreviewObject(context.Reader, &corev1.ConfigMap{}, namespace, resourceNames.AwsCredentialsFile); awsCredsFile != nil {
+			context.ConfigMaps[awsCredsFile.Name] = awsCredsFile
+		}
+	}
+
+	aggregatorOptions := initializeAggregator(&context, options)
+
+	var collectorConfig string
+	if collectorConfig, err = GenerateConfig(context.Client, *context.Forwarder, *resourceNames, context.Secrets, options); err != nil {
+		return nil, err
+	}
+	var collectorConfHash string
+	if collectorConfHash, err = utils.CalculateMD5Hash(collectorConfig); err != nil {
+		return nil, err
+	}
+
+	isDaemonSet := !internalobs.DeployAsDeployment(*context.Forwarder)
+	collectorFactory := collector.New(
+		collectorConfHash,
+		context.ClusterID,
+		context.Forwarder.Spec.Collector,
+		context.Secrets, context.ConfigMaps,
+		context.Forwarder.Spec,
+		resourceNames,
+		isDaemonSet,
+		context.Forwarder.Annotations,
+	)
+	tlsProfile, _ := tls.FetchAPIServerTlsProfile(context.Client)
+	tlsProfileSpec := tls.GetClusterTLSProfileSpec(tlsProfile)
+
+	desired := []client.Object{collectorFactory.NewCollectorConfig(namespace, collectorConfig)}
+	if isDaemonSet {
+		desired = append(desired, collectorFactory.NewDaemonSet(namespace, resourceNames.DaemonSetName(), trustedCABundle, tlsProfileSpec))
+	} else {
+		desired = append(desired, collectorFactory.NewDeployment(namespace, resourceNames.DaemonSetName(), trustedCABundle, tlsProfileSpec))
+	}
+	for _, input := range context.Forwarder.Spec.Inputs {
+		if input.Receiver != nil {
+			serviceName := resourceNames.GenerateInputServiceName(input.Name)
+			desired = append(desired, network.NewInputService(namespace, serviceName, resourceNames.CommonName, serviceName, network.InputServicePorts(*input.Receiver), input.Receiver.Type, ownerRef, collectorFactory.CommonLabelInitializer))
+		}
+	}
+	if collectorSpec := context.Forwarder.Spec.Collector; collectorSpec != nil && collectorSpec.NetworkPolicy != nil {
+		networkPolicyName := fmt.Sprintf("%s-%s", constants.CollectorName, resourceNames.CommonName)
+		if aggregatorOptions != nil {
+			desired = append(desired, network.NewAgentNetworkPolicy(namespace, networkPolicyName, context.Forwarder.Name, constants.CollectorName, collectorSpec.NetworkPolicy.RuleSet, context.Forwarder.Spec.Inputs, ownerRef, collectorFactory.CommonLabelInitializer))
+		} else {
+			desired = append(desired, network.NewClusterLogForwarderNetworkPolicy(namespace, networkPolicyName, context.Forwarder.Name, constants.CollectorName, collectorSpec.NetworkPolicy.RuleSet, context.Forwarder.Spec.Outputs, context.Forwarder.Spec.Inputs, ownerRef, collectorFactory.CommonLabelInitializer))
+		}
+	}
+	desired = append(desired, network.NewMetricsService(namespace, resourceNames.CommonName, context.Forwarder.Name, constants.CollectorName, constants.MetricsPortName, resourceNames.SecretMetrics, constants.MetricsPort, ownerRef, collectorFactory.CommonLabelInitializer))
+
+	previewData := map[string]string{
+		vector.ConfigFile: collectorConfig,
+	}
+	if aggregatorOptions != nil {
+		aggregatorConfig, aggregatorFactory, err := generateAggregator(context, resourceNames, aggregatorOptions)
+		if err != nil {
+			return nil, err
+		}
+		previewData[PreviewAggregatorConfigKey] = aggregatorConfig
+		aggregatorNames := aggregatorFactory.ResourceNames
+		desired = append(desired,
+			aggregatorFactory.NewCollectorConfig(namespace, aggregatorConfig),
+			aggregatorFactory.NewDeployment(namespace, aggregatorNames.DaemonSetName(), trustedCABundle, tlsProfileSpec),
+			network.NewAggregatorService(namespace, aggregatorNames.CommonName, aggregatorNames.ForwarderName, constants.CollectorName, aggregatorNames.SecretMetrics, ownerRef, aggregatorFactory.CommonLabelInitializer),
+		)
+	}
+
+	profiles, err := generateNodeProfiles(context, resourceNames, options)
+	if err != nil {
+		return nil, err
+	}
+	for _, profile := range profiles {
+		profileNames := profile.factory.ResourceNames
+		previewData[profileNames.ConfigMap+".toml"] = profile.config
+		desired = append(desired,
+			profile.factory.NewCollectorConfig(namespace, profile.config),
+			profile.factory.NewDaemonSet(namespace, profileNames.DaemonSetName(), trustedCABundle, tlsProfileSpec),
+			network.NewMetricsService(namespace, profileNames.CommonName, profileNames.ForwarderName, constants.CollectorName, constants.MetricsPortName, profileNames.SecretMetrics, constants.MetricsPort, ownerRef, profile.factory.CommonLabelInitializer),
+		)
+	}
+
+	for _, object := range desired {
+		utils.AddOwnerRefToObject(object, ownerRef)
+		var change ResourceChange
+		if change, err = previewChange(context.Reader, object); err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+
+	var changesYAML []byte
+	if changesYAML, err = yaml.Marshal(changes); err != nil {
+		return nil, err
+	}
+	previewData[PreviewChangesKey] = string(changesYAML)
+	preview := runtime.NewConfigMap(namespace, resourceNames.Preview, previewData, collectorFactory.CommonLabelInitializer)
+	utils.AddOwnerRefToObject(preview, ownerRef)
+	if err = reconcile.Configmap(context.Client, context.Reader, preview, comparators.CompareLabels); err != nil {
+		log.V(3).Error(err, "reconcile.Configmap", "name", preview.Name)
+		return nil, err
+	}
+	return changes, nil
+}
+
+// RemovePreview removes the preview configmap when the forwarder is not in a preview state
+func RemovePreview(k8Client client.Client, namespace string, resourceNames *factory.ForwarderResourceNames) error {
+	if err := k8Client.Delete(context.TODO(), runtime.NewConfigMap(namespace, resourceNames.Preview, nil)); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failure deleting %s/%s: %v", namespace, resourceNames.Preview, err)
+	}
+	return nil
+}
+
+// previewChange evaluates the change to the deployed resource when it is reconciled to the desired state. Only the
+// labels and spec are evaluated for resources other than configmaps, which are the fields updated by the reconciler
+func previewChange(reader client.Reader, desired client.Object) (ResourceChange, error) {
+	change := ResourceChange{
+		Name:      desired.GetName(),
+		Operation: controllerutil.OperationResultNone,
+	}
+	var current client.Object
+	switch o := desired.(type) {
+	case *corev1.ConfigMap:
+		change.Kind, current = "ConfigMap", &corev1.ConfigMap{}
+	case *appsv1.DaemonSet:
+		change.Kind, current = "DaemonSet", &appsv1.DaemonSet{}
+	case *appsv1.Deployment:
+		change.Kind, current = "Deployment", &appsv1.Deployment{}
+	case *corev1.Service:
+		change.Kind, current = "Service", &corev1.Service{}
+	case *networkingv1.NetworkPolicy:
+		change.Kind, current = "NetworkPolicy", &networkingv1.NetworkPolicy{}
+	default:
+		return change, fmt.Errorf("unable to preview the change of %T %s", o, desired.GetName())
+	}
+
+	if err := reader.Get(context.TODO(), client.ObjectKeyFromObject(desired), current); err != nil {
+		if errors.IsNotFound(err) {
+			change.Operation = controllerutil.OperationResultCreated
+			return change, nil
+		}
+		return change, fmt.Errorf("failed to get %s %s: %v", change.Kind, desired.GetName(), err)
+	}
+
+	if currentConfigMap, ok := current.(*corev1.ConfigMap); ok {
+		desiredConfigMap := desired.(*corev1.ConfigMap)
+		if !configmaps.AreSame(currentConfigMap, desiredConfigMap, comparators.CompareLabels) {
+			change.Operation = controllerutil.OperationResultUpdated
+			change.Diff = configmaps.Diff(currentConfigMap, desiredConfigMap)
+		}
+		return change, nil
+	}
+	if diff := objects.Diff(newReconciledFields(current), newReconciledFields(desired)); diff != "" {
+		change.Operation = controllerutil.OperationResultUpdated
+		change.Diff = diff
+	}
+	return change, nil
+}
+
+// reconciledFields are the fields of a resource that are updated by the reconciler
+type reconciledFields struct {
+	Labels map[string]string
+	Spec   interface{}
+}
+
+func newReconciledFields(object client.Object) reconciledFields {
+	fields := reconciledFields{Labels: object.GetLabels()}
+	switch o := object.(type) {
+	case *appsv1.DaemonSet:
+		fields.Spec = o.Spec
+	case *appsv1.Deployment:
+		fields.Spec = o.Spec
+	case *corev1.Service:
+		fields.Spec = o.Spec
+	case *networkingv1.NetworkPolicy:
+		fields.Spec = o.Spec
+	}
+	return fields
+}
+
+// fetchPreviewObject returns the object or nil when it does not exist or can not be retrieved
+func fetchPreviewObject[T client.Object](reader client.Reader, object T, namespace, name string) T {
+	var none T
+	if err := reader.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: name}, object); err != nil {
+		if !errors.IsNotFound(err) {
+			log.V(3).Error(err, "Unable to retrieve the object for the preview", "namespace", namespace, "name", name)
+		}
+		return none
+	}
+	return object
+}
@@ -0,0 +1,462 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/collector"
+	"github.com/openshift/cluster-logging-operator/internal/collector/vector"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/network"
+	"github.com/openshift/cluster-logging-operator/internal/reconcile"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/runtime/service"
+	"github.com/openshift/cluster-logging-operator/internal/tls"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"github.com/openshift/cluster-logging-operator/internal/utils/comparators"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// rolloutAnnotations are the annotations of the DaemonSet of the collector which keep the state of a canary rollout
+var rolloutAnnotations = []string{
+	constants.AnnotationRolloutStableConfigHash,
+	constants.AnnotationRolloutCanaryConfigHash,
+	constants.AnnotationRolloutCanaryStarted,
+	constants.AnnotationRolloutCanaryReady,
+	constants.AnnotationRolloutCanaryNodes,
+	constants.AnnotationRolloutRolledBackConfigHash,
+	constants.AnnotationRolloutRolledBackReason,
+	constants.AnnotationRolloutRolledBackGeneration,
+}
+
+// rolloutState is the state of the canary rollout of the config of the collector
+type rolloutState struct {
+	// stable is the hash of the config rolled out to all collectors
+	stable string
+	// canary is the hash of the config rolled out to the canary collectors
+	canary        string
+	canaryStarted time.Time
+	canaryReady   time.Time
+	canaryNodes   []string
+	// rolledBack is the hash of the last config rolled back from the canary collectors
+	rolledBack       string
+	rolledBackReason string
+	// rolledBackGeneration is the generation of the forwarder when the config was rolled back
+	rolledBackGeneration int64
+}
+
+// rolloutStateOf returns the state of the rollout of a DaemonSet whose template runs the stable config
+func rolloutStateOf(ds *appsv1.DaemonSet) rolloutState {
+	state := rolloutState{}
+	if ds == nil {
+		return state
+	}
+	annotations := ds.Annotations
+	state.stable = collector.ConfigHashOf(ds.Spec.Template.Spec)
+	state.rolledBack = annotations[constants.AnnotationRolloutRolledBackConfigHash]
+	state.rolledBackReason = annotations[constants.AnnotationRolloutRolledBackReason]
+	state.rolledBackGeneration, _ = strconv.ParseInt(annotations[constants.AnnotationRolloutRolledBackGeneration], 10, 64)
+	if canary := annotations[constants.AnnotationRolloutCanaryConfigHash]; canary != "" {
+		state.canary = canary
+		state.canaryStarted, _ = time.Parse(time.RFC3339, annotations[constants.AnnotationRolloutCanaryStarted])
+		state.canaryReady, _ = time.Parse(time.RFC3339, annotations[constants.AnnotationRolloutCanaryReady])
+		if nodes := annotations[constants.AnnotationRolloutCanaryNodes]; nodes != "" {
+			state.canaryNodes = strings.Split(nodes, ",")
+		}
+	}
+	return state
+}
+
+func (s rolloutState) annotations() map[string]string {
+	annotations := map[string]string{
+		constants.AnnotationRolloutStableConfigHash:     s.stable,
+		constants.AnnotationRolloutCanaryConfigHash:     s.canary,
+		constants.AnnotationRolloutCanaryNodes:          strings.Join(s.canaryNodes, ","),
+		constants.AnnotationRolloutRolledBackConfigHash: s.rolledBack,
+		constants.AnnotationRolloutRolledBackReason:     s.rolledBackReason,
+	}
+	if !s.canaryStarted.IsZero() {
+		annotations[constants.AnnotationRolloutCanaryStarted] = s.canaryStarted.UTC().Format(time.RFC3339)
+	}
+	if !s.canaryReady.IsZero() {
+		annotations[constants.AnnotationRolloutCanaryReady] = s.canaryReady.UTC().Format(time.RFC3339)
+	}
+	if s.rolledBack != "" {
+		annotations[constants.AnnotationRolloutRolledBackGeneration] = strconv.FormatInt(s.rolledBackGeneration, 10)
+	}
+	for key, value := range annotations {
+		if value == "" {
+			delete(annotations, key)
+		}
+	}
+	return annotations
+}
+
+// reconcileRollout reconciles the DaemonSet of the collector with a canary rollout of changes to its config. The
+// collectors mount revisions of the config which are named after their hash. The template of the DaemonSet always runs
+// the stable config. A changed config is rolled out to the canary nodes by a separate canary DaemonSet once the
+// DaemonSet of the collector no longer schedules its collectors on them. The config is rolled out to the remaining
+// collectors by the rolling update of the DaemonSet once the canary collectors are healthy for the soak period,
+// otherwise the canary is removed and the rolled back config is not rolled out again until the spec of the forwarder
+// changes. The state of the rollout is kept in the annotations of the DaemonSet and its progress is reported by the
+// Rollout condition of the forwarder
+func reconcileRollout(forwarderContext internalcontext.ForwarderContext, collectorFactory *collector.Factory, collectorConfig string, trustedCABundle *corev1.ConfigMap, owner metav1.OwnerReference) error {
+	spec := *collectorFactory.Rollout()
+	namespace := forwarderContext.Forwarder.Namespace
+	generation := forwarderContext.Forwarder.Generation
+	resourceNames := collectorFactory.ResourceNames
+	configHash := collectorFactory.ConfigHash
+
+	revision := collectorFactory.NewConfigRevision(namespace, configHash, collectorFactory.NewCollectorConfig(namespace, collectorConfig).Data)
+	utils.AddOwnerRefToObject(revision, owner)
+	if err := reconcile.Configmap(forwarderContext.Client, forwarderContext.Reader, revision, comparators.CompareLabels); err != nil {
+		return err
+	}
+
+	current := &appsv1.DaemonSet{}
+	if err := forwarderContext.Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: resourceNames.DaemonSetName()}, current); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		current = nil
+	}
+	state := rolloutStateOf(current)
+	if state.rolledBack != "" && state.rolledBackGeneration != generation {
+		log.V(3).Info("Retrying the rolled back config since the spec of the forwarder changed", "namespace", namespace, "configHash", state.rolledBack)
+		state.rolledBack, state.rolledBackReason, state.rolledBackGeneration = "", "", 0
+	}
+	stableExists, err := configRevisionExists(forwarderContext.Client, namespace, resourceNames.ConfigRevision(state.stable))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var condition metav1.Condition
+	var canaryFactory *collector.Factory
+	var canaryPods []corev1.Pod
+	switch {
+	case current == nil || configHash == state.stable:
+		state = rolloutState{stable: configHash}
+		condition = internalobs.NewCondition(obs.ConditionTypeRollout, obs.ConditionTrue, obs.ReasonRolloutComplete,
+			fmt.Sprintf("config %s is rolled out to all collectors", shortHash(configHash)))
+	case !stableExists:
+		log.V(1).Info("Rolling out the config to all collectors without a canary since the revision of the deployed config does not exist",
+			"namespace", namespace, "configRevision", resourceNames.ConfigRevision(state.stable))
+		state = rolloutState{stable: configHash}
+		condition = internalobs.NewCondition(obs.ConditionTypeRollout, obs.ConditionTrue, obs.ReasonRolloutComplete,
+			fmt.Sprintf("config %s is rolled out to all collectors without a canary", shortHash(configHash)))
+	case configHash == state.rolledBack:
+		state.canary, state.canaryStarted, state.canaryReady, state.canaryNodes = "", time.Time{}, time.Time{}, nil
+		condition = rolledBackCondition(state)
+	default:
+		if state.canary != configHash {
+			var canaryNodes sets.Set[string]
+			if canaryNodes, err = selectCanaryNodes(forwarderContext.Reader, spec, namespace, resourceNames.CommonName); err != nil {
+				return err
+			}
+			state.canary = configHash
+			state.canaryStarted = now
+			state.canaryReady = time.Time{}
+			state.canaryNodes = sets.List(canaryNodes)
+		}
+		var stablePods []corev1.Pod
+		if stablePods, err = listCollectorPods(forwarderContext.Reader, namespace, resourceNames.CommonName, state.canaryNodes); err != nil {
+			return err
+		}
+		soakPeriod := collector.SoakPeriod(spec)
+		var ready bool
+		var failure, progress string
+		if len(stablePods) == 0 {
+			canaryFactory = collectorFactory.NewCanary(configHash, state.canaryNodes)
+			if canaryPods, err = listCollectorPods(forwarderContext.Reader, namespace, canaryFactory.ResourceNames.CommonName, state.canaryNodes); err != nil {
+				return err
+			}
+			ready, failure = collector.EvaluateCanary(spec, canaryPods, configHash)
+			progress = "waiting for the canary collectors to be ready"
+		} else {
+			progress = "waiting for the collectors of the stable config on the canary nodes to stop"
+		}
+		switch {
+		case failure != "":
+		case len(state.canaryNodes) == 0:
+			failure = "no node runs a collector to be a canary node"
+		case !ready:
+			state.canaryReady = time.Time{}
+			if now.Sub(state.canaryStarted) > 2*soakPeriod {
+				failure = fmt.Sprintf("the canary collectors are not ready within %s", 2*soakPeriod)
+			}
+		default:
+			if state.canaryReady.IsZero() {
+				state.canaryReady = now
+			}
+			if now.Sub(state.canaryReady) < soakPeriod {
+				progress = fmt.Sprintf("the canary collectors are ready since %s and the config is rolled out to all collectors after %s",
+					state.canaryReady.UTC().Format(time.RFC3339), state.canaryReady.Add(soakPeriod).UTC().Format(time.RFC3339))
+				break
+			}
+			if failure = evaluateComponentErrors(forwarderContext, spec, canaryPods, soakPeriod); failure == "" {
+				log.V(3).Info("The canary collectors are healthy, rolling out the config to all collectors", "namespace", namespace, "configHash", configHash)
+				state = rolloutState{stable: configHash}
+				canaryFactory = nil
+				condition = internalobs.NewCondition(obs.ConditionTypeRollout, obs.ConditionTrue, obs.ReasonRolloutComplete,
+					fmt.Sprintf("config %s passed the canary and is rolled out to all collectors", shortHash(configHash)))
+			}
+		}
+		switch {
+		case failure != "":
+			log.V(1).Info("Rolling back the canary collectors", "namespace", namespace, "configHash", configHash, "failure", failure)
+			state.rolledBack = configHash
+			state.rolledBackReason = failure
+			state.rolledBackGeneration = generation
+			state.canary, state.canaryStarted, state.canaryReady, state.canaryNodes = "", time.Time{}, time.Time{}, nil
+			canaryFactory = nil
+			condition = rolledBackCondition(state)
+		case state.canary != "":
+			condition = internalobs.NewCondition(obs.ConditionTypeRollout, obs.ConditionUnknown, obs.ReasonRolloutCanary,
+				fmt.Sprintf("config %s is rolled out to the collectors on %d canary nodes: %s", shortHash(configHash), len(state.canaryNodes), progress))
+		}
+	}
+
+	// the collectors of the DaemonSet run the stable config and are not scheduled on the canary nodes during the canary.
+	// The DaemonSet is updated on delete during the canary and after a rollback to not restart the collectors which
+	// keep running the stable config when the canary nodes are excluded and included again
+	stableFactory := collectorFactory.WithConfigRevision(state.stable)
+	if state.canary != "" {
+		stableFactory = stableFactory.WithoutNodes(state.canaryNodes)
+	}
+	tlsProfile, _ := tls.FetchAPIServerTlsProfile(forwarderContext.Client)
+	desired := stableFactory.NewDaemonSet(namespace, resourceNames.DaemonSetName(), trustedCABundle, tls.GetClusterTLSProfileSpec(tlsProfile))
+	if state.canary != "" || state.rolledBack != "" {
+		desired.Spec.UpdateStrategy = appsv1.DaemonSetUpdateStrategy{Type: appsv1.OnDeleteDaemonSetStrategyType}
+	}
+	utils.AddOwnerRefToObject(desired, owner)
+	if err = applyRollout(forwarderContext.Client, desired, state); err != nil {
+		return err
+	}
+	internalobs.SetCondition(&forwarderContext.Forwarder.Status.Conditions, condition)
+
+	if canaryFactory != nil {
+		if err = reconcileCanary(forwarderContext, canaryFactory, trustedCABundle, owner); err != nil {
+			return err
+		}
+	} else if err = removeCanary(forwarderContext.Client, namespace, resourceNames.CanaryResourceNames()); err != nil {
+		return err
+	}
+
+	return removeConfigRevisions(forwarderContext.Client, namespace, resourceNames.ForwarderName, sets.New(state.stable, state.canary))
+}
+
+// reconcileCanary deploys the canary collectors and the service and monitors of their metrics
+func reconcileCanary(forwarderContext internalcontext.ForwarderContext, canaryFactory *collector.Factory, trustedCABundle *corev1.ConfigMap, owner metav1.OwnerReference) error {
+	namespace := forwarderContext.Forwarder.Namespace
+	canaryNames := canaryFactory.ResourceNames
+	if err := canaryFactory.ReconcileDaemonset(forwarderContext.Client, namespace, trustedCABundle, owner); err != nil {
+		return err
+	}
+	if err := network.ReconcileService(forwarderContext.Client, namespace, canaryNames.CommonName, canaryNames.CommonName, constants.CollectorName, constants.MetricsPortName, canaryNames.SecretMetrics, constants.MetricsPort, owner, canaryFactory.CommonLabelInitializer); err != nil {
+		return err
+	}
+	return reconcileServiceMonitors(forwarderContext.Client, namespace, canaryNames.CommonName, owner)
+}
+
+// removeCanary removes the canary collectors and the service and monitors of their metrics
+func removeCanary(k8Client client.Client, namespace string, canaryNames *factory.ForwarderResourceNames) error {
+	if err := collector.Remove(k8Client, namespace, canaryNames.DaemonSetName()); err != nil {
+		return err
+	}
+	if err := service.Delete(k8Client, namespace, canaryNames.CommonName); err != nil {
+		return err
+	}
+	objects := []client.Object{
+		runtime.NewServiceMonitor(namespace, canaryNames.CommonName),
+		runtime.NewServiceMonitor(namespace, constants.MetricsCollectionProfileMinimal+"-"+canaryNames.CommonName),
+		runtime.NewServiceMonitor(namespace, constants.MetricsCollectionProfileTelemetry+"-"+canaryNames.CommonName),
+	}
+	for _, o := range objects {
+		if err := k8Client.Delete(context.TODO(), o); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failure deleting %s/%s: %v", namespace, o.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// snapshotConfigRevision keeps the deployed collector config as a revision before it is updated to be able to roll
+// back to it
+func snapshotConfigRevision(forwarderContext internalcontext.ForwarderContext, collectorFactory *collector.Factory, owner metav1.OwnerReference) error {
+	deployed := &corev1.ConfigMap{}
+	if err := forwarderContext.Client.Get(context.TODO(), types.NamespacedName{Namespace: forwarderContext.Forwarder.Namespace, Name: collectorFactory.ResourceNames.ConfigMap}, deployed); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	configHash, err := utils.CalculateMD5Hash(deployed.Data[vector.ConfigFile])
+	if err != nil {
+		return err
+	}
+	revision := collectorFactory.NewConfigRevision(forwarderContext.Forwarder.Namespace, configHash, deployed.Data)
+	utils.AddOwnerRefToObject(revision, owner)
+	return reconcile.Configmap(forwarderContext.Client, forwarderContext.Reader, revision, comparators.CompareLabels)
+}
+
+// RemoveRollout removes the Rollout condition, the canary collectors and the revisions of the collector config when
+// the collector is deployed without a canary rollout
+func RemoveRollout(forwarderContext internalcontext.ForwarderContext, resourceNames *factory.ForwarderResourceNames) error {
+	internalobs.RemoveConditionByType(&forwarderContext.Forwarder.Status.Conditions, obs.ConditionTypeRollout)
+	if err := removeCanary(forwarderContext.Client, forwarderContext.Forwarder.Namespace, resourceNames.CanaryResourceNames()); err != nil {
+		return err
+	}
+	return removeConfigRevisions(forwarderContext.Client, forwarderContext.Forwarder.Namespace, resourceNames.ForwarderName, sets.New[string]())
+}
+
+// applyRollout creates or updates the DaemonSet of the collector with the state of the rollout
+func applyRollout(k8Client client.Client, desired *appsv1.DaemonSet, state rolloutState) error {
+	ds := runtime.NewDaemonSet(desired.Namespace, desired.Name)
+	op, err := controllerutil.CreateOrUpdate(context.TODO(), k8Client, ds, func() error {
+		ds.Labels = desired.Labels
+		ds.Spec = desired.Spec
+		ds.OwnerReferences = desired.OwnerReferences
+		if ds.Annotations == nil {
+			ds.Annotations = map[string]string{}
+		}
+		for _, key := range rolloutAnnotations {
+			delete(ds.Annotations, key)
+		}
+		for key, value := range state.annotations() {
+			ds.Annotations[key] = value
+		}
+		return nil
+	})
+	if err == nil {
+		log.V(3).Info(fmt.Sprintf("reconciled daemonset - operation: %s", op))
+	}
+	return err
+}
+
+// selectCanaryNodes returns the canary nodes among the nodes which run a collector of the DaemonSet
+func selectCanaryNodes(reader client.Reader, spec obs.CollectorRolloutSpec, namespace, instanceName string) (sets.Set[string], error) {
+	pods, err := listCollectorPods(reader, namespace, instanceName, nil)
+	if err != nil {
+		return nil, err
+	}
+	collectorNodes := sets.New[string]()
+	for _, pod := range pods {
+		if pod.Spec.NodeName != "" {
+			collectorNodes.Insert(pod.Spec.NodeName)
+		}
+	}
+
+	if len(spec.CanaryNodeSelector) == 0 {
+		return collector.CanaryNodes(spec, collectorNodes.UnsortedList()), nil
+	}
+	nodes := &corev1.NodeList{}
+	if err := reader.List(context.TODO(), nodes, client.MatchingLabels(spec.CanaryNodeSelector)); err != nil {
+		return nil, err
+	}
+	canaryNodes := sets.New[string]()
+	for _, node := range nodes.Items {
+		if collectorNodes.Has(node.Name) {
+			canaryNodes.Insert(node.Name)
+		}
+	}
+	return canaryNodes, nil
+}
+
+// listCollectorPods returns the collector pods of an instance, only those on the nodes when nodes are given
+func listCollectorPods(reader client.Reader, namespace, instanceName string, nodes []string) ([]corev1.Pod, error) {
+	pods := &corev1.PodList{}
+	selector := runtime.Selectors(instanceName, constants.CollectorName, constants.VectorName)
+	if err := reader.List(context.TODO(), pods, client.InNamespace(namespace), client.MatchingLabels(selector)); err != nil {
+		return nil, err
+	}
+	if nodes == nil {
+		return pods.Items, nil
+	}
+	onNodes := sets.New(nodes...)
+	var result []corev1.Pod
+	for _, pod := range pods.Items {
+		if onNodes.Has(pod.Spec.NodeName) {
+			result = append(result, pod)
+		}
+	}
+	return result, nil
+}
+
+// evaluateComponentErrors returns a failure when the canary collectors reported more component errors during the
+// soak period than tolerated. The errors are not evaluated when the metrics of the collectors can not be queried
+func evaluateComponentErrors(forwarderContext internalcontext.ForwarderContext, spec obs.CollectorRolloutSpec, pods []corev1.Pod, soakPeriod time.Duration) string {
+	if spec.MaxComponentErrors == nil || forwarderContext.Metrics == nil {
+		return ""
+	}
+	names := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		names = append(names, regexp.QuoteMeta(pod.Name))
+	}
+	namespace := forwarderContext.Forwarder.Namespace
+	query := fmt.Sprintf(`sum(increase(vector_component_errors_total{namespace=%q,pod=~%q}[%ds]))`, namespace, strings.Join(names, "|"), int64(soakPeriod.Seconds()))
+	errors, err := forwarderContext.Metrics.Query(namespace, query)
+	if err != nil {
+		log.V(1).Error(err, "Unable to query the component errors of the canary collectors", "namespace", namespace)
+		return ""
+	}
+	if errors > float64(*spec.MaxComponentErrors) {
+		return fmt.Sprintf("the canary collectors reported %.0f component errors during the soak period", errors)
+	}
+	return ""
+}
+
+// removeConfigRevisions removes the revisions of the collector config of an instance which are not kept
+func removeConfigRevisions(k8Client client.Client, namespace, instanceName string, keep sets.Set[string]) error {
+	revisions := &corev1.ConfigMapList{}
+	if err := k8Client.List(context.TODO(), revisions, client.InNamespace(namespace), client.MatchingLabels{constants.LabelK8sInstance: instanceName}, client.HasLabels{constants.LabelConfigRevision}); err != nil {
+		return err
+	}
+	for i, revision := range revisions.Items {
+		if keep.Has(revision.Labels[constants.LabelConfigRevision]) {
+			continue
+		}
+		if err := k8Client.Delete(context.TODO(), &revisions.Items[i]); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failure deleting configmap %s/%s: %v", namespace, revision.Name, err)
+		}
+	}
+	return nil
+}
+
+func configRevisionExists(k8Client client.Client, namespace, name string) (bool, error) {
+	if err := k8Client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, &corev1.ConfigMap{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func rolledBackCondition(state rolloutState) metav1.Condition {
+	return internalobs.NewCondition(obs.ConditionTypeRollout, obs.ConditionFalse, obs.ReasonRolledBack,
+		fmt.Sprintf("config %s was rolled back to %s: %s", shortHash(state.rolledBack), shortHash(state.stable), state.rolledBackReason))
+}
+
+// shortHash abbreviates a config hash like the names of the revisions of the config
+func shortHash(configHash string) string {
+	if len(configHash) > 10 {
+		return configHash[:10]
+	}
+	return configHash
+}
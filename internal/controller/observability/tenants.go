@@ -0,0 +1,231 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	internalinit "github.com/openshift/cluster-logging-operator/internal/api/initialize"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	validations "github.com/openshift/cluster-logging-operator/internal/validations/observability"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// tenant is the LogForwarder of a tenant served by a forwarder
+type tenant struct {
+	forwarder *obs.LogForwarder
+
+	// secrets are the copies of the secrets of the tenant in the namespace of the forwarder
+	secrets []*corev1.Secret
+
+	// failures are the failed validations of the LogForwarder which is not added to the forwarder
+	failures []metav1.Condition
+}
+
+// tenancy is the LogForwarders served by a forwarder and the LogForwarders not served by any forwarder
+type tenancy struct {
+	tenants   []tenant
+	notServed []*obs.LogForwarder
+}
+
+// mergeTenants adds the valid LogForwarders of the namespaces served by the forwarder and the copies of the secrets they
+// reference to the context
+func mergeTenants(cxt internalcontext.ForwarderContext) (result tenancy, err error) {
+	logForwarders := &obs.LogForwarderList{}
+	if err = cxt.Client.List(context.TODO(), logForwarders); err != nil || len(logForwarders.Items) == 0 {
+		return result, err
+	}
+	forwarders := &obs.ClusterLogForwarderList{}
+	if err = cxt.Client.List(context.TODO(), forwarders); err != nil {
+		return result, err
+	}
+	sort.Slice(logForwarders.Items, func(i, j int) bool {
+		return namespacedName(&logForwarders.Items[i]) < namespacedName(&logForwarders.Items[j])
+	})
+	hosts := map[string]*obs.ClusterLogForwarder{}
+	for i := range logForwarders.Items {
+		logForwarder := &logForwarders.Items[i]
+		host, found := hosts[logForwarder.Namespace]
+		if !found {
+			namespace := &corev1.Namespace{}
+			if err = cxt.Client.Get(context.TODO(), types.NamespacedName{Name: logForwarder.Namespace}, namespace); err != nil {
+				return result, err
+			}
+			host = servingForwarder(*namespace, forwarders.Items)
+			hosts[logForwarder.Namespace] = host
+		}
+		switch {
+		case host == nil:
+			result.notServed = append(result.notServed, logForwarder)
+		case namespacedName(host) == namespacedName(cxt.Forwarder):
+			var t tenant
+			if t, err = mergeTenant(cxt, logForwarder); err != nil {
+				return result, err
+			}
+			result.tenants = append(result.tenants, t)
+		}
+	}
+	return result, nil
+}
+
+// mergeTenant validates the LogForwarder of a tenant with the secrets of its namespace and adds it to the spec of the
+// forwarder when it is valid
+func mergeTenant(cxt internalcontext.ForwarderContext, logForwarder *obs.LogForwarder) (t tenant, err error) {
+	t.forwarder = logForwarder
+	var secrets []*corev1.Secret
+	if secrets, err = FetchSecrets(cxt.Client, logForwarder.Namespace, internalobs.Outputs(logForwarder.Spec.Outputs).SecretNames()...); err != nil {
+		return t, err
+	}
+	secretMap := map[string]*corev1.Secret{}
+	for _, secret := range secrets {
+		secretMap[secret.Name] = secret
+	}
+	if t.failures = validations.ValidateLogForwarder(*logForwarder, secretMap); len(t.failures) > 0 {
+		log.V(3).Info("LogForwarder failed validation", "namespace", logForwarder.Namespace, "name", logForwarder.Name)
+		return t, nil
+	}
+
+	spec := obs.ClusterLogForwarder{
+		Spec: internalobs.TenantSpec(cxt.Forwarder.Name, *logForwarder),
+	}
+	spec = internalinit.ClusterLogForwarder(spec, utils.Options{})
+	tenantInputs, _ := utils.GetOption(cxt.AdditionalContext, framework.OptionTenantInputs, []string{})
+	cxt.AdditionalContext[framework.OptionTenantInputs] = append(tenantInputs, internalobs.Inputs(spec.Spec.Inputs).Names()...)
+	cxt.Forwarder.Spec.Inputs = append(cxt.Forwarder.Spec.Inputs, spec.Spec.Inputs...)
+	cxt.Forwarder.Spec.Outputs = append(cxt.Forwarder.Spec.Outputs, spec.Spec.Outputs...)
+	cxt.Forwarder.Spec.Filters = append(cxt.Forwarder.Spec.Filters, spec.Spec.Filters...)
+	cxt.Forwarder.Spec.Pipelines = append(cxt.Forwarder.Spec.Pipelines, spec.Spec.Pipelines...)
+	for _, secret := range secrets {
+		name := internalobs.TenantSecretName(cxt.Forwarder.Name, *logForwarder, secret.Name)
+		copied := runtime.NewSecret(cxt.Forwarder.Namespace, name, secret.Data)
+		cxt.Secrets[name] = copied
+		t.secrets = append(t.secrets, copied)
+	}
+	return t, nil
+}
+
+// servingForwarder returns the oldest forwarder with a tenancy selecting the namespace. The LogForwarders of the
+// namespaces of the infrastructure are not served
+func servingForwarder(namespace corev1.Namespace, forwarders []obs.ClusterLogForwarder) (host *obs.ClusterLogForwarder) {
+	if validations.IsInfrastructureNamespace(namespace.Name) {
+		return nil
+	}
+	for i := range forwarders {
+		forwarder := &forwarders[i]
+		if forwarder.Spec.Tenancy == nil || forwarder.DeletionTimestamp != nil {
+			continue
+		}
+		selector := labels.Everything()
+		if forwarder.Spec.Tenancy.NamespaceSelector != nil {
+			var err error
+			if selector, err = metav1.LabelSelectorAsSelector(forwarder.Spec.Tenancy.NamespaceSelector); err != nil {
+				log.V(3).Error(err, "invalid tenancy namespace selector", "namespace", forwarder.Namespace, "name", forwarder.Name)
+				continue
+			}
+		}
+		if !selector.Matches(labels.Set(namespace.Labels)) {
+			continue
+		}
+		if host == nil || forwarder.CreationTimestamp.Before(&host.CreationTimestamp) ||
+			(forwarder.CreationTimestamp.Equal(&host.CreationTimestamp) && namespacedName(forwarder) < namespacedName(host)) {
+			host = forwarder
+		}
+	}
+	return host
+}
+
+// reconcileTenants copies the secrets of the tenants to the namespace of the forwarder, removes the copies which are
+// no longer referenced and updates the status of the LogForwarders
+func reconcileTenants(cxt internalcontext.ForwarderContext, result tenancy, ownerRef metav1.OwnerReference) error {
+	keep := sets.New[string]()
+	for _, t := range result.tenants {
+		for _, secret := range t.secrets {
+			if err := reconcileTenantSecret(cxt.Client, cxt.Forwarder.Name, secret, ownerRef); err != nil {
+				return err
+			}
+			keep.Insert(secret.Name)
+		}
+	}
+	if err := removeTenantSecrets(cxt.Client, cxt.Forwarder.Namespace, cxt.Forwarder.Name, keep); err != nil {
+		return err
+	}
+
+	for _, t := range result.tenants {
+		condition := internalobs.NewCondition(obs.ConditionTypeReady, obs.ConditionTrue, obs.ReasonReconciliationComplete,
+			fmt.Sprintf("forwarded by ClusterLogForwarder %s", namespacedName(cxt.Forwarder)))
+		if len(t.failures) > 0 {
+			var messages []string
+			for _, failure := range t.failures {
+				messages = append(messages, failure.Message)
+			}
+			condition.Status = obs.ConditionFalse
+			condition.Reason = obs.ReasonValidationFailure
+			condition.Message = strings.Join(messages, ", ")
+		}
+		updateLogForwarderStatus(cxt.Client, t.forwarder, condition)
+	}
+	for _, logForwarder := range result.notServed {
+		updateLogForwarderStatus(cxt.Client, logForwarder, internalobs.NewCondition(obs.ConditionTypeReady, obs.ConditionFalse, obs.ReasonNotServed,
+			fmt.Sprintf("no ClusterLogForwarder serves the LogForwarders of namespace %q", logForwarder.Namespace)))
+	}
+	return nil
+}
+
+func reconcileTenantSecret(k8Client client.Client, forwarderName string, desired *corev1.Secret, ownerRef metav1.OwnerReference) error {
+	secret := runtime.NewSecret(desired.Namespace, desired.Name, nil)
+	_, err := controllerutil.CreateOrUpdate(context.TODO(), k8Client, secret, func() error {
+		if secret.Labels == nil {
+			secret.Labels = map[string]string{}
+		}
+		secret.Labels[constants.LabelTenantForwarder] = forwarderName
+		secret.Data = desired.Data
+		secret.OwnerReferences = []metav1.OwnerReference{ownerRef}
+		return nil
+	})
+	return err
+}
+
+// removeTenantSecrets removes the copies of the secrets of the tenants of the forwarder which are not kept
+func removeTenantSecrets(k8Client client.Client, namespace, forwarderName string, keep sets.Set[string]) error {
+	secrets := &corev1.SecretList{}
+	if err := k8Client.List(context.TODO(), secrets, client.InNamespace(namespace), client.MatchingLabels{constants.LabelTenantForwarder: forwarderName}); err != nil {
+		return err
+	}
+	for i := range secrets.Items {
+		if keep.Has(secrets.Items[i].Name) {
+			continue
+		}
+		if err := k8Client.Delete(context.TODO(), &secrets.Items[i]); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func updateLogForwarderStatus(k8Client client.Client, logForwarder *obs.LogForwarder, ready metav1.Condition) {
+	if !internalobs.SetCondition(&logForwarder.Status.Conditions, ready) {
+		return
+	}
+	if err := k8Client.Status().Update(context.TODO(), logForwarder); err != nil {
+		log.Error(err, "Error updating status", "namespace", logForwarder.Namespace, "name", logForwarder.Name)
+	}
+}
+
+func namespacedName(o client.Object) string {
+	return o.GetNamespace() + "/" + o.GetName()
+}
@@ -0,0 +1,175 @@
+package observability
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	obscontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("Tenants", func() {
+	var (
+		now       = time.Now()
+		namespace = func(name string, labels map[string]string) *corev1.Namespace {
+			return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+		}
+		forwarder = func(name string, created time.Time, tenancy *obs.TenancySpec) obs.ClusterLogForwarder {
+			return obs.ClusterLogForwarder{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-logging", Name: name, CreationTimestamp: metav1.NewTime(created)},
+				Spec:       obs.ClusterLogForwarderSpec{Tenancy: tenancy},
+			}
+		}
+		logForwarder = func(namespace, secretName string) *obs.LogForwarder {
+			return &obs.LogForwarder{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "logs"},
+				Spec: obs.LogForwarderSpec{
+					Outputs: []obs.OutputSpec{
+						{
+							Name: "my-splunk",
+							Type: obs.OutputTypeSplunk,
+							Splunk: &obs.Splunk{
+								URLSpec:        obs.URLSpec{URL: "https://splunk.example.com"},
+								Authentication: &obs.SplunkAuthentication{Token: &obs.SecretReference{SecretName: secretName, Key: "token"}},
+							},
+						},
+					},
+					Pipelines: []obs.LogForwarderPipelineSpec{{Name: "to-splunk", OutputRefs: []string{"my-splunk"}}},
+				},
+			}
+		}
+	)
+
+	Context("#servingForwarder", func() {
+		It("should serve the namespace by the oldest forwarder with a tenancy selecting it", func() {
+			teamSelector := &obs.TenancySpec{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "true"}}}
+			forwarders := []obs.ClusterLogForwarder{
+				forwarder("oldest-without-tenancy", now.Add(-3*time.Hour), nil),
+				forwarder("newer", now.Add(-time.Hour), &obs.TenancySpec{}),
+				forwarder("older", now.Add(-2*time.Hour), teamSelector),
+			}
+			Expect(servingForwarder(*namespace("team-a", map[string]string{"team": "true"}), forwarders).Name).To(Equal("older"))
+			Expect(servingForwarder(*namespace("other", nil), forwarders).Name).To(Equal("newer"))
+			Expect(servingForwarder(*namespace("openshift-monitoring", map[string]string{"team": "true"}), forwarders)).To(BeNil())
+			Expect(servingForwarder(*namespace("other", nil), forwarders[2:])).To(BeNil())
+		})
+	})
+
+	Context("#mergeTenants and #reconcileTenants", func() {
+		var (
+			k8sClient client.Client
+			host      obs.ClusterLogForwarder
+			cxt       obscontext.ForwarderContext
+			served    = logForwarder("team-a", "hec")
+			invalid   = logForwarder("team-b", "missing")
+			notServed = logForwarder("other", "hec")
+		)
+		BeforeEach(func() {
+			host = forwarder("collector", now, &obs.TenancySpec{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "true"}},
+				RateLimit:         &obs.LimitSpec{MaxRecordsPerSecond: 100},
+			})
+			k8sClient = fake.NewClientBuilder().
+				WithStatusSubresource(&obs.LogForwarder{}).
+				WithObjects(
+					&host,
+					namespace("team-a", map[string]string{"team": "true"}),
+					namespace("team-b", map[string]string{"team": "true"}),
+					namespace("other", nil),
+					runtime.NewSecret("team-a", "hec", map[string][]byte{"token": []byte("abc")}),
+					runtime.NewSecret("other", "hec", map[string][]byte{"token": []byte("xyz")}),
+					served.DeepCopy(), invalid.DeepCopy(), notServed.DeepCopy(),
+				).Build()
+			cxt = obscontext.ForwarderContext{
+				Client:            k8sClient,
+				Reader:            k8sClient,
+				Forwarder:         host.DeepCopy(),
+				Secrets:           map[string]*corev1.Secret{},
+				AdditionalContext: utils.Options{},
+			}
+		})
+
+		fetchStatus := func(namespace string) []metav1.Condition {
+			lf := &obs.LogForwarder{}
+			Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: "logs"}, lf)).To(Succeed())
+			return lf.Status.Conditions
+		}
+
+		It("should forward the valid LogForwarders of the namespaces it serves with the copies of their secrets", func() {
+			tenants, err := mergeTenants(cxt)
+			Expect(err).To(BeNil())
+			Expect(tenants.tenants).To(HaveLen(2))
+			Expect(tenants.notServed).To(HaveLen(1))
+
+			name := internalobs.TenantName(*served)
+			secretName := internalobs.TenantSecretName("collector", *served, "hec")
+			Expect(internalobs.Outputs(cxt.Forwarder.Spec.Outputs).Names()).To(Equal([]string{name + "-my-splunk"}))
+			Expect(cxt.Forwarder.Spec.Outputs[0].Limit).To(BeNil())
+			Expect(cxt.Forwarder.Spec.Inputs[0].Application.Tuning).To(BeNil())
+			Expect(cxt.AdditionalContext).To(HaveKeyWithValue(framework.OptionTenantInputs, []string{name}))
+			Expect(cxt.Forwarder.Spec.Inputs[0].Application.Includes).To(Equal([]obs.NamespaceContainerSpec{{Namespace: "team-a"}}))
+			Expect(cxt.Secrets).To(HaveKey(secretName))
+
+			Expect(reconcileTenants(cxt, tenants, utils.AsOwner(cxt.Forwarder))).To(Succeed())
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: host.Namespace, Name: secretName}, secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKeyWithValue("token", []byte("abc")))
+			Expect(secret.Labels).To(HaveKeyWithValue(constants.LabelTenantForwarder, "collector"))
+
+			Expect(fetchStatus("team-a")).To(HaveCondition(obs.ConditionTypeReady, true, obs.ReasonReconciliationComplete, "forwarded by ClusterLogForwarder openshift-logging/collector"))
+			Expect(fetchStatus("team-b")).To(HaveCondition(obs.ConditionTypeReady, false, obs.ReasonValidationFailure, `secret\[missing\] not found`))
+			Expect(fetchStatus("other")).To(HaveCondition(obs.ConditionTypeReady, false, obs.ReasonNotServed, ""))
+		})
+
+		It("should remove the copies of the secrets of the LogForwarders which are no longer served", func() {
+			tenants, err := mergeTenants(cxt)
+			Expect(err).To(BeNil())
+			Expect(reconcileTenants(cxt, tenants, utils.AsOwner(cxt.Forwarder))).To(Succeed())
+
+			Expect(k8sClient.Delete(context.TODO(), served.DeepCopy())).To(Succeed())
+			cxt.Forwarder = host.DeepCopy()
+			tenants, err = mergeTenants(cxt)
+			Expect(err).To(BeNil())
+			Expect(cxt.Forwarder.Spec.Outputs).To(BeEmpty())
+			Expect(reconcileTenants(cxt, tenants, utils.AsOwner(cxt.Forwarder))).To(Succeed())
+			secrets := &corev1.SecretList{}
+			Expect(k8sClient.List(context.TODO(), secrets, client.InNamespace(host.Namespace))).To(Succeed())
+			Expect(secrets.Items).To(BeEmpty())
+		})
+
+		Context("when the secrets or the namespaces of the LogForwarders change", func() {
+			var reconciler *ClusterLogForwarderReconciler
+			BeforeEach(func() {
+				reconciler = &ClusterLogForwarderReconciler{
+					NewForwarderContext: func() obscontext.ForwarderContext { return cxt },
+				}
+			})
+			hostRequest := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "openshift-logging", Name: "collector"}}
+
+			It("should only reconcile the forwarder serving the namespace of a secret referenced by a LogForwarder", func() {
+				Expect(reconciler.mapTenantSecretToClusterLogForwarder(context.TODO(), runtime.NewSecret("team-a", "hec", nil))).To(Equal([]ctrl.Request{hostRequest}))
+				Expect(reconciler.mapTenantSecretToClusterLogForwarder(context.TODO(), runtime.NewSecret("team-a", "unreferenced", nil))).To(BeEmpty())
+				Expect(reconciler.mapTenantSecretToClusterLogForwarder(context.TODO(), runtime.NewSecret("other", "hec", nil))).To(BeEmpty())
+			})
+
+			It("should reconcile the forwarders with a tenancy when the labels of a namespace with LogForwarders change", func() {
+				Expect(reconciler.mapNamespaceToClusterLogForwarders(context.TODO(), namespace("other", map[string]string{"team": "true"}))).To(Equal([]ctrl.Request{hostRequest}))
+				Expect(reconciler.mapNamespaceToClusterLogForwarders(context.TODO(), namespace("team-c", nil))).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -27,8 +27,21 @@ type APIEvent struct {
 	eventv1.Event    `json:",inline,omitempty"`
 }
 
+// The data model for collected audit event logs from the auditd service of the nodes.
+// The records of an event, e.g. SYSCALL, EXECVE, CWD and PATH, are correlated into a single log.
+//
+// +kubebuilder:object:root=true
+// +docgen:displayname=Viaq Data Model for Linux audit events
+type LinuxAuditLog types.LinuxAuditLog
+
 // The data model for event logs collected from the EventRouter.
 //
 // +kubebuilder:object:root=true
 // +docgen:displayname=Viaq Data Model for EventRouter
 type EventRouterLog types.EventRouterLog
+
+// The data model for the events of the cluster collected from the API server by an `events` input.
+//
+// +kubebuilder:object:root=true
+// +docgen:displayname=Viaq Data Model for Kubernetes events
+type KubernetesEventLog types.KubernetesEventLog
@@ -20,6 +20,10 @@ type ForwarderResourceNames struct {
 	ForwarderName                    string
 	Secrets                          string
 	AwsCredentialsFile               string
+	Preview                          string
+	ConfigValidation                 string
+	AggregatorClientSecret           string
+	AggregatorClientCA               string
 }
 
 func (f *ForwarderResourceNames) DaemonSetName() string {
@@ -31,6 +35,53 @@ func (f *ForwarderResourceNames) GenerateInputServiceName(serviceName string) st
 	return fmt.Sprintf("%s-%s", f.CommonName, serviceName)
 }
 
+// AggregatorResourceNames returns the names of the objects of the aggregator tier which share the service account,
+// secrets and trust bundle of the collector
+func (f *ForwarderResourceNames) AggregatorResourceNames() *ForwarderResourceNames {
+	names := *f
+	resBaseName := fmt.Sprintf("%s-%s", f.CommonName, constants.AggregatorName)
+	names.CommonName = resBaseName
+	names.ForwarderName = resBaseName
+	names.SecretMetrics = resBaseName + "-metrics"
+	names.ConfigMap = resBaseName + "-config"
+	return &names
+}
+
+// NodeProfileResourceNames returns the names of the objects of the collector deployed on the nodes of a node profile
+// which share the service account, secrets and trust bundle of the collector
+func (f *ForwarderResourceNames) NodeProfileResourceNames(profile string) *ForwarderResourceNames {
+	names := *f
+	resBaseName := fmt.Sprintf("%s-%s", f.CommonName, profile)
+	names.CommonName = resBaseName
+	names.ForwarderName = resBaseName
+	names.SecretMetrics = resBaseName + "-metrics"
+	names.ConfigMap = resBaseName + "-config"
+	return &names
+}
+
+// CanaryResourceNames returns the names of the objects of the canary collectors of a canary rollout which share the
+// service account, secrets, trust bundle and data path of the collector
+func (f *ForwarderResourceNames) CanaryResourceNames() *ForwarderResourceNames {
+	names := *f
+	resBaseName := f.CommonName + "-canary"
+	names.CommonName = resBaseName
+	names.SecretMetrics = resBaseName + "-metrics"
+	return &names
+}
+
+// ConfigRevision is the name of the configmap of the revision of the collector config identified by its hash
+func (f *ForwarderResourceNames) ConfigRevision(configHash string) string {
+	if len(configHash) > 10 {
+		configHash = configHash[:10]
+	}
+	return fmt.Sprintf("%s-%s", f.ConfigMap, configHash)
+}
+
+// AggregatorAddress is the address of the service of the aggregator tier
+func (f *ForwarderResourceNames) AggregatorAddress(namespace string) string {
+	return fmt.Sprintf("%s.%s.svc:%d", f.AggregatorResourceNames().CommonName, namespace, constants.AggregatorPort)
+}
+
 // ResourceNames is a factory for naming of objects based on ClusterLogForwarder namespace and name
 func ResourceNames(clf obsv1.ClusterLogForwarder) *ForwarderResourceNames {
 	resBaseName := clf.Name
@@ -47,5 +98,9 @@ func ResourceNames(clf obsv1.ClusterLogForwarder) *ForwarderResourceNames {
 		ServiceAccountTokenSecret:        clf.Spec.ServiceAccount.Name + "-token",
 		Secrets:                          resBaseName + "-secrets",
 		AwsCredentialsFile:               resBaseName + "-" + constants.AwsCredentialsConfigMapName,
+		Preview:                          resBaseName + "-preview",
+		ConfigValidation:                 resBaseName + "-config-validation",
+		AggregatorClientSecret:           resBaseName + "-aggregator-client",
+		AggregatorClientCA:               resBaseName + "-aggregator-client-ca",
 	}
 }
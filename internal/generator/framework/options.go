@@ -11,11 +11,31 @@ const (
 	URL                                 = "url"
 	OptionServiceAccountTokenSecretName = "serviceAccountTokenSecretName"
 	OptionForwarderName                 = "forwarderName"
+	OptionForwarderNamespace            = "forwarderNamespace"
 	UseKubeCacheOption                  = "useKubeCache"
 	MaxUnavailableOption                = "maxUnavailableRollout"
 
+	//OptionConfigMaps is the set of ConfigMaps referenced by the forwarder, keyed by name
+	OptionConfigMaps = "configMaps"
+
 	//OptionLogsToMetricInputs identifies a set of inputs that should be used for exporting metrics
 	OptionLogsToMetricInputs = "logsToMetricInputs"
+
+	//OptionClusterRegion is the region of the cloud platform of the cluster, if any
+	OptionClusterRegion = "clusterRegion"
+
+	//OptionCollectorTier identifies the tier of a two-tier collector for which the config is generated
+	OptionCollectorTier = "collectorTier"
+
+	//CollectorTierAgent reads and normalizes logs of the node and forwards them to the aggregator tier
+	CollectorTierAgent = "agent"
+
+	//CollectorTierAggregator receives logs from the agent tier and applies the pipelines
+	CollectorTierAggregator = "aggregator"
+
+	//OptionTenantInputs identifies the inputs of the LogForwarders of the tenants served by the forwarder, which share
+	//a single container source
+	OptionTenantInputs = "tenantInputs"
 )
 
 // Options is a map of Options used to customize the config generation. E.g. Debugging, legacy config generation
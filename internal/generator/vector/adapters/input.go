@@ -16,6 +16,9 @@ func (i *Input) InputIDs() []string {
 }
 
 func (i *Input) GetTlsSpec() *obs.TLSSpec {
+	if i.Kafka != nil && i.Kafka.TLS != nil {
+		return &i.Kafka.TLS.TLSSpec
+	}
 	if i.Receiver == nil || i.Receiver.TLS == nil {
 		return nil
 	}
@@ -24,11 +27,14 @@ func (i *Input) GetTlsSpec() *obs.TLSSpec {
 }
 
 func (i *Input) GetTlsSecurityProfile() *openshiftv1.TLSSecurityProfile {
+	if i.Kafka != nil && i.Kafka.TLS != nil {
+		return i.Kafka.TLS.TLSSecurityProfile
+	}
 	return nil
 }
 
 func (i *Input) IsInsecureSkipVerify() bool {
-	return false
+	return i.Kafka != nil && i.Kafka.TLS != nil && i.Kafka.TLS.InsecureSkipVerify
 }
 
 func NewInput(spec obs.InputSpec) *Input {
@@ -5,6 +5,7 @@ import (
 	obsv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/api/observability"
 	nhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/utils/sets"
 )
 
 // Output is an internal representation of the public API Output
@@ -58,3 +59,26 @@ func (o *Output) AddInputFrom(n nhelpers.InputComponent) {
 func (o *Output) Inputs() []string {
 	return o.InputIDs
 }
+
+// DeadLetterInputID is the ID of the component that feeds the records rejected by the named output
+// to its dead-letter output
+func DeadLetterInputID(outputName string) string {
+	return nhelpers.MakeOutputID(outputName, "dead_letter")
+}
+
+// AddDeadLetterInputs adds to each dead-letter output the records rejected by every output referencing it
+func AddDeadLetterInputs(outputs map[string]*Output) {
+	for name, o := range outputs {
+		isDeadLetter := false
+		ids := sets.NewString(o.InputIDs...)
+		for _, primary := range outputs {
+			if primary.Name != name && primary.PreDeliveryRejectionsOutputRef == name {
+				isDeadLetter = true
+				ids.Insert(DeadLetterInputID(primary.Name))
+			}
+		}
+		if isDeadLetter {
+			o.InputIDs = ids.List()
+		}
+	}
+}
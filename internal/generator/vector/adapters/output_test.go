@@ -0,0 +1,34 @@
+package adapters
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("#AddDeadLetterInputs", func() {
+
+	var (
+		outputs map[string]*Output
+	)
+
+	BeforeEach(func() {
+		outputs = map[string]*Output{
+			"primary":   {OutputSpec: obs.OutputSpec{Name: "primary", PreDeliveryRejectionsOutputRef: "rejects"}, InputIDs: []string{"pipeline_a"}},
+			"secondary": {OutputSpec: obs.OutputSpec{Name: "secondary", PreDeliveryRejectionsOutputRef: "rejects"}, InputIDs: []string{"pipeline_b"}},
+			"rejects":   {OutputSpec: obs.OutputSpec{Name: "rejects"}, InputIDs: []string{"pipeline_c"}},
+			"other":     {OutputSpec: obs.OutputSpec{Name: "other"}, InputIDs: []string{"pipeline_c", "pipeline_a"}},
+		}
+		AddDeadLetterInputs(outputs)
+	})
+
+	It("should feed a dead-letter output with the records rejected by each referencing output", func() {
+		Expect(outputs["rejects"].InputIDs).To(Equal([]string{"output_primary_dead_letter", "output_secondary_dead_letter", "pipeline_c"}))
+	})
+
+	It("should not modify the inputs of outputs that are not dead-letter outputs", func() {
+		Expect(outputs["primary"].InputIDs).To(Equal([]string{"pipeline_a"}))
+		Expect(outputs["secondary"].InputIDs).To(Equal([]string{"pipeline_b"}))
+		Expect(outputs["other"].InputIDs).To(Equal([]string{"pipeline_c", "pipeline_a"}))
+	})
+})
@@ -6,9 +6,11 @@ import (
 
 	log "github.com/ViaQ/logerr/v2/log/static"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
 	v1 "github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/openshift/viaq/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/pipelinehint"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/utils/sets"
 )
@@ -102,9 +104,18 @@ func AddSystemFilters(p *Pipeline) {
 		}
 	}
 
-	// [Parse/Multiline] -> [Viaq] -> [Others]
-	newRefs := make([]string, 0, len(preFilters)+1+len(otherFilters))
+	// [PipelineHint] -> [Parse/Multiline] -> [Viaq] -> [Others]
+	newRefs := make([]string, 0, len(preFilters)+2+len(otherFilters))
 
+	if internalobs.Inputs(p.inputSpecs).AllowPodAnnotation(obs.PodAnnotationPipelineHint) {
+		p.filterMap[pipelinehint.PipelineHint] = InternalFilterSpec{
+			FilterSpec: &obs.FilterSpec{Type: pipelinehint.PipelineHint},
+			Factory: func(inputs ...string) types.Transform {
+				return pipelinehint.New(p.Name(), inputs...)
+			},
+		}
+		newRefs = append(newRefs, pipelinehint.PipelineHint)
+	}
 	newRefs = append(newRefs, preFilters...)
 	newRefs = append(newRefs, postFilterName)
 	newRefs = append(newRefs, otherFilters...)
@@ -10,6 +10,8 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	v1 "github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/openshift/viaq/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/pipelinehint"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 )
 
@@ -112,4 +114,48 @@ var _ = Describe("Pipeline adapters", func() {
 
 		})
 	})
+
+	Describe("#AddSystemFilters", func() {
+
+		It("should add the pipeline hint filter first when an input allows the pipeline-hint annotation", func() {
+			specs := []obs.InputSpec{
+				{
+					Name: "app-in",
+					Type: obs.InputTypeApplication,
+					Application: &obs.Application{
+						AllowedPodAnnotations: []obs.PodAnnotationKey{obs.PodAnnotationPipelineHint},
+					},
+				},
+			}
+			adapter := adapters.NewPipeline(0, obs.PipelineSpec{
+				Name:       "mypipeline",
+				InputRefs:  []string{"app-in"},
+				FilterRefs: []string{"dropFilter"},
+				OutputRefs: []string{"referenced"},
+			}, inputMap,
+				outputMap,
+				internalFilterMap,
+				specs,
+				adapters.AddSystemFilters,
+			)
+			Expect(adapter.FilterRefs).To(Equal([]string{pipelinehint.PipelineHint, v1.Viaq, "dropFilter"}))
+			Expect(adapter.Transforms()["pipeline_mypipeline_pipeline_hint_0"]).To(Equal(
+				transforms.NewFilter(`!exists(._internal.pod_hints.pipeline) || ._internal.pod_hints.pipeline == "mypipeline"`, "input_app_in_container_meta")))
+		})
+
+		It("should not add the pipeline hint filter when no input allows the pipeline-hint annotation", func() {
+			adapter := adapters.NewPipeline(0, obs.PipelineSpec{
+				Name:       "mypipeline",
+				InputRefs:  []string{"app-in"},
+				FilterRefs: []string{"dropFilter"},
+				OutputRefs: []string{"referenced"},
+			}, inputMap,
+				outputMap,
+				internalFilterMap,
+				inputSpecs,
+				adapters.AddSystemFilters,
+			)
+			Expect(adapter.FilterRefs).To(Equal([]string{v1.Viaq, "dropFilter"}))
+		})
+	})
 })
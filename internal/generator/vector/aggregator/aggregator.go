@@ -0,0 +1,93 @@
+package aggregator
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sinks"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sources"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/transport"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/common/tls"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+)
+
+const (
+	// SinkID is the sink of the agent tier which forwards to the aggregator tier
+	SinkID = "output_aggregator"
+
+	// SourceID is the source of the aggregator tier which receives from the agent tier
+	SourceID = "input_aggregator"
+
+	// RouteID is the transform of the aggregator tier which routes events to the input they were collected by
+	RouteID = "input_aggregator_route"
+
+	metricsCertFile = "/etc/collector/metrics/tls.crt"
+	metricsKeyFile  = "/etc/collector/metrics/tls.key"
+)
+
+// NewTagInput returns a transform which records the name of the input which collected the event so the
+// aggregator tier can route it to the pipelines consuming the input
+func NewTagInput(input obs.InputSpec, inputIDs ...string) (string, types.Transform) {
+	id := helpers.MakeInputID(input.Name, constants.AggregatorName)
+	return id, transforms.NewRemap(fmt.Sprintf(`._internal.input_name = %q`, input.Name), inputIDs...)
+}
+
+// NewSink returns the sink which forwards events of the agent tier to the aggregator tier. The collector
+// authenticates using the dedicated client certificate of the agent tier and verifies the aggregator using the service CA
+func NewSink(address, clientSecretName string, op utils.Options, inputs ...string) types.Sink {
+	return sinks.NewVector(address, func(s *sinks.Vector) {
+		s.Compression = true
+		s.Acknowledgements = &sinks.Acknowledgements{Enabled: true}
+		s.TLS = newTLS(
+			helpers.SecretPath(clientSecretName, constants.ClientCertKey, "%s"),
+			helpers.SecretPath(clientSecretName, constants.ClientPrivateKey, "%s"),
+			helpers.ConfigPath(constants.ServiceCABundleConfigMapName, constants.ServiceCABundleKey, "%s"),
+			op)
+	}, inputs...)
+}
+
+// NewSource returns the source which receives events of the agent tier using the serving certificate of the
+// aggregator and requires a client certificate signed by the CA of the agent tier client certificate
+func NewSource(clientCAConfigMapName string, op utils.Options) types.Source {
+	s := sources.NewVector(helpers.ListenOnAllLocalInterfacesAddress(), constants.AggregatorPort)
+	s.TLS = newTLS(
+		metricsCertFile,
+		metricsKeyFile,
+		helpers.ConfigPath(clientCAConfigMapName, constants.TrustedCABundleKey, "%s"),
+		op)
+	s.TLS.VerifyCertificate = utils.GetPtr(true)
+	return s
+}
+
+// NewRoute returns the transform which routes events received from the agent tier to the input which collected them
+func NewRoute(inputs []obs.InputSpec) types.Transform {
+	return transforms.NewRoute(func(r *transforms.Route) {
+		r.Routes = map[string]string{}
+		for _, i := range inputs {
+			r.Routes[helpers.FormatComponentID(i.Name)] = fmt.Sprintf(`._internal.input_name == %q`, i.Name)
+		}
+	}, SourceID)
+}
+
+// RouteInputID is the id of the route for an input
+func RouteInputID(input obs.InputSpec) string {
+	return strings.Join([]string{RouteID, helpers.FormatComponentID(input.Name)}, ".")
+}
+
+func newTLS(crtFile, keyFile, caFile string, op utils.Options) *transport.TlsEnabled {
+	t := &transport.TlsEnabled{
+		Enabled: true,
+		TLS: transport.TLS{
+			KeyFile: keyFile,
+			CRTFile: crtFile,
+			CAFile:  caFile,
+		},
+	}
+	tls.SetTLSProfile(&t.TLS, op)
+	return t
+}
@@ -69,6 +69,12 @@ func (sinkMap *Sinks) UnmarshalTOML(data interface{}) (err error) {
 				return fmt.Errorf("failed to unmarshal sink %s: %w", id, err)
 			}
 			sink = &s
+		case types.SinkTypeDatadogLogs:
+			var s sinks.DatadogLogs
+			if err = tree.Unmarshal(&s); err != nil {
+				return fmt.Errorf("failed to unmarshal sink %s: %w", id, err)
+			}
+			sink = &s
 		case types.SinkTypeElasticsearch:
 			var s sinks.Elasticsearch
 			if err = tree.Unmarshal(&s); err != nil {
@@ -99,6 +105,12 @@ func (sinkMap *Sinks) UnmarshalTOML(data interface{}) (err error) {
 				return fmt.Errorf("failed to unmarshal sink %s: %w", id, err)
 			}
 			sink = &s
+		case types.SinkTypeNewRelic:
+			var s sinks.NewRelic
+			if err = tree.Unmarshal(&s); err != nil {
+				return fmt.Errorf("failed to unmarshal sink %s: %w", id, err)
+			}
+			sink = &s
 		case types.SinkTypeOpenTelemetry:
 			var s sinks.OpenTelemetry
 			if err = tree.Unmarshal(&s); err != nil {
@@ -123,6 +135,12 @@ func (sinkMap *Sinks) UnmarshalTOML(data interface{}) (err error) {
 				return fmt.Errorf("failed to unmarshal sink %s: %w", id, err)
 			}
 			sink = &s
+		case types.SinkTypeVector:
+			var s sinks.Vector
+			if err = tree.Unmarshal(&s); err != nil {
+				return fmt.Errorf("failed to unmarshal sink %s: %w", id, err)
+			}
+			sink = &s
 		default:
 			return fmt.Errorf("unknown sink type %s for sink %s", typeExtractor.Type, id)
 		}
@@ -46,7 +46,11 @@ const (
 type Encoding struct {
 	Codec           codec.CodecType `json:"codec,omitempty" yaml:"codec,omitempty" toml:"codec,omitempty"`
 	TimestampFormat string          `json:"timestamp_format,omitempty" yaml:"timestamp_format,omitempty" toml:"timestamp_format,omitempty"`
+	OnlyFields      []string        `json:"only_fields,omitempty" yaml:"only_fields,omitempty" toml:"only_fields,omitempty"`
 	ExceptFields    []string        `json:"except_fields,omitempty" yaml:"except_fields,omitempty" toml:"except_fields,omitempty"`
+	CSV             *codec.CSV      `json:"csv,omitempty" yaml:"csv,omitempty" toml:"csv,omitempty"`
+	Protobuf        *codec.Protobuf `json:"protobuf,omitempty" yaml:"protobuf,omitempty" toml:"protobuf,omitempty"`
+	Avro            *codec.Avro     `json:"avro,omitempty" yaml:"avro,omitempty" toml:"avro,omitempty"`
 }
 
 type FramingMethod string
@@ -60,9 +64,12 @@ const (
 )
 
 type Framing struct {
-	Method             FramingMethod `json:"method,omitempty" yaml:"method,omitempty" toml:"method,omitempty"`
-	CharacterDelimiter string        `json:"character_delimited,omitempty" yaml:"character_delimited,omitempty" toml:"character_delimited,omitempty"`
-	MaxLength          uint          `json:"max_length,omitempty" yaml:"max_length,omitempty" toml:"max_length,omitempty"`
+	Method             FramingMethod              `json:"method,omitempty" yaml:"method,omitempty" toml:"method,omitempty"`
+	CharacterDelimited *CharacterDelimitedFraming `json:"character_delimited,omitempty" yaml:"character_delimited,omitempty" toml:"character_delimited,omitempty"`
+}
+
+type CharacterDelimitedFraming struct {
+	Delimiter string `json:"delimiter,omitempty" yaml:"delimiter,omitempty" toml:"delimiter,omitempty"`
 }
 
 type Proxy struct {
@@ -0,0 +1,32 @@
+package sinks
+
+import (
+	"sort"
+
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+)
+
+type DatadogLogs struct {
+	Type          types.SinkType `json:"type,omitempty" yaml:"type,omitempty" toml:"type,omitempty"`
+	Inputs        []string       `json:"inputs,omitempty" yaml:"inputs,omitempty" toml:"inputs,omitempty"`
+	DefaultApiKey string         `json:"default_api_key,omitempty" yaml:"default_api_key,omitempty" toml:"default_api_key,omitempty"`
+	Site          string         `json:"site,omitempty" yaml:"site,omitempty" toml:"site,omitempty"`
+	Endpoint      string         `json:"endpoint,omitempty" yaml:"endpoint,omitempty" toml:"endpoint,omitempty"`
+	BaseSink
+}
+
+func NewDatadogLogs(init func(s *DatadogLogs), inputs ...string) (s *DatadogLogs) {
+	sort.Strings(inputs)
+	s = &DatadogLogs{
+		Type:   types.SinkTypeDatadogLogs,
+		Inputs: inputs,
+	}
+	if init != nil {
+		init(s)
+	}
+	return s
+}
+
+func (s *DatadogLogs) SinkType() types.SinkType {
+	return s.Type
+}
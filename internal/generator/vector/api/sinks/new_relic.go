@@ -0,0 +1,41 @@
+package sinks
+
+import (
+	"sort"
+
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+)
+
+type NewRelicApi string
+
+const (
+	NewRelicApiLogs NewRelicApi = "logs"
+)
+
+type NewRelic struct {
+	Type       types.SinkType `json:"type,omitempty" yaml:"type,omitempty" toml:"type,omitempty"`
+	Inputs     []string       `json:"inputs,omitempty" yaml:"inputs,omitempty" toml:"inputs,omitempty"`
+	AccountId  string         `json:"account_id,omitempty" yaml:"account_id,omitempty" toml:"account_id,omitempty"`
+	LicenseKey string         `json:"license_key,omitempty" yaml:"license_key,omitempty" toml:"license_key,omitempty"`
+	Api        NewRelicApi    `json:"api,omitempty" yaml:"api,omitempty" toml:"api,omitempty"`
+	Region     string         `json:"region,omitempty" yaml:"region,omitempty" toml:"region,omitempty"`
+	BaseSink
+}
+
+func NewNewRelic(accountID string, init func(s *NewRelic), inputs ...string) (s *NewRelic) {
+	sort.Strings(inputs)
+	s = &NewRelic{
+		Type:      types.SinkTypeNewRelic,
+		Inputs:    inputs,
+		AccountId: accountID,
+		Api:       NewRelicApiLogs,
+	}
+	if init != nil {
+		init(s)
+	}
+	return s
+}
+
+func (s *NewRelic) SinkType() types.SinkType {
+	return s.Type
+}
@@ -4,6 +4,7 @@ import (
 	"sort"
 
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/codec"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/transport"
 )
 
@@ -30,9 +31,10 @@ type SyslogEncodingConfig struct {
 }
 
 type SocketEncoding struct {
-	Codec        string                `json:"codec,omitempty" yaml:"codec,omitempty" toml:"codec,omitempty"`
+	Codec        codec.CodecType       `json:"codec,omitempty" yaml:"codec,omitempty" toml:"codec,omitempty"`
 	ExceptFields []string              `json:"except_fields,omitempty" yaml:"except_fields,omitempty" toml:"except_fields,omitempty"`
 	Syslog       *SyslogEncodingConfig `json:"syslog,omitempty" yaml:"syslog,omitempty" toml:"syslog,omitempty"`
+	CSV          *codec.CSV            `json:"csv,omitempty" yaml:"csv,omitempty" toml:"csv,omitempty"`
 }
 
 type Socket struct {
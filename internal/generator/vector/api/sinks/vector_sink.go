@@ -0,0 +1,35 @@
+package sinks
+
+import (
+	"sort"
+
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/transport"
+)
+
+// Vector forwards events to another Vector instance using the native protocol
+type Vector struct {
+	Type             types.SinkType        `json:"type" yaml:"type" toml:"type"`
+	Inputs           []string              `json:"inputs" yaml:"inputs" toml:"inputs"`
+	Address          string                `json:"address" yaml:"address" toml:"address"`
+	Compression      bool                  `json:"compression,omitempty" yaml:"compression,omitempty" toml:"compression,omitempty"`
+	Acknowledgements *Acknowledgements     `json:"acknowledgements,omitempty" yaml:"acknowledgements,omitempty" toml:"acknowledgements,omitempty"`
+	TLS              *transport.TlsEnabled `json:"tls,omitempty" yaml:"tls,omitempty" toml:"tls,omitempty"`
+}
+
+func NewVector(address string, init func(s *Vector), inputs ...string) (s *Vector) {
+	sort.Strings(inputs)
+	s = &Vector{
+		Type:    types.SinkTypeVector,
+		Inputs:  inputs,
+		Address: address,
+	}
+	if init != nil {
+		init(s)
+	}
+	return s
+}
+
+func (s *Vector) SinkType() types.SinkType {
+	return s.Type
+}
@@ -47,6 +47,12 @@ func (sourceMap *Sources) UnmarshalTOML(data interface{}) (err error) {
 				return fmt.Errorf("failed to unmarshal file source %s: %w", id, err)
 			}
 			source = &s
+		case types.SourceTypeHttpClient:
+			var s sources.HttpClient
+			if err = tree.Unmarshal(&s); err != nil {
+				return fmt.Errorf("failed to unmarshal http_client source %s: %w", id, err)
+			}
+			source = &s
 		case types.SourceTypeHttpServer:
 			var s sources.HttpServer
 			if err = tree.Unmarshal(&s); err != nil {
@@ -77,6 +83,36 @@ func (sourceMap *Sources) UnmarshalTOML(data interface{}) (err error) {
 				return fmt.Errorf("failed to unmarshal syslog source %s: %w", id, err)
 			}
 			source = &s
+		case types.SourceTypeOpenTelemetry:
+			var s sources.OpenTelemetry
+			if err = tree.Unmarshal(&s); err != nil {
+				return fmt.Errorf("failed to unmarshal opentelemetry source %s: %w", id, err)
+			}
+			source = &s
+		case types.SourceTypeFluent:
+			var s sources.Fluent
+			if err = tree.Unmarshal(&s); err != nil {
+				return fmt.Errorf("failed to unmarshal fluent source %s: %w", id, err)
+			}
+			source = &s
+		case types.SourceTypeKafka:
+			var s sources.Kafka
+			if err = tree.Unmarshal(&s); err != nil {
+				return fmt.Errorf("failed to unmarshal kafka source %s: %w", id, err)
+			}
+			source = &s
+		case types.SourceTypeSplunkHec:
+			var s sources.SplunkHec
+			if err = tree.Unmarshal(&s); err != nil {
+				return fmt.Errorf("failed to unmarshal splunk_hec source %s: %w", id, err)
+			}
+			source = &s
+		case types.SourceTypeVector:
+			var s sources.Vector
+			if err = tree.Unmarshal(&s); err != nil {
+				return fmt.Errorf("failed to unmarshal vector source %s: %w", id, err)
+			}
+			source = &s
 		default:
 			return fmt.Errorf("unknown source type %s for source %s", typeExtractor.Type, id)
 		}
@@ -11,12 +11,50 @@ type File struct {
 	// Include is file paths to include for this source
 	Include []string `json:"include" yaml:"include" toml:"include"`
 
+	// Exclude is file paths to exclude from the included paths
+	Exclude []string `json:"exclude,omitempty" yaml:"exclude,omitempty" toml:"exclude,omitempty"`
+
 	HostKey                           string `json:"host_key,omitempty" yaml:"host_key,omitempty" toml:"host_key,omitempty"`
 	GlobalMinimumCooldownMilliSeconds int64  `json:"glob_minimum_cooldown_ms,omitempty" yaml:"glob_minimum_cooldown_ms" toml:"glob_minimum_cooldown_ms,omitempty"`
 	IgnoreOlderSecs                   int64  `json:"ignore_older_secs,omitempty" yaml:"ignore_older_secs,omitempty" toml:"ignore_older_secs,omitempty"`
 	MaxLineBytes                      int64  `json:"max_line_bytes,omitempty" yaml:"max_line_bytes,omitempty" toml:"max_line_bytes,omitempty"`
 	MaxReadBytes                      int64  `json:"max_read_bytes,omitempty" yaml:"max_read_bytes,omitempty" toml:"max_read_bytes,omitempty"`
 	RotateWaitSecs                    int64  `json:"rotate_wait_secs,omitempty" yaml:"rotate_wait_secs,omitempty" toml:"rotate_wait_secs,omitempty"`
+
+	// Multiline aggregates multiple lines into a single event
+	Multiline *FileMultiline `json:"multiline,omitempty" yaml:"multiline,omitempty" toml:"multiline,omitempty"`
+
+	// Fingerprint configures how files are identified
+	Fingerprint *FileFingerprint `json:"fingerprint,omitempty" yaml:"fingerprint,omitempty" toml:"fingerprint,omitempty"`
+}
+
+type MultilineMode string
+
+const (
+	MultilineModeContinueThrough MultilineMode = "continue_through"
+	MultilineModeContinuePast    MultilineMode = "continue_past"
+	MultilineModeHaltBefore      MultilineMode = "halt_before"
+	MultilineModeHaltWith        MultilineMode = "halt_with"
+)
+
+type FileMultiline struct {
+	StartPattern     string        `json:"start_pattern" yaml:"start_pattern" toml:"start_pattern"`
+	ConditionPattern string        `json:"condition_pattern" yaml:"condition_pattern" toml:"condition_pattern"`
+	Mode             MultilineMode `json:"mode" yaml:"mode" toml:"mode"`
+	TimeoutMs        int64         `json:"timeout_ms" yaml:"timeout_ms" toml:"timeout_ms"`
+}
+
+type FingerprintStrategy string
+
+const (
+	FingerprintStrategyChecksum       FingerprintStrategy = "checksum"
+	FingerprintStrategyDeviceAndInode FingerprintStrategy = "device_and_inode"
+)
+
+type FileFingerprint struct {
+	Strategy           FingerprintStrategy `json:"strategy" yaml:"strategy" toml:"strategy"`
+	Lines              *int64              `json:"lines,omitempty" yaml:"lines,omitempty" toml:"lines,omitempty"`
+	IgnoredHeaderBytes *int64              `json:"ignored_header_bytes,omitempty" yaml:"ignored_header_bytes,omitempty" toml:"ignored_header_bytes,omitempty"`
 }
 
 func (s File) SourceType() types.SourceType {
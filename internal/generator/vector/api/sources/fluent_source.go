@@ -0,0 +1,37 @@
+package sources
+
+import (
+	"fmt"
+
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/transport"
+)
+
+// Fluent receives logs from Fluent Bit and Fluentd clients using the forward protocol
+type Fluent struct {
+	Type    types.SourceType `json:"type" yaml:"type" toml:"type"`
+	Address string           `json:"address" yaml:"address" toml:"address"`
+	Mode    FluentMode       `json:"mode,omitempty" yaml:"mode,omitempty" toml:"mode,omitempty"`
+	// SharedKey authenticates clients with the shared key handshake of the forward protocol
+	SharedKey string `json:"shared_key,omitempty" yaml:"shared_key,omitempty" toml:"shared_key,omitempty"`
+
+	TLS *transport.TlsEnabled `json:"tls,omitempty" yaml:"tls,omitempty" toml:"tls,omitempty"`
+}
+
+func (f Fluent) SourceType() types.SourceType {
+	return f.Type
+}
+
+func NewFluent(listenAddress string, listenPort int32) *Fluent {
+	return &Fluent{
+		Type:    types.SourceTypeFluent,
+		Address: fmt.Sprintf("%s:%d", listenAddress, listenPort),
+		Mode:    FluentModeTcp,
+	}
+}
+
+type FluentMode string
+
+const (
+	FluentModeTcp FluentMode = "tcp"
+)
@@ -0,0 +1,32 @@
+package sources
+
+import (
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sinks"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/transport"
+)
+
+// HttpClient periodically requests an HTTP endpoint and emits the decoded responses
+type HttpClient struct {
+	Type               types.SourceType `json:"type" yaml:"type" toml:"type"`
+	Endpoint           string           `json:"endpoint" yaml:"endpoint" toml:"endpoint"`
+	Method             string           `json:"method,omitempty" yaml:"method,omitempty" toml:"method,omitempty"`
+	ScrapeIntervalSecs uint64           `json:"scrape_interval_secs,omitempty" yaml:"scrape_interval_secs,omitempty" toml:"scrape_interval_secs,omitempty"`
+	ScrapeTimeoutSecs  uint64           `json:"scrape_timeout_secs,omitempty" yaml:"scrape_timeout_secs,omitempty" toml:"scrape_timeout_secs,omitempty"`
+	Framing            *Framing         `json:"framing,omitempty" yaml:"framing,omitempty" toml:"framing,omitempty"`
+	Decoding           *Decoding        `json:"decoding,omitempty" yaml:"decoding,omitempty" toml:"decoding,omitempty"`
+	Auth               *sinks.HttpAuth  `json:"auth,omitempty" yaml:"auth,omitempty" toml:"auth,omitempty"`
+	TLS                *transport.TLS   `json:"tls,omitempty" yaml:"tls,omitempty" toml:"tls,omitempty"`
+}
+
+func (h HttpClient) SourceType() types.SourceType {
+	return h.Type
+}
+
+func NewHttpClient(endpoint string) *HttpClient {
+	return &HttpClient{
+		Type:     types.SourceTypeHttpClient,
+		Endpoint: endpoint,
+		Method:   "GET",
+	}
+}
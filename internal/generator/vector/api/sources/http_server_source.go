@@ -11,11 +11,37 @@ import (
 type HttpServer struct {
 	Type     types.SourceType `json:"type" yaml:"type" toml:"type"`
 	Address  string           `json:"address" yaml:"address" toml:"address"`
+	Path     string           `json:"path,omitempty" yaml:"path,omitempty" toml:"path,omitempty"`
+	Headers  []string         `json:"headers,omitempty" yaml:"headers,omitempty" toml:"headers,omitempty"`
+	Framing  *Framing         `json:"framing,omitempty" yaml:"framing,omitempty" toml:"framing,omitempty"`
 	Decoding *Decoding        `json:"decoding,omitempty" yaml:"decoding,omitempty" toml:"decoding,omitempty"`
+	Auth     *HttpServerAuth  `json:"auth,omitempty" yaml:"auth,omitempty" toml:"auth,omitempty"`
 
 	TLS *transport.TlsEnabled `json:"tls,omitempty" yaml:"tls,omitempty" toml:"tls,omitempty"`
 }
 
+type HttpServerAuthStrategy string
+
+const (
+	HttpServerAuthStrategyCustom HttpServerAuthStrategy = "custom"
+)
+
+// HttpServerAuth authenticates requests using a VRL condition evaluated against the request headers
+type HttpServerAuth struct {
+	Strategy HttpServerAuthStrategy `json:"strategy,omitempty" yaml:"strategy,omitempty" toml:"strategy,omitempty"`
+	Source   string                 `json:"source,omitempty" yaml:"source,omitempty" toml:"source,omitempty"`
+}
+
+type FramingMethod string
+
+const (
+	FramingMethodNewlineDelimited FramingMethod = "newline_delimited"
+)
+
+type Framing struct {
+	Method FramingMethod `json:"method,omitempty" yaml:"method,omitempty" toml:"method,omitempty"`
+}
+
 func (h HttpServer) SourceType() types.SourceType {
 	return h.Type
 }
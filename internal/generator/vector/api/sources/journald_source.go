@@ -9,6 +9,18 @@ type Journald struct {
 	Type types.SourceType `json:"type" yaml:"type" toml:"type"`
 
 	JournalDirectory string `json:"journal_directory" yaml:"journal_directory" toml:"journal_directory"`
+
+	// IncludeUnits is the list of systemd units to read
+	IncludeUnits []string `json:"include_units,omitempty" yaml:"include_units,omitempty" toml:"include_units,omitempty"`
+
+	// ExcludeUnits is the list of systemd units not to read
+	ExcludeUnits []string `json:"exclude_units,omitempty" yaml:"exclude_units,omitempty" toml:"exclude_units,omitempty"`
+
+	// SinceNow reads only the entries written after the source started
+	SinceNow bool `json:"since_now,omitempty" yaml:"since_now,omitempty" toml:"since_now,omitempty"`
+
+	// IncludeMatches is the values of the journal fields an entry must match to be read
+	IncludeMatches map[string][]string `json:"include_matches,omitempty" yaml:"include_matches,omitempty" toml:"include_matches,omitempty"`
 }
 
 func NewJournalD() Journald {
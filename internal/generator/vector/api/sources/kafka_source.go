@@ -0,0 +1,44 @@
+package sources
+
+import (
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/transport"
+)
+
+// Kafka consumes logs from the topics of a Kafka cluster
+type Kafka struct {
+	Type               types.SourceType      `json:"type" yaml:"type" toml:"type"`
+	BootstrapServers   string                `json:"bootstrap_servers" yaml:"bootstrap_servers" toml:"bootstrap_servers"`
+	Topics             []string              `json:"topics" yaml:"topics" toml:"topics"`
+	GroupID            string                `json:"group_id" yaml:"group_id" toml:"group_id"`
+	Decoding           *Decoding             `json:"decoding,omitempty" yaml:"decoding,omitempty" toml:"decoding,omitempty"`
+	Acknowledgements   *Acknowledgements     `json:"acknowledgements,omitempty" yaml:"acknowledgements,omitempty" toml:"acknowledgements,omitempty"`
+	Sasl               *Sasl                 `json:"sasl,omitempty" yaml:"sasl,omitempty" toml:"sasl,omitempty"`
+	TLS                *transport.TlsEnabled `json:"tls,omitempty" yaml:"tls,omitempty" toml:"tls,omitempty"`
+	LibrdKafka_Options map[string]string     `json:"librdkafka_options,omitempty" yaml:"librdkafka_options,omitempty" toml:"librdkafka_options,omitempty"`
+}
+
+func (k Kafka) SourceType() types.SourceType {
+	return k.Type
+}
+
+func NewKafka(bootstrapServers string, topics []string, groupID string) *Kafka {
+	return &Kafka{
+		Type:             types.SourceTypeKafka,
+		BootstrapServers: bootstrapServers,
+		Topics:           topics,
+		GroupID:          groupID,
+	}
+}
+
+// Acknowledgements delays the acknowledgement of events by a source until they are accepted by its sinks
+type Acknowledgements struct {
+	Enabled bool `json:"enabled" yaml:"enabled" toml:"enabled"`
+}
+
+type Sasl struct {
+	Enabled   bool   `json:"enabled,omitempty" yaml:"enabled,omitempty" toml:"enabled,omitempty"`
+	Username  string `json:"username,omitempty" yaml:"username,omitempty" toml:"username,omitempty"`
+	Password  string `json:"password,omitempty" yaml:"password,omitempty" toml:"password,omitempty"`
+	Mechanism string `json:"mechanism,omitempty" yaml:"mechanism,omitempty" toml:"mechanism,omitempty"`
+}
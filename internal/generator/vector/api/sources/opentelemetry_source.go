@@ -0,0 +1,40 @@
+package sources
+
+import (
+	"fmt"
+
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/transport"
+)
+
+// OpenTelemetry receives logs, metrics and traces using the OpenTelemetry Protocol.
+// Events are emitted on the named outputs `<id>.logs`, `<id>.metrics` and `<id>.traces`
+type OpenTelemetry struct {
+	Type types.SourceType `json:"type" yaml:"type" toml:"type"`
+	// UseOTLPDecoding emits the received OTLP payloads as is instead of decoding them into Vector events
+	UseOTLPDecoding bool                 `json:"use_otlp_decoding,omitempty" yaml:"use_otlp_decoding,omitempty" toml:"use_otlp_decoding,omitempty"`
+	GRPC            *OpenTelemetryServer `json:"grpc,omitempty" yaml:"grpc,omitempty" toml:"grpc,omitempty"`
+	HTTP            *OpenTelemetryServer `json:"http,omitempty" yaml:"http,omitempty" toml:"http,omitempty"`
+}
+
+type OpenTelemetryServer struct {
+	Address string `json:"address" yaml:"address" toml:"address"`
+
+	TLS *transport.TlsEnabled `json:"tls,omitempty" yaml:"tls,omitempty" toml:"tls,omitempty"`
+}
+
+func (o OpenTelemetry) SourceType() types.SourceType {
+	return o.Type
+}
+
+func NewOpenTelemetry(listenAddress string, grpcPort, httpPort int32) *OpenTelemetry {
+	return &OpenTelemetry{
+		Type: types.SourceTypeOpenTelemetry,
+		GRPC: &OpenTelemetryServer{
+			Address: fmt.Sprintf("%s:%d", listenAddress, grpcPort),
+		},
+		HTTP: &OpenTelemetryServer{
+			Address: fmt.Sprintf("%s:%d", listenAddress, httpPort),
+		},
+	}
+}
@@ -0,0 +1,29 @@
+package sources
+
+import (
+	"fmt"
+
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/transport"
+)
+
+// SplunkHec receives logs from clients using the Splunk HTTP Event Collector protocol
+type SplunkHec struct {
+	Type             types.SourceType  `json:"type" yaml:"type" toml:"type"`
+	Address          string            `json:"address" yaml:"address" toml:"address"`
+	ValidTokens      []string          `json:"valid_tokens,omitempty" yaml:"valid_tokens,omitempty" toml:"valid_tokens,omitempty"`
+	Acknowledgements *Acknowledgements `json:"acknowledgements,omitempty" yaml:"acknowledgements,omitempty" toml:"acknowledgements,omitempty"`
+
+	TLS *transport.TlsEnabled `json:"tls,omitempty" yaml:"tls,omitempty" toml:"tls,omitempty"`
+}
+
+func (s SplunkHec) SourceType() types.SourceType {
+	return s.Type
+}
+
+func NewSplunkHec(listenAddress string, listenPort int32) *SplunkHec {
+	return &SplunkHec{
+		Type:    types.SourceTypeSplunkHec,
+		Address: fmt.Sprintf("%s:%d", listenAddress, listenPort),
+	}
+}
@@ -0,0 +1,27 @@
+package sources
+
+import (
+	"fmt"
+
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/transport"
+)
+
+// Vector receives events from other Vector instances using the native protocol
+type Vector struct {
+	Type    types.SourceType `json:"type" yaml:"type" toml:"type"`
+	Address string           `json:"address" yaml:"address" toml:"address"`
+
+	TLS *transport.TlsEnabled `json:"tls,omitempty" yaml:"tls,omitempty" toml:"tls,omitempty"`
+}
+
+func (v Vector) SourceType() types.SourceType {
+	return v.Type
+}
+
+func NewVector(listenAddress string, listenPort int32) *Vector {
+	return &Vector{
+		Type:    types.SourceTypeVector,
+		Address: fmt.Sprintf("%s:%d", listenAddress, listenPort),
+	}
+}
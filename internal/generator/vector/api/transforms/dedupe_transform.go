@@ -0,0 +1,41 @@
+package transforms
+
+import (
+	"sort"
+
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+)
+
+// Dedupe drops events matching the fields of an event seen recently
+type Dedupe struct {
+	Type types.TransformType `json:"type" yaml:"type" toml:"type"`
+
+	// Inputs is the IDs of the components feeding into this component
+	Inputs []string `json:"inputs" yaml:"inputs" toml:"inputs"`
+
+	Fields *DedupeFields `json:"fields,omitempty" yaml:"fields,omitempty" toml:"fields,omitempty"`
+	Cache  *DedupeCache  `json:"cache,omitempty" yaml:"cache,omitempty" toml:"cache,omitempty"`
+}
+
+type DedupeFields struct {
+	Match []string `json:"match,omitempty" yaml:"match,omitempty" toml:"match,omitempty"`
+}
+
+type DedupeCache struct {
+	NumEvents uint64 `json:"num_events,omitempty" yaml:"num_events,omitempty" toml:"num_events,omitempty"`
+}
+
+func NewDedupe(match []string, inputs ...string) *Dedupe {
+	sort.Strings(inputs)
+	return &Dedupe{
+		Type:   types.TransformTypeDedupe,
+		Inputs: inputs,
+		Fields: &DedupeFields{
+			Match: match,
+		},
+	}
+}
+
+func (t *Dedupe) TransformType() types.TransformType {
+	return t.Type
+}
@@ -12,20 +12,24 @@ type Reduce struct {
 	ExpireAfterMs   uint64              `json:"expire_after_ms,omitempty" yaml:"expire_after_ms,omitempty" toml:"expire_after_ms,omitempty"`
 	MaxEvents       uint64              `json:"max_events,omitempty" yaml:"max_events,omitempty" toml:"max_events,omitempty"`
 	GroupBy         []string            `json:"group_by,omitempty" yaml:"group_by,omitempty" toml:"group_by,omitempty"`
+	EndsWhen        string              `json:"ends_when,omitempty" yaml:"ends_when,omitempty" toml:"ends_when,omitempty"`
 	MergeStrategies *MergeStrategies    `json:"merge_strategies,omitempty" yaml:"merge_strategies,omitempty" toml:"merge_strategies,omitempty"`
 }
 
 type MergeStrategiesResourceType string
 type MergeStrategiesLogRecordsType string
+type MergeStrategiesMessageType string
 
 const (
-	MergeStrategiesResourceRetain  MergeStrategiesResourceType   = "retain"
-	MergeStrategiesLogRecordsArray MergeStrategiesLogRecordsType = "array"
+	MergeStrategiesResourceRetain       MergeStrategiesResourceType   = "retain"
+	MergeStrategiesLogRecordsArray      MergeStrategiesLogRecordsType = "array"
+	MergeStrategiesMessageConcatNewline MergeStrategiesMessageType    = "concat_newline"
 )
 
 type MergeStrategies struct {
 	Resource   MergeStrategiesResourceType   `json:"resource,omitempty" yaml:"resource,omitempty" toml:"resource,omitempty"`
 	LogRecords MergeStrategiesLogRecordsType `json:"logRecords,omitempty" yaml:"logRecords,omitempty" toml:"logRecords,omitempty"`
+	Message    MergeStrategiesMessageType    `json:"message,omitempty" yaml:"message,omitempty" toml:"message,omitempty"`
 }
 
 func NewReduce(init func(*Reduce), inputs ...string) *Reduce {
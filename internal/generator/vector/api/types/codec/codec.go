@@ -3,5 +3,29 @@ package codec
 type CodecType string
 
 const (
-	CodecTypeJSON CodecType = "json"
+	CodecTypeAvro       CodecType = "avro"
+	CodecTypeBytes      CodecType = "bytes"
+	CodecTypeCSV        CodecType = "csv"
+	CodecTypeGELF       CodecType = "gelf"
+	CodecTypeJSON       CodecType = "json"
+	CodecTypeProtobuf   CodecType = "protobuf"
+	CodecTypeRawMessage CodecType = "raw_message"
+	CodecTypeSyslog     CodecType = "syslog"
+	CodecTypeText       CodecType = "text"
 )
+
+// CSV are the options of the csv codec
+type CSV struct {
+	Fields []string `json:"fields,omitempty" yaml:"fields,omitempty" toml:"fields,omitempty"`
+}
+
+// Protobuf are the options of the protobuf codec
+type Protobuf struct {
+	DescFile    string `json:"desc_file,omitempty" yaml:"desc_file,omitempty" toml:"desc_file,omitempty"`
+	MessageType string `json:"message_type,omitempty" yaml:"message_type,omitempty" toml:"message_type,omitempty"`
+}
+
+// Avro are the options of the avro codec
+type Avro struct {
+	Schema string `json:"schema,omitempty" yaml:"schema,omitempty" toml:"schema,omitempty"`
+}
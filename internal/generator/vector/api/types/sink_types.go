@@ -7,15 +7,18 @@ const (
 	SinkTypeAwsS3              SinkType = "aws_s3"
 	SinkTypeAzureLogsIngestion SinkType = "azure_logs_ingestion"
 	SinkTypeAzureMonitorLogs   SinkType = "azure_monitor_logs"
+	SinkTypeDatadogLogs        SinkType = "datadog_logs"
 	SinkTypeElasticsearch      SinkType = "elasticsearch"
 	SinkTypeGcpStackdriverLogs SinkType = "gcp_stackdriver_logs"
 	SinkTypeHttp               SinkType = "http"
 	SinkTypeLoki               SinkType = "loki"
 	SinkTypeKafka              SinkType = "kafka"
+	SinkTypeNewRelic           SinkType = "new_relic"
 	SinkTypeOpenTelemetry      SinkType = "opentelemetry"
 	SinkTypePrometheusExporter SinkType = "prometheus_exporter"
 	SinkTypeSocket             SinkType = "socket"
 	SinkTypeSplunkHecLogs      SinkType = "splunk_hec_logs"
+	SinkTypeVector             SinkType = "vector"
 )
 
 type Sink interface {
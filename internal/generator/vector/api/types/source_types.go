@@ -4,11 +4,17 @@ type SourceType string
 
 const (
 	SourceTypeFile            SourceType = "file"
+	SourceTypeHttpClient      SourceType = "http_client"
 	SourceTypeHttpServer      SourceType = "http_server"
 	SourceTypeInternalMetrics SourceType = "internal_metrics"
 	SourceTypeKubernetesLogs  SourceType = "kubernetes_logs"
 	SourceTypeJournald        SourceType = "journald"
 	SourceTypeSyslog          SourceType = "syslog"
+	SourceTypeOpenTelemetry   SourceType = "opentelemetry"
+	SourceTypeFluent          SourceType = "fluent"
+	SourceTypeSplunkHec       SourceType = "splunk_hec"
+	SourceTypeKafka           SourceType = "kafka"
+	SourceTypeVector          SourceType = "vector"
 )
 
 // Source is a vector source for signals coming into the collector
@@ -3,6 +3,7 @@ package types
 type TransformType string
 
 const (
+	TransformTypeDedupe           TransformType = "dedupe"
 	TransformTypeDetectExceptions TransformType = "detect_exceptions"
 	TransformTypeFilter           TransformType = "filter"
 	TransformTypeLogToMetric      TransformType = "log_to_metric"
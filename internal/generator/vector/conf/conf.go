@@ -1,11 +1,13 @@
 package conf
 
 import (
+	"slices"
 	"sort"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/aggregator"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sources"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/input"
@@ -66,18 +68,25 @@ const (
 //nolint:govet // using declarative style
 func Conf(secrets map[string]*corev1.Secret, clfspec obs.ClusterLogForwarderSpec, namespace, forwarderName string, resNames factory.ForwarderResourceNames, op utils.Options) (config *api.Config) {
 	op[helpers.CLFSpec] = internalobs.ClusterLogForwarderSpec(clfspec)
+	tier, _ := utils.GetOption(op, framework.OptionCollectorTier, "")
 
 	// Init inputs, outputs, pipelines
 	inputMap := map[string]*adapters.Input{}
 	inputCompMap := map[string]helpers.InputComponent{}
 	for _, i := range clfspec.Inputs {
 		a := adapters.NewInput(i)
-		inputMap[i.Name] = a
+		if tier == framework.CollectorTierAggregator {
+			// inputs are read by the agent tier and routed by name when received by the aggregator tier
+			a.Ids = []string{aggregator.RouteInputID(i)}
+		} else {
+			inputMap[i.Name] = a
+		}
 		inputCompMap[i.Name] = a
 	}
 
 	outputMap := map[string]*adapters.Output{}
 	op[framework.OptionForwarderName] = forwarderName
+	op[framework.OptionForwarderNamespace] = namespace
 	for _, spec := range clfspec.Outputs {
 		o := adapters.NewOutput(spec)
 		outputMap[spec.Name] = o
@@ -89,16 +98,52 @@ func Conf(secrets map[string]*corev1.Secret, clfspec obs.ClusterLogForwarderSpec
 		a := adapters.NewPipeline(i, p, inputCompMap, outputMap, filters, clfspec.Inputs, adapters.AddSystemFilters)
 		pipelineMap[p.Name] = a
 	}
+	adapters.AddDeadLetterInputs(outputMap)
 
+	dataDirName := forwarderName
+	if tier == framework.CollectorTierAggregator {
+		dataDirName = resNames.AggregatorResourceNames().ForwarderName
+	}
 	config = api.NewConfig(func(c *api.Config) {
-		Global(c, namespace, forwarderName)
+		Global(c, namespace, dataDirName)
 		c.Sources[InternalMetricsSourceName] = sources.NewInternalMetrics()
 	})
+	tenantInputNames, _ := utils.GetOption(op, framework.OptionTenantInputs, []string{})
+	tenantInputs := []*adapters.Input{}
 	for _, i := range sortAdapters(inputMap) {
+		if slices.Contains(tenantInputNames, i.Name) {
+			tenantInputs = append(tenantInputs, i)
+			continue
+		}
 		sources, transforms := input.NewSource(i, resNames, secrets, op)
 		config.AddSources(sources)
 		config.AddTransforms(transforms)
 	}
+	if len(tenantInputs) > 0 {
+		var rateLimit *obs.LimitSpec
+		if clfspec.Tenancy != nil {
+			rateLimit = clfspec.Tenancy.RateLimit
+		}
+		sources, transforms := input.NewTenantsSource(tenantInputs, rateLimit)
+		config.AddSources(sources)
+		config.AddTransforms(transforms)
+	}
+	switch tier {
+	case framework.CollectorTierAgent:
+		// the agent tier only forwards to the aggregator tier which applies the pipelines
+		sinkInputs := []string{}
+		for _, i := range sortAdapters(inputMap) {
+			id, transform := aggregator.NewTagInput(i.InputSpec, i.InputIDs()...)
+			config.Transforms[id] = transform
+			sinkInputs = append(sinkInputs, id)
+		}
+		config.Sinks[aggregator.SinkID] = aggregator.NewSink(resNames.AggregatorAddress(namespace), resNames.AggregatorClientSecret, op, sinkInputs...)
+		pipelineMap = map[string]*adapters.Pipeline{}
+		outputMap = map[string]*adapters.Output{}
+	case framework.CollectorTierAggregator:
+		config.Sources[aggregator.SourceID] = aggregator.NewSource(resNames.AggregatorClientCA, op)
+		config.Transforms[aggregator.RouteID] = aggregator.NewRoute(clfspec.Inputs)
+	}
 	for _, p := range sortAdapters(pipelineMap) {
 		config.AddTransforms(p.Transforms())
 	}
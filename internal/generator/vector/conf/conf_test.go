@@ -61,7 +61,7 @@ var _ = Describe("Testing Complete Config Generation", func() {
 		if op == nil {
 			op = clusterOptions
 		}
-		conf := Conf(secrets, spec, constants.OpenshiftNS, "my-forwarder", factory.ForwarderResourceNames{CommonName: constants.CollectorName}, op)
+		conf := Conf(secrets, spec, constants.OpenshiftNS, "my-forwarder", factory.ForwarderResourceNames{CommonName: constants.CollectorName, AggregatorClientSecret: "collector-aggregator-client", AggregatorClientCA: "collector-aggregator-client-ca"}, op)
 		Expect(exp).To(EqualConfigFrom(conf))
 	},
 		Entry("with complex spec",
@@ -175,5 +175,95 @@ var _ = Describe("Testing Complete Config Generation", func() {
 					kafkaOutput,
 				},
 			}),
+		Entry("with the agent tier of a two-tier collector",
+			"agent.toml",
+			framework.Options{
+				framework.ClusterTLSProfileSpec: tls.GetClusterTLSProfileSpec(nil),
+				framework.OptionCollectorTier:   framework.CollectorTierAgent,
+			},
+			obs.ClusterLogForwarderSpec{
+				Inputs: []obs.InputSpec{
+					{
+						Name: "mytestapp",
+						Type: obs.InputTypeApplication,
+						Application: &obs.Application{
+							Includes: []obs.NamespaceContainerSpec{
+								{Namespace: "test-ns"},
+							},
+						},
+					},
+					{
+						Name:  string(obs.InputTypeAudit),
+						Type:  obs.InputTypeAudit,
+						Audit: &obs.Audit{},
+					},
+				},
+				Pipelines: []obs.PipelineSpec{
+					{
+						InputRefs: []string{
+							"mytestapp",
+							string(obs.InputTypeAudit),
+						},
+						OutputRefs: []string{"kafka-receiver"},
+						Name:       "pipeline",
+						FilterRefs: []string{"my-labels"},
+					},
+				},
+				Filters: []obs.FilterSpec{
+					{
+						Name:            "my-labels",
+						Type:            obs.FilterTypeOpenshiftLabels,
+						OpenshiftLabels: map[string]string{"key1": "value1", "key2": "value2"},
+					},
+				},
+				Outputs: []obs.OutputSpec{
+					kafkaOutput,
+				},
+			}),
+		Entry("with the aggregator tier of a two-tier collector",
+			"aggregator.toml",
+			framework.Options{
+				framework.ClusterTLSProfileSpec: tls.GetClusterTLSProfileSpec(nil),
+				framework.OptionCollectorTier:   framework.CollectorTierAggregator,
+			},
+			obs.ClusterLogForwarderSpec{
+				Inputs: []obs.InputSpec{
+					{
+						Name: "mytestapp",
+						Type: obs.InputTypeApplication,
+						Application: &obs.Application{
+							Includes: []obs.NamespaceContainerSpec{
+								{Namespace: "test-ns"},
+							},
+						},
+					},
+					{
+						Name:  string(obs.InputTypeAudit),
+						Type:  obs.InputTypeAudit,
+						Audit: &obs.Audit{},
+					},
+				},
+				Pipelines: []obs.PipelineSpec{
+					{
+						InputRefs: []string{
+							"mytestapp",
+							string(obs.InputTypeAudit),
+						},
+						OutputRefs: []string{"kafka-receiver"},
+						Name:       "pipeline",
+						FilterRefs: []string{"my-labels"},
+					},
+				},
+				Filters: []obs.FilterSpec{
+					{
+						Name:            "my-labels",
+						Type:            obs.FilterTypeOpenshiftLabels,
+						OpenshiftLabels: map[string]string{"key1": "value1", "key2": "value2"},
+					},
+				},
+				Outputs: []obs.OutputSpec{
+					kafkaOutput,
+				},
+			}),
 	)
 })
@@ -2,6 +2,9 @@
 //
 // This is a re-implementation of splunk-audit-exporter in Vector Remap Language.
 //
+// It also 'compiles' the drop rules of an OVN audit filter into a Vector transform dropping
+// the matching OVN ACL audit events.
+//
 // The following features are not (yet) implemented:
 //   - Redactions (TODO)
 //   - Drop duplicate update events (TODO)
@@ -70,4 +70,5 @@ var policyVRLTemplate = template.Must(template.New("policy VRL").Funcs(template.
 	"vsub":         func(v any) string { return fmt.Sprintf("{{%v}}", v) },
 	"matchAny":     matchAny,
 	"matchAnyPath": matchAnyPath,
+	"matchGroup":   matchGroup,
 }).Parse(policyVRLTemplateStr))
@@ -75,4 +75,56 @@ var _ = Describe("KubeAPIAudit filter VRL generation", func() {
 			Expect(vrl).To(ContainSubstring("if omit_managed"))
 		})
 	})
+
+	Context("when sources are set", func() {
+		It("should only apply the policy to the events of the sources", func() {
+			spec := &obs.KubeAPIAudit{Sources: []obs.KubeAPIAuditSource{obs.KubeAPIAuditSourceOAuth}}
+			vrl, err := NewFilter(spec).VRL()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vrl).To(ContainSubstring(`if ._internal.log_source == "openshiftAPI" {`))
+			Expect(vrl).To(ContainSubstring(`audit_source = "oauth"`))
+			Expect(vrl).To(ContainSubstring(`if is_string(.auditID) && is_string(.verb) && includes(["oauth"], audit_source) {`))
+		})
+
+		It("should apply the policy to all events when not set", func() {
+			vrl, err := NewFilter(&obs.KubeAPIAudit{}).VRL()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vrl).NotTo(ContainSubstring("audit_source"))
+			Expect(vrl).To(HavePrefix("if is_string(.auditID) && is_string(.verb) {"))
+		})
+	})
+
+	Context("when OAuth is set", func() {
+		It("should exempt login events from the default rules and omit the decisions", func() {
+			spec := &obs.KubeAPIAudit{OAuth: &obs.OAuthAudit{OmitDecisions: []obs.OAuthLoginDecision{obs.OAuthLoginDecisionAllow}}}
+			vrl, err := NewFilter(spec).VRL()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vrl).To(ContainSubstring(`decision = .annotations."authentication.openshift.io/decision"`))
+			Expect(vrl).To(ContainSubstring(`if includes(["allow"], decision) { .level = "None" }`))
+			Expect(vrl).To(ContainSubstring(`} else if match(username, r'^$|^system:.*') { # System events`))
+		})
+
+		It("should not omit any decision when none is listed", func() {
+			vrl, err := NewFilter(&obs.KubeAPIAudit{OAuth: &obs.OAuthAudit{}}).VRL()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vrl).To(ContainSubstring(`if includes([], decision) { .level = "None" }`))
+		})
+	})
+
+	Context("when a rule has API groups", func() {
+		It("should match wildcard groups and compare other groups", func() {
+			spec := &obs.KubeAPIAudit{
+				Rules: []auditv1.PolicyRule{
+					{
+						Level:     auditv1.LevelMetadata,
+						Resources: []auditv1.GroupResources{{Group: "*.openshift.io"}, {Group: "apps"}},
+					},
+				},
+			}
+			vrl, err := NewFilter(spec).VRL()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vrl).To(ContainSubstring(`match(string(.objectRef.apiGroup) ?? "", r'^(.*\.openshift\.io)$')`))
+			Expect(vrl).To(ContainSubstring(`.objectRef.apiGroup == "apps"`))
+		})
+	})
 })
@@ -0,0 +1,49 @@
+package apiaudit
+
+import (
+	_ "embed"
+	"encoding/json"
+	"strings"
+	"text/template"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+)
+
+type OVNFilter struct {
+	*obs.OVNAudit
+}
+
+func NewOVNFilter(spec *obs.OVNAudit) OVNFilter {
+	return OVNFilter{spec}
+}
+
+func NewOVN(spec *obs.OVNAudit, inputs ...string) *transforms.Remap {
+	vrl, err := NewOVNFilter(spec).VRL()
+	if err != nil {
+		log.Error(err, "bad filter", "ovnAudit", spec)
+		return nil
+	}
+	return transforms.NewRemap(vrl, inputs...)
+}
+
+func (f OVNFilter) VRL() (string, error) {
+	if f.OVNAudit == nil {
+		f.OVNAudit = &obs.OVNAudit{} // Treat missing as empty, nothing is dropped.
+	}
+	w := &strings.Builder{}
+	err := ovnVRLTemplate.Execute(w, f)
+	return w.String(), err
+}
+
+//go:embed ovn.vrl.tmpl
+var ovnVRLTemplateStr string
+
+// This template compiles the drop rules of an OVN audit filter into a vector VRL remap program.
+// The VRL program parses the ACL name, verdict and severity from the message of an OVN ACL audit event
+// and drops the event when it matches any rule.
+var ovnVRLTemplate = template.Must(template.New("ovn VRL").Funcs(template.FuncMap{
+	"json":     func(v any) (string, error) { b, err := json.Marshal(v); return string(b), err },
+	"matchAny": matchAny,
+}).Parse(ovnVRLTemplateStr))
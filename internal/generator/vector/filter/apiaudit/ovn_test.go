@@ -0,0 +1,40 @@
+package apiaudit
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("OVNAudit filter VRL generation", func() {
+	It("should drop the events matching any rule", func() {
+		spec := &obs.OVNAudit{
+			Drop: []obs.OVNAuditRule{
+				{ACLNames: []string{"verify-audit-logging_*"}, Verdicts: []obs.OVNACLVerdict{obs.OVNACLVerdictAllow}},
+				{Severities: []obs.OVNACLSeverity{obs.OVNACLSeverityDebug, obs.OVNACLSeverityInfo}},
+			},
+		}
+		vrl, err := NewOVNFilter(spec).VRL()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vrl).To(HavePrefix(`if ._internal.log_source == "ovn" && is_string(.message) {`))
+		Expect(vrl).To(ContainSubstring(`(match(acl_name, r'^(verify-audit-logging_.*)$') && includes(["allow"], verdict) && true) ||`))
+		Expect(vrl).To(ContainSubstring(`(includes(["debug","info"], severity) && true)`))
+		Expect(vrl).To(ContainSubstring("abort"))
+	})
+
+	It("should match all events with an empty rule", func() {
+		vrl, err := NewOVNFilter(&obs.OVNAudit{Drop: []obs.OVNAuditRule{{}}}).VRL()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vrl).To(ContainSubstring("  if (\n    (true)\n  ) {"))
+	})
+
+	It("should generate a remap transform", func() {
+		Expect(NewOVN(&obs.OVNAudit{}, "b", "a").Inputs).To(Equal([]string{"a", "b"}))
+	})
+
+	It("should not drop any event without rules", func() {
+		vrl, err := NewOVNFilter(nil).VRL()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vrl).To(ContainSubstring("  if (\n    false\n  ) {"))
+	})
+})
@@ -22,6 +22,14 @@ func matchAnyPath(wildcards []string) string {
 	return w.String()
 }
 
+// matchGroup returns a VRL condition matching the API group of an event against a group which can be a wildcard.
+func matchGroup(group string) string {
+	if strings.HasPrefix(group, "*") || strings.HasSuffix(group, "*") {
+		return fmt.Sprintf(`match(string(.objectRef.apiGroup) ?? "", %s)`, matchAny([]string{group}))
+	}
+	return fmt.Sprintf(`.objectRef.apiGroup == %q`, group)
+}
+
 // anyWildcardRegexp returns an anchored regexp matching any of the given wildcards.
 // The suffix is appended to the regex for each wildcard.
 //
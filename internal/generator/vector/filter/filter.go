@@ -38,6 +38,10 @@ func NewInternalFilterMap(filters map[string]*obs.FilterSpec) map[string]*adapte
 			internalFilter.Factory = func(inputs ...string) types.Transform {
 				return apiaudit.New(f.KubeAPIAudit, inputs...)
 			}
+		case obs.FilterTypeOVNAudit:
+			internalFilter.Factory = func(inputs ...string) types.Transform {
+				return apiaudit.NewOVN(f.OVNAudit, inputs...)
+			}
 		case obs.FilterTypeParse:
 			internalFilter.Factory = func(inputs ...string) types.Transform {
 				return parse.New(inputs...)
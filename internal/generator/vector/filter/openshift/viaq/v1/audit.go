@@ -29,6 +29,91 @@ if err == null {
 } else {
   log("could not parse host audit msg. err=" + err, rate_limit_secs: 0)
 }
+`
+	// ParseHostAuditRecords parses the correlated records of a host audit event into the structured "audit.linux" fields.
+	// Values encoded as hex by auditd are decoded and ids are mapped to names when auditd logs enriched records.
+	ParseHostAuditRecords = `
+del(._internal.audit_event_id)
+del(._internal.timestamp_end)
+audit_types = []
+primary = {}
+syscall = {}
+execve = {}
+paths = []
+names = {}
+cwd = null
+proctitle = null
+for_each(split(string(._internal.message) ?? "", "\n")) -> |_index, line| {
+  record = parse_regex(line, r'^type=(?P<type>\S+) msg=audit\([^)]*\):\s*(?P<body>.*)$') ?? {}
+  if is_string(record.type) {
+    parts = split(string!(record.body), r'\x1d', limit: 2)
+    body = string(parts[0]) ?? ""
+    inner = parse_regex(body, r'^(?P<head>.*?)msg=\x27(?P<msg>[^\x27]*)\x27(?P<tail>.*)$') ?? {}
+    if is_string(inner.msg) {
+      body = string!(inner.head) + string!(inner.msg) + " " + string!(inner.tail)
+    }
+    fields = {}
+    for_each(parse_regex_all(body, r'(?P<key>[\w\[\]-]+)=(?:"(?P<quoted>[^"]*)"|(?P<plain>\S*))') ?? []) -> |_i, kv| {
+      value = string(kv.quoted) ?? ""
+      if !is_string(kv.quoted) {
+        value = string(kv.plain) ?? ""
+        if match(string(kv.key) ?? "", r'^(a\d+|acct|comm|cwd|data|exe|key|name|proctitle)$') && match(value, r'^([0-9A-F]{2})+$') {
+          value = replace(decode_base16(value) ?? value, r'\x00', " ")
+        }
+      }
+      fields = set!(fields, [string!(kv.key)], value)
+    }
+    if length(parts) > 1 {
+      for_each(parse_regex_all(string(parts[1]) ?? "", r'(?P<key>\w+)="?(?P<value>[^"\s]*)"?') ?? []) -> |_i, kv| {
+        names = set!(names, [downcase(string!(kv.key))], kv.value)
+      }
+    }
+    record_type = string!(record.type)
+    audit_types = push(audit_types, record_type)
+    if record_type == "SYSCALL" {
+      syscall = object(fields) ?? {}
+    } else if record_type == "EXECVE" {
+      execve = object(fields) ?? {}
+    } else if record_type == "PATH" {
+      paths = push(paths, fields)
+    } else if record_type == "CWD" {
+      cwd = fields.cwd
+    } else if record_type == "PROCTITLE" {
+      proctitle = fields.proctitle
+    } else if record_type != "EOE" && length(primary) == 0 {
+      primary = object(fields) ?? {}
+    }
+  }
+}
+if length(audit_types) > 0 {
+  linux = object(._internal."audit.linux") ?? {}
+  linux.record_types = audit_types
+  if length(syscall) > 0 {
+    primary = syscall
+    if is_string(names.syscall) { syscall.name = names.syscall }
+    linux.syscall = syscall
+  } else if length(primary) > 0 {
+    linux.fields = primary
+  }
+  args = []
+  for_each(execve) -> |key, value| {
+    arg = parse_regex(key, r'^a(?P<index>\d+)$') ?? {}
+    if is_string(arg.index) { args = set!(args, [to_int!(arg.index)], value) }
+  }
+  if length(args) > 0 { linux.execve = {"argc": to_int(execve.argc) ?? length(args), "args": args} }
+  if cwd != null { linux.cwd = cwd }
+  if proctitle != null { linux.proctitle = proctitle }
+  if length(paths) > 0 { linux.paths = paths }
+  user = {}
+  for_each(["auid", "uid", "euid", "suid", "fsuid", "gid", "egid", "sgid", "fsgid"]) -> |_i, key| {
+    id = get(primary, [key]) ?? null
+    if id != null {
+      user = set!(user, [key], compact({"id": id, "name": get(names, [key]) ?? null}))
+    }
+  }
+  if length(user) > 0 { linux.user = user }
+  ._internal."audit.linux" = linux
+}
 `
 	SetK8sAuditLevel       = `.k8s_audit_level = ._internal.structured.level`
 	SetOpenshiftAuditLevel = `.openshift_audit_level = ._internal.structured.level`
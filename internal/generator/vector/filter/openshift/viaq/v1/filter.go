@@ -30,9 +30,16 @@ func New(inputs []string, inputSpecs []obs.InputSpec) types.Transform {
 	vrls = auditOVN(vrls, inputSpecs)
 	vrls = containerSource(vrls, inputSpecs)
 	vrls = journalSource(vrls, inputSpecs)
+	vrls = nodeFileSource(vrls, inputSpecs)
 	vrls = receiverSource(vrls, inputSpecs)
+	vrls = append(vrls, RemoveKubernetesForNonContainerLogs)
+	vrls = httpReceiverSource(vrls, inputSpecs)
+	vrls = otlpReceiverSource(vrls, inputSpecs)
+	vrls = fluentForwardReceiverSource(vrls, inputSpecs)
+	vrls = splunkHECReceiverSource(vrls, inputSpecs)
+	vrls = kafkaSource(vrls, inputSpecs)
+	vrls = eventsSource(vrls, inputSpecs)
 	vrls = append(vrls,
-		RemoveKubernetesForNonContainerLogs,
 		MergeStructuredIntoRoot,
 		`.timestamp = ._internal.timestamp`,
 		`."@timestamp" = ._internal.timestamp`,
@@ -96,9 +103,58 @@ func journalSource(vrls []string, inputs internalobs.Inputs) []string {
 	return vrls
 }
 
+func nodeFileSource(vrls []string, inputs internalobs.Inputs) []string {
+	if inputs.HasNodeFileSource() {
+		vrls = append(vrls, nodeFileLogs())
+	}
+	return vrls
+}
+
 func receiverSource(vrls []string, inputs internalobs.Inputs) []string {
 	if inputs.HasReceiverSource() {
 		vrls = append(vrls, receiverLogs())
 	}
 	return vrls
 }
+
+func httpReceiverSource(vrls []string, inputs internalobs.Inputs) []string {
+	if inputs.HasHTTPLogReceiver() {
+		vrls = append(vrls, logReceiverLogs(obs.ReceiverTypeHTTP))
+	}
+	return vrls
+}
+
+func otlpReceiverSource(vrls []string, inputs internalobs.Inputs) []string {
+	if inputs.HasOTLPReceiver() {
+		vrls = append(vrls, logReceiverLogs(obs.ReceiverTypeOTLP))
+	}
+	return vrls
+}
+
+func fluentForwardReceiverSource(vrls []string, inputs internalobs.Inputs) []string {
+	if inputs.HasReceiverType(obs.ReceiverTypeFluentForward) {
+		vrls = append(vrls, logReceiverLogs(obs.ReceiverTypeFluentForward))
+	}
+	return vrls
+}
+
+func splunkHECReceiverSource(vrls []string, inputs internalobs.Inputs) []string {
+	if inputs.HasReceiverType(obs.ReceiverTypeSplunkHEC) {
+		vrls = append(vrls, logReceiverLogs(obs.ReceiverTypeSplunkHEC))
+	}
+	return vrls
+}
+
+func kafkaSource(vrls []string, inputs internalobs.Inputs) []string {
+	if inputs.HasKafkaSource() {
+		vrls = append(vrls, logReceiverLogs(obs.InputTypeKafka))
+	}
+	return vrls
+}
+
+func eventsSource(vrls []string, inputs internalobs.Inputs) []string {
+	if inputs.HasEventsSource() {
+		vrls = append(vrls, logReceiverLogs(obs.InputTypeEvents))
+	}
+	return vrls
+}
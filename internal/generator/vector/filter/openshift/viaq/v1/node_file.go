@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+// nodeFileLogs moves the message and the path of the log files of the node to the root
+func nodeFileLogs() string {
+	return fmt.Sprintf(`
+if .log_source == "%s" {
+  %s
+}
+`, obs.InfrastructureSourceFile, strings.Join(helpers.TrimSpaces([]string{
+		`.message = ._internal.message`,
+		`.file = ._internal.file`,
+	}), "\n"))
+}
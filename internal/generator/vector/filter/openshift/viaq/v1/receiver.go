@@ -22,3 +22,19 @@ func receiverLogsVRL() string {
 		fmt.Sprintf(`.log_source = "%s"`, obs.InfrastructureSourceNode),
 	}), "\n\n")
 }
+
+// logReceiverLogs moves the records accepted by a receiver or consumed by an input of the given log source to the root.
+// It is applied after the kubernetes metadata of non-container logs is removed to keep the namespace and app attribution
+func logReceiverLogs(logSource interface{}) string {
+	return fmt.Sprintf(`
+if .log_source == "%s" {
+  %s
+}
+`, logSource, strings.Join(helpers.TrimSpaces([]string{
+		`.message = ._internal.message`,
+		`if exists(._internal.kubernetes) { .kubernetes = ._internal.kubernetes }`,
+		`if exists(._internal.structured) { .structured = ._internal.structured }`,
+		`if exists(._internal.trace_id) { .trace_id = ._internal.trace_id }`,
+		`if exists(._internal.span_id) { .span_id = ._internal.span_id }`,
+	}), "\n"))
+}
@@ -2,6 +2,25 @@ package parse
 
 import "github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
 
+// parseVRL parses container messages as JSON unless the pod requested another format
+// using the parser annotation exposed as ._internal.pod_hints.parser
+const parseVRL = `
+if ._internal.log_source == "container" {
+	parser = string(._internal.pod_hints.parser) ?? "json"
+	if parser == "json" {
+		parsed, err = parse_json(._internal.message)
+		if err == null {
+			._internal.structured = parsed
+		}
+	} else if parser == "logfmt" {
+		parsed, err = parse_logfmt(._internal.message)
+		if err == null {
+			._internal.structured = parsed
+		}
+	}
+}
+`
+
 type Filter struct{}
 
 func NewParseFilter() Filter {
@@ -9,23 +28,9 @@ func NewParseFilter() Filter {
 }
 
 func (f Filter) VRL() (string, error) {
-	return `
-	if ._internal.log_source == "container" {
-		parsed, err = parse_json(._internal.message)
-		if err == null {
-			._internal.structured = parsed
-		}
-	}
-	`, nil
+	return parseVRL, nil
 }
 
 func New(inputs ...string) *transforms.Remap {
-	return transforms.NewRemap(`
-	if ._internal.log_source == "container" {
-		parsed, err = parse_json(._internal.message)
-		if err == null {
-			._internal.structured = parsed
-		}
-	}
-`, inputs...)
+	return transforms.NewRemap(parseVRL, inputs...)
 }
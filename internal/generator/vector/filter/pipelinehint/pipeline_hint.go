@@ -0,0 +1,20 @@
+package pipelinehint
+
+import (
+	"fmt"
+
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+)
+
+const (
+	PipelineHint = "pipeline_hint"
+
+	fmtCondition = `!exists(._internal.pod_hints.pipeline) || ._internal.pod_hints.pipeline == %q`
+)
+
+// New returns a filter for a pipeline that drops the logs of pods requesting another pipeline
+// using the pipeline-hint annotation exposed as ._internal.pod_hints.pipeline
+func New(pipelineName string, inputs ...string) types.Transform {
+	return transforms.NewFilter(fmt.Sprintf(fmtCondition, pipelineName), inputs...)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
 	sourcesfile "github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sources"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
 	v1 "github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/openshift/viaq/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
@@ -16,6 +17,18 @@ const (
 	MaxLineBytes          = 3145728
 	MaxReadBytes          = 262144
 	RotateWaitSecs        = 5
+
+	// AuditdCorrelationExpireAfterMs is the time to wait for more records of a host audit event
+	AuditdCorrelationExpireAfterMs = 2000
+
+	// auditdEventID sets the id of the host audit event of a record to correlate the records of an event
+	auditdEventID = `
+record = parse_regex(string(.message) ?? "", r'msg=audit\((?P<id>[^)]+)\):') ?? {}
+.audit_event_id = string(record.id) ?? ""
+`
+	// auditdEventEnds ends the event unless the record is a syscall record followed by more records of the event.
+	// Records of other types, e.g. USER_LOGIN, are events of a single record and PROCTITLE or EOE end a syscall event
+	auditdEventEnds = `.audit_event_id == "" || !match(string(.message) ?? "", r'^type=(SYSCALL|EXECVE|CWD|PATH|SOCKADDR|SOCKETCALL|FD_PAIR|MMAP|IPC|CAPSET|BPRM_FCAPS|OBJ_PID|MQ_[A-Z]+) ')`
 )
 
 func auditIgnoreOlderSecs(input *adapters.Input) int64 {
@@ -28,6 +41,8 @@ func auditIgnoreOlderSecs(input *adapters.Input) int64 {
 func NewAuditAuditdSource(input *adapters.Input) (id string, _ types.Source, tfs api.Transforms) {
 	tfs = api.Transforms{}
 	id = helpers.MakeInputID(input.Name, "host")
+	eventID := helpers.MakeID(id, "event_id")
+	correlateID := helpers.MakeID(id, "correlate")
 	metaID := helpers.MakeID(id, "meta")
 	f := sourcesfile.NewFile("/var/log/audit/audit.log")
 	f.HostKey = "hostname"
@@ -36,12 +51,27 @@ func NewAuditAuditdSource(input *adapters.Input) (id string, _ types.Source, tfs
 	f.MaxLineBytes = MaxLineBytes
 	f.MaxReadBytes = MaxReadBytes
 	f.RotateWaitSecs = RotateWaitSecs
-	tfs.Add(metaID, NewInternalNormalization(obs.AuditSourceAuditd, obs.InputTypeAudit, id, v1.ParseHostAuditLogs))
+	tfs.Add(eventID, transforms.NewRemap(auditdEventID, id))
+	tfs.Add(correlateID, NewAuditdCorrelation(eventID))
+	tfs.Add(metaID, NewInternalNormalization(obs.AuditSourceAuditd, obs.InputTypeAudit, correlateID, v1.ParseHostAuditLogs, v1.ParseHostAuditRecords))
 	input.Ids = append(input.Ids, metaID)
 	return id, f, tfs
 
 }
 
+// NewAuditdCorrelation correlates the records of a host audit event, e.g. SYSCALL, EXECVE, CWD and PATH, into a single
+// event by the id of the event
+func NewAuditdCorrelation(inputs ...string) types.Transform {
+	return transforms.NewReduce(func(r *transforms.Reduce) {
+		r.ExpireAfterMs = AuditdCorrelationExpireAfterMs
+		r.GroupBy = []string{".audit_event_id"}
+		r.EndsWhen = auditdEventEnds
+		r.MergeStrategies = &transforms.MergeStrategies{
+			Message: transforms.MergeStrategiesMessageConcatNewline,
+		}
+	}, inputs...)
+}
+
 func NewK8sAuditSource(input *adapters.Input) (id string, _ types.Source, tfs api.Transforms) {
 	tfs = api.Transforms{}
 	id = helpers.MakeInputID(input.Name, "kube")
@@ -41,13 +41,14 @@ var (
 
 // NewContainerSource generates the source and transforms to support this input and
 // returns an identifier id, source, transforms, and sets the list of ids to use for downstream components.
-func NewContainerSource(spec *adapters.Input, includes, excludes []string, logType obs.InputType, logSource interface{}) (id string, source types.Source, tfs api.Transforms) {
+func NewContainerSource(spec *adapters.Input, includes, excludes []string, logType obs.InputType, logSource interface{}, addVRLs ...string) (id string, source types.Source, tfs api.Transforms) {
 	tfs = api.Transforms{}
 	base := helpers.MakeInputID(spec.Name, "container")
-	var selector *metav1.LabelSelector
+	var selector, nsSelector *metav1.LabelSelector
 	maxMsgSize := int64(0)
 	if spec.Application != nil {
 		selector = spec.Application.Selector
+		nsSelector = spec.Application.NamespaceSelector
 		if spec.Application.Tuning != nil && spec.Application.Tuning.MaxMessageSize != nil {
 			if size, ok := spec.Application.Tuning.MaxMessageSize.AsInt64(); ok {
 				maxMsgSize = size
@@ -72,6 +73,7 @@ func NewContainerSource(spec *adapters.Input, includes, excludes []string, logTy
 		kl.IncludePathsGlobPatterns = includes
 		kl.ExcludePathsGlobPatterns = excludes
 		kl.ExtraLabelSelector = helpers2.LabelSelectorFrom(selector)
+		kl.ExtraNamespaceLabelSelector = helpers2.LabelSelectorFrom(nsSelector)
 		kl.PodAnnotationFields = &sources.PodAnnotationFields{
 			PodLabels:      "kubernetes.labels",
 			PodNamespace:   "kubernetes.namespace_name",
@@ -85,14 +87,20 @@ func NewContainerSource(spec *adapters.Input, includes, excludes []string, logTy
 		kl.RotateWaitSecs = 5
 		kl.UseApiServerCache = true
 	})
-	tfs.Add(metaID, NewInternalNormalization(logSource, logType, base))
+	tfs.Add(metaID, NewInternalNormalization(logSource, logType, base, addVRLs...))
 	id = metaID
 
+	if internalobs.AllowsPodAnnotation(spec.InputSpec, obs.PodAnnotationCollect) {
+		collectID := helpers.MakeID(base, "pod_collect")
+		tfs.Add(collectID, NewPodCollectFilter(id))
+		id = collectID
+	}
+
 	//TODO: DETERMINE IF key field is correct and actually works
 	if threshold, hasPolicy := internalobs.MaxRecordsPerSecond(spec.InputSpec); hasPolicy {
 		throttleID := helpers.MakeID(base, "throttle")
+		tfs.Add(throttleID, AddThrottleToInput(id, threshold))
 		id = throttleID
-		tfs.Add(throttleID, AddThrottleToInput(metaID, threshold))
 	}
 	spec.Ids = append(spec.Ids, id)
 	return base, source, tfs
@@ -0,0 +1,128 @@
+package input
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sinks"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sources"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/codec"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/transport"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+)
+
+const (
+	// eventsEndpoint watches the changes of the events for the duration of a scrape. The source can not resume a watch
+	// from the resourceVersion where the previous one ended, so each watch starts with synthetic ADDED events of all the
+	// existing events and the changes made between two watches are only visible in these
+	eventsEndpoint = "https://kubernetes.default.svc/apis/events.k8s.io/v1/events?watch=true&timeoutSeconds=30"
+	// eventsScrapeIntervalSecs matches the timeout of the watch so the next watch starts as soon as the previous one ends
+	eventsScrapeIntervalSecs = 30
+	// eventsScrapeTimeoutSecs leaves the API server time to end the watch before the request is abandoned
+	eventsScrapeTimeoutSecs = 35
+	// eventsMaxAgeSecs is the age after which the ADDED events of a watch are considered existing events which were
+	// forwarded by a previous watch. It covers the time between two watches
+	eventsMaxAgeSecs = 60
+	// eventsCacheSize is the number of recently forwarded events remembered to deduplicate the ADDED events of a watch
+	// which are younger than eventsMaxAgeSecs and were forwarded by the previous watch
+	eventsCacheSize = 20000
+	// serviceAccountCAKey is the key of the API server CA in a service account token secret
+	serviceAccountCAKey = "ca.crt"
+
+	// eventsWatchVRL keeps the events which are created or updated and drops the deletions and bookmarks of the watch
+	// and the synthetic ADDED events of the existing events older than eventsMaxAgeSecs
+	eventsWatchVRL = `
+if .type == "ADDED" || .type == "MODIFIED" {
+  if .type == "ADDED" {
+    created = parse_timestamp(.object.metadata.creationTimestamp, format: "%%+") ?? now()
+    if to_unix_timestamp(now()) - to_unix_timestamp(created) > %d {
+      abort
+    }
+  }
+  . = {"structured": .object}
+} else {
+  if .type == "ERROR" {
+    log("Unable to watch events: " + (to_string(.object.message) ?? "unknown error"), level: "error")
+  }
+  abort
+}
+`
+
+	// eventsRecordVRL moves the event to the kubernetes metadata of the record and sets the namespace,
+	// message, timestamp and level of the record from the event
+	eventsRecordVRL = `
+event = del(._internal.structured)
+._internal.kubernetes.event = event
+._internal.kubernetes.namespace_name = event.regarding.namespace || event.metadata.namespace
+._internal.message = replace(to_string(event.note) ?? "", "\n", s'\n')
+ts = event.series.lastObservedTime || event.eventTime || event.deprecatedLastTimestamp || event.metadata.creationTimestamp
+._internal.timestamp = parse_timestamp(ts, format: "%+") ?? now()
+if event.type == "Warning" {
+  ._internal.level = "warn"
+} else {
+  ._internal.level = "info"
+}
+`
+)
+
+// eventsDedupeFields identify an event and its updates, which change its resourceVersion
+var eventsDedupeFields = []string{"structured.metadata.uid", "structured.metadata.resourceVersion"}
+
+// NewEventsSource generates a source continuously watching the events of the cluster from the API server and
+// forwards each event when it is created or updated
+func NewEventsSource(input *adapters.Input, op utils.Options) (id string, source types.Source, tfs api.Transforms) {
+	id = helpers.MakeInputID(input.Name)
+	itemsID := helpers.MakeID(id, "items")
+	dedupeID := helpers.MakeID(id, "dedupe")
+	metaID := helpers.MakeID(id, "meta")
+
+	client := sources.NewHttpClient(eventsEndpoint)
+	client.ScrapeIntervalSecs = eventsScrapeIntervalSecs
+	client.ScrapeTimeoutSecs = eventsScrapeTimeoutSecs
+	client.Framing = &sources.Framing{Method: sources.FramingMethodNewlineDelimited}
+	client.Decoding = &sources.Decoding{Codec: codec.CodecTypeJSON}
+	if name, found := utils.GetOption(op, framework.OptionServiceAccountTokenSecretName, ""); found {
+		client.Auth = &sinks.HttpAuth{
+			Strategy: sinks.HttpAuthStrategyBearer,
+			Token: helpers.SecretFrom(&obs.SecretReference{
+				Key:        constants.TokenKey,
+				SecretName: name,
+			}),
+		}
+		client.TLS = &transport.TLS{
+			CAFile: helpers.SecretPath(name, serviceAccountCAKey, "%s"),
+		}
+	}
+
+	tfs = api.Transforms{
+		itemsID: transforms.NewRemap(fmt.Sprintf(eventsWatchVRL, eventsMaxAgeSecs), id),
+	}
+	dedupeInput := itemsID
+	if input.Events != nil && len(input.Events.Types) > 0 {
+		typesID := helpers.MakeID(id, "types")
+		tfs[typesID] = transforms.NewFilter(eventTypesCondition(input.Events.Types), itemsID)
+		dedupeInput = typesID
+	}
+	dedupe := transforms.NewDedupe(eventsDedupeFields, dedupeInput)
+	dedupe.Cache = &transforms.DedupeCache{NumEvents: eventsCacheSize}
+	tfs[dedupeID] = dedupe
+	tfs[metaID] = NewLogReceiverInternalNormalization(obs.InputTypeEvents, string(obs.InputTypeInfrastructure), setEnvelope, dedupeID, eventsRecordVRL)
+	input.Ids = append(input.Ids, metaID)
+	return id, client, tfs
+}
+
+func eventTypesCondition(eventTypes []obs.EventType) string {
+	quoted := []string{}
+	for _, t := range eventTypes {
+		quoted = append(quoted, fmt.Sprintf("%q", t))
+	}
+	return fmt.Sprintf("includes([%s], .structured.type)", strings.Join(quoted, ","))
+}
@@ -98,3 +98,19 @@ func NewReceiverInternalNormalization(logSource interface{}, envelopeVrl, inputs
 	vrls = append(vrls, addVRLs...)
 	return transforms.NewRemap(strings.Join(vrls, "\n"), inputs)
 }
+
+// NewLogReceiverInternalNormalization returns configuration elements to normalize records received by an HTTP receiver
+// in the json, ndjson or otlp format, by an OTLP, fluentForward or splunkHEC receiver or consumed by a kafka input
+// to an internal, common data model
+func NewLogReceiverInternalNormalization(logSource interface{}, logType, envelopeVrl, inputs string, addVRLs ...string) types.Transform {
+	vrls := []string{
+		envelopeVrl,
+		fmt.Sprintf(fmtLogSource, logSource),
+		fmt.Sprintf(fmtLogType, logType),
+		setHostName,
+		setClusterID,
+		setOpenshiftSequence,
+	}
+	vrls = append(vrls, addVRLs...)
+	return transforms.NewRemap(strings.Join(vrls, "\n"), inputs)
+}
@@ -1,10 +1,15 @@
 package input
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sources"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
 	v1 "github.com/openshift/cluster-logging-operator/internal/generator/vector/filter/openshift/viaq/v1"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
@@ -12,10 +17,20 @@ import (
 
 func NewJournalInput(input *adapters.Input) (id string, source types.Source, tfs api.Transforms) {
 	tfs = api.Transforms{}
-	source = sources.NewJournalD()
+	journald := sources.NewJournalD()
 	id = helpers.MakeInputID(input.Name, "journal")
+	metaInputID := id
+	if input.Infrastructure != nil && input.Infrastructure.Tuning != nil && input.Infrastructure.Tuning.Journal != nil {
+		tuning := *input.Infrastructure.Tuning.Journal
+		applyJournalTuning(&journald, tuning)
+		if condition := journalMatchesCondition(tuning); condition != "" {
+			metaInputID = helpers.MakeID(id, "matches")
+			tfs.Add(metaInputID, transforms.NewFilter(condition, id))
+		}
+	}
+	source = journald
 	metaID := helpers.MakeID(id, "meta")
-	tfs.Add(metaID, NewJournalInternalNormalization(obs.InfrastructureSourceNode, setEnvelope, id,
+	tfs.Add(metaID, NewJournalInternalNormalization(obs.InfrastructureSourceNode, setEnvelope, metaInputID,
 		v1.FixJournalLogLevel,
 		v1.SetJournalMessage,
 		v1.SystemK,
@@ -25,3 +40,55 @@ func NewJournalInput(input *adapters.Input) (id string, source types.Source, tfs
 	input.Ids = append(input.Ids, metaID)
 	return id, source, tfs
 }
+
+// applyJournalTuning filters the journal entries of the units when they are read by the source, along with their
+// priority when it is the only field to match
+func applyJournalTuning(journald *sources.Journald, tuning obs.JournalInputTuningSpec) {
+	journald.IncludeUnits = tuning.IncludeUnits
+	journald.ExcludeUnits = tuning.ExcludeUnits
+	journald.SinceNow = tuning.Since == obs.JournalSinceNow
+	if matchPriorityAtSource(tuning) {
+		journald.IncludeMatches = map[string][]string{
+			"PRIORITY": journalPriorities(*tuning.Priority),
+		}
+	}
+}
+
+// matchPriorityAtSource is true when the priority is the only field of the entries to match. The source can not filter
+// the priority otherwise because its matches are ORed across fields and with the included units
+func matchPriorityAtSource(tuning obs.JournalInputTuningSpec) bool {
+	return tuning.Priority != nil && len(tuning.IncludeMatches) == 0 && len(tuning.IncludeUnits) == 0
+}
+
+// journalMatchesCondition returns the condition of the entries matching every field of the include matches and the
+// priority when it is not matched by the source
+func journalMatchesCondition(tuning obs.JournalInputTuningSpec) string {
+	conditions := []string{}
+	if tuning.Priority != nil && !matchPriorityAtSource(tuning) {
+		conditions = append(conditions, journalFieldCondition("PRIORITY", journalPriorities(*tuning.Priority)))
+	}
+	for _, match := range tuning.IncludeMatches {
+		conditions = append(conditions, journalFieldCondition(match.Field, match.Values))
+	}
+	return strings.Join(conditions, " && ")
+}
+
+// journalPriorities returns the values of the minimum priority and all higher priorities, which have lower values
+func journalPriorities(minimum obs.JournalPriority) []string {
+	priorities := []string{}
+	for value, priority := range obs.JournalPriorities {
+		priorities = append(priorities, strconv.Itoa(value))
+		if priority == minimum {
+			break
+		}
+	}
+	return priorities
+}
+
+func journalFieldCondition(field string, values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = strconv.Quote(value)
+	}
+	return fmt.Sprintf("includes([%s], .%s)", strings.Join(quoted, ", "), field)
+}
@@ -0,0 +1,145 @@
+package input
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sources"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/codec"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/transport"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/common/tls"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+)
+
+const (
+	kafkaSASLMechanismPlain = "PLAIN"
+
+	// kafkaRecordVRL moves the message, timestamp and level of consumed messages alongside their remaining structured
+	// content and keeps the topic, partition and offset of the messages
+	kafkaRecordVRL = `
+._internal.timestamp = del(._internal.structured.timestamp) || now()
+._internal.message = del(._internal.structured.message)
+if exists(._internal.structured.level) {
+  ._internal.level = del(._internal.structured.level)
+}
+._internal.structured.kafka = {
+  "topic": del(._internal.structured.topic),
+  "partition": del(._internal.structured.partition),
+  "offset": del(._internal.structured.offset)
+}
+del(._internal.structured.message_key)
+del(._internal.structured.headers)
+del(._internal.structured.source_type)
+`
+)
+
+// NewKafkaSource generates a kafka source consuming the topics of the input as a member of its consumer group.
+// Offsets are only committed once messages are accepted by the outputs when any of them delivers logs at least once
+func NewKafkaSource(input *adapters.Input, resNames factory.ForwarderResourceNames, secrets observability.Secrets, op utils.Options) (id string, source types.Source, tfs api.Transforms) {
+	id = helpers.MakeInputID(input.Name)
+	metaID := helpers.MakeID(id, "meta")
+	spec := input.Kafka
+
+	kafka := sources.NewKafka(kafkaBrokers(spec.Brokers), kafkaTopics(spec), kafkaGroupID(input.InputSpec, resNames, op))
+	kafka.Decoding = &sources.Decoding{Codec: codec.CodecTypeBytes}
+	if spec.Decoding == obs.KafkaInputDecodingJSON {
+		kafka.Decoding.Codec = codec.CodecTypeJSON
+	}
+	if deliversAtLeastOnce(input.InputSpec, op) {
+		kafka.Acknowledgements = &sources.Acknowledgements{Enabled: true}
+	}
+	kafka.Sasl = kafkaSasl(spec.Authentication)
+	kafka.TLS = tls.NewTlsEnabled(input, secrets, op, framework.Option{Name: tls.ExcludeInsecureSkipVerify, Value: ""})
+	if kafka.TLS == nil && isTLSBrokers(spec.Brokers) {
+		kafka.TLS = &transport.TlsEnabled{Enabled: true}
+	}
+	if input.IsInsecureSkipVerify() {
+		kafka.LibrdKafka_Options = map[string]string{
+			"enable.ssl.certificate.verification": "false",
+		}
+	}
+
+	tfs = api.Transforms{
+		metaID: NewLogReceiverInternalNormalization(obs.InputTypeKafka, logType(spec.LogType), setEnvelopeToStructured, id, kafkaRecordVRL),
+	}
+	input.Ids = append(input.Ids, metaID)
+	return id, kafka, tfs
+}
+
+// kafkaBrokers returns the hosts of the broker URLs
+func kafkaBrokers(brokers []obs.BrokerURL) string {
+	hosts := []string{}
+	for _, b := range brokers {
+		if u, _ := url.Parse(string(b)); u != nil {
+			hosts = append(hosts, u.Host)
+		}
+	}
+	return strings.Join(hosts, ",")
+}
+
+// kafkaTopics returns the topics of the input or the topic pattern as a regular expression anchored to the start
+// of the topic names, which is how the source identifies patterns
+func kafkaTopics(spec *obs.KafkaInput) []string {
+	if spec.TopicPattern == "" {
+		return spec.Topics
+	}
+	if strings.HasPrefix(spec.TopicPattern, "^") {
+		return []string{spec.TopicPattern}
+	}
+	return []string{"^" + spec.TopicPattern}
+}
+
+// kafkaGroupID returns the consumer group of the input which defaults to a group unique to the input of the forwarder
+// in its namespace, so forwarders of the same name in different namespaces do not share the messages of the topics
+func kafkaGroupID(input obs.InputSpec, resNames factory.ForwarderResourceNames, op utils.Options) string {
+	if input.Kafka.GroupID != "" {
+		return input.Kafka.GroupID
+	}
+	namespace, _ := utils.GetOption(op, framework.OptionForwarderNamespace, "")
+	return fmt.Sprintf("%s-%s-%s", namespace, resNames.ForwarderName, input.Name)
+}
+
+func kafkaSasl(spec *obs.KafkaAuthentication) *sources.Sasl {
+	if spec == nil || spec.SASL == nil || spec.SASL.Username == nil || spec.SASL.Password == nil {
+		return nil
+	}
+	sasl := &sources.Sasl{
+		Enabled:   true,
+		Username:  helpers.SecretFrom(spec.SASL.Username),
+		Password:  helpers.SecretFrom(spec.SASL.Password),
+		Mechanism: kafkaSASLMechanismPlain,
+	}
+	if spec.SASL.Mechanism != "" {
+		sasl.Mechanism = spec.SASL.Mechanism
+	}
+	return sasl
+}
+
+func isTLSBrokers(brokers []obs.BrokerURL) bool {
+	for _, b := range brokers {
+		if !strings.HasPrefix(string(b), "tls:") {
+			return false
+		}
+	}
+	return len(brokers) > 0
+}
+
+// deliversAtLeastOnce returns true when any output consuming the input delivers logs at least once
+func deliversAtLeastOnce(input obs.InputSpec, op utils.Options) bool {
+	clfSpec, _ := utils.GetOption(op, helpers.CLFSpec, observability.ClusterLogForwarderSpec{})
+	for _, out := range clfSpec.OutputSpecsFrom(input) {
+		if observability.NewTuning(out).DeliveryMode == obs.DeliveryModeAtLeastOnce {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package input
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sources"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+)
+
+const (
+	MultilineTimeoutMs = 1000
+)
+
+var (
+	multilineModes = map[obs.NodeFileMultilineMode]sources.MultilineMode{
+		obs.NodeFileMultilineModeContinueThrough: sources.MultilineModeContinueThrough,
+		obs.NodeFileMultilineModeContinuePast:    sources.MultilineModeContinuePast,
+		obs.NodeFileMultilineModeHaltBefore:      sources.MultilineModeHaltBefore,
+		obs.NodeFileMultilineModeHaltWith:        sources.MultilineModeHaltWith,
+	}
+	fingerprintStrategies = map[obs.NodeFileFingerprintStrategy]sources.FingerprintStrategy{
+		obs.NodeFileFingerprintStrategyChecksum:       sources.FingerprintStrategyChecksum,
+		obs.NodeFileFingerprintStrategyDeviceAndInode: sources.FingerprintStrategyDeviceAndInode,
+	}
+)
+
+// NewNodeFileSource generates a file source to collect the log files of the node defined by an infrastructure input
+func NewNodeFileSource(input *adapters.Input) (id string, _ types.Source, tfs api.Transforms) {
+	tfs = api.Transforms{}
+	id = helpers.MakeInputID(input.Name, "file")
+	metaID := helpers.MakeID(id, "meta")
+	spec := input.Infrastructure.Files
+	f := sources.NewFile(spec.Includes...)
+	f.Exclude = spec.Excludes
+	f.HostKey = "hostname"
+	f.GlobalMinimumCooldownMilliSeconds = GlobalMinimumCooldown
+	f.MaxLineBytes = MaxLineBytes
+	f.MaxReadBytes = MaxReadBytes
+	f.RotateWaitSecs = RotateWaitSecs
+	if ml := spec.Multiline; ml != nil {
+		f.Multiline = &sources.FileMultiline{
+			StartPattern:     ml.StartPattern,
+			ConditionPattern: ml.ConditionPattern,
+			Mode:             sources.MultilineModeContinueThrough,
+			TimeoutMs:        MultilineTimeoutMs,
+		}
+		if mode, found := multilineModes[ml.Mode]; found {
+			f.Multiline.Mode = mode
+		}
+		if ml.Timeout != nil {
+			f.Multiline.TimeoutMs = ml.Timeout.Milliseconds()
+		}
+	}
+	if fp := spec.Fingerprint; fp != nil {
+		f.Fingerprint = &sources.FileFingerprint{
+			Strategy: sources.FingerprintStrategyChecksum,
+		}
+		if strategy, found := fingerprintStrategies[fp.Strategy]; found {
+			f.Fingerprint.Strategy = strategy
+		}
+		if f.Fingerprint.Strategy == sources.FingerprintStrategyChecksum {
+			f.Fingerprint.Lines = fp.Lines
+			f.Fingerprint.IgnoredHeaderBytes = fp.IgnoredHeaderBytes
+		}
+	}
+	tfs.Add(metaID, NewInternalNormalization(obs.InfrastructureSourceFile, obs.InputTypeInfrastructure, id))
+	input.Ids = append(input.Ids, metaID)
+	return id, f, tfs
+}
@@ -0,0 +1,51 @@
+package input
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+)
+
+const (
+	fmtPodHint = `
+%[1]s, err = string(._internal.kubernetes.annotations.%[2]q)
+if err == null {
+  ._internal.pod_hints.%[1]s = %[1]s
+}
+`
+	fmtPodHintPipeline = `
+pipeline, err = string(._internal.kubernetes.annotations.%q)
+if err == null && includes([%s], pipeline) {
+  ._internal.pod_hints.pipeline = pipeline
+}
+`
+	podHintCollectCondition = `._internal.pod_hints.collect != "false"`
+)
+
+// PodHintsVRL returns the VRL to expose the pod annotations allowed by an application input as hints
+// for downstream components. A pipeline hint is only honored when it names one of the given pipelines
+func PodHintsVRL(input obs.InputSpec, pipelines []string) (vrls []string) {
+	if internalobs.AllowsPodAnnotation(input, obs.PodAnnotationCollect) {
+		vrls = append(vrls, fmt.Sprintf(fmtPodHint, "collect", obs.PodAnnotationCollect))
+	}
+	if internalobs.AllowsPodAnnotation(input, obs.PodAnnotationParser) {
+		vrls = append(vrls, fmt.Sprintf(fmtPodHint, "parser", obs.PodAnnotationParser))
+	}
+	if internalobs.AllowsPodAnnotation(input, obs.PodAnnotationPipelineHint) && len(pipelines) > 0 {
+		names := make([]string, 0, len(pipelines))
+		for _, name := range pipelines {
+			names = append(names, fmt.Sprintf("%q", name))
+		}
+		vrls = append(vrls, fmt.Sprintf(fmtPodHintPipeline, obs.PodAnnotationPipelineHint, strings.Join(names, ",")))
+	}
+	return vrls
+}
+
+// NewPodCollectFilter drops the logs of pods that opted out of collection
+func NewPodCollectFilter(inputs ...string) types.Transform {
+	return transforms.NewFilter(podHintCollectCondition, inputs...)
+}
@@ -2,6 +2,8 @@ package input
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/api/observability"
@@ -17,6 +19,211 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/utils"
 )
 
+const (
+	otlpLogsPath = "/v1/logs"
+	// otlpLogsOutput is the named output of the opentelemetry source emitting log events
+	otlpLogsOutput = "logs"
+
+	// otlpReceiverRecordsVRL flattens the log records of the OTLP payloads received by the opentelemetry source into
+	// events with the resources, attributes, body and timestamp of each record. The original resource, scope and log
+	// record are kept in the otlp field to forward them untouched to OTLP outputs
+	otlpReceiverRecordsVRL = `
+records = []
+for_each(array(.resourceLogs) ?? []) -> |_resource_index, resource_logs| {
+  resources = {}
+  for_each(array(resource_logs.resource.attributes) ?? []) -> |_attribute_index, attribute| {
+    value = values(object(attribute.value) ?? {})
+    resources = set!(resources, [to_string(attribute.key) ?? ""], value[0])
+  }
+  for_each(array(resource_logs.scopeLogs) ?? []) -> |_scope_index, scope_logs| {
+    for_each(array(scope_logs.logRecords) ?? []) -> |_record_index, log_record| {
+      attributes = {}
+      for_each(array(log_record.attributes) ?? []) -> |_attribute_index, attribute| {
+        value = values(object(attribute.value) ?? {})
+        attributes = set!(attributes, [to_string(attribute.key) ?? ""], value[0])
+      }
+      body = values(object(log_record.body) ?? {})
+      nanos = to_int(log_record.timeUnixNano) ?? 0
+      if nanos == 0 {
+        nanos = to_int(log_record.observedTimeUnixNano) ?? 0
+      }
+      timestamp = now()
+      if nanos > 0 {
+        timestamp = from_unix_timestamp(nanos, unit: "nanoseconds")
+      }
+      scope_log = object(scope_logs) ?? {}
+      scope_log.logRecords = [log_record]
+      resource_log = object(resource_logs) ?? {}
+      resource_log.scopeLogs = [scope_log]
+      records = push(records, {
+        "resources": resources,
+        "attributes": attributes,
+        "message": body[0],
+        "severity_text": log_record.severityText,
+        "timestamp": timestamp,
+        "otlp": resource_log
+      })
+    }
+  }
+}
+. = records
+`
+
+	// otlpResourceVRL maps the resource attributes of OTLP log records to the ViaQ data model
+	otlpResourceVRL = `
+if exists(._internal.message) && !is_string(._internal.message) {
+  ._internal.message = encode_json(._internal.message)
+}
+resources = object(._internal.resources) ?? {}
+if exists(resources."k8s.namespace.name") {
+  ._internal.kubernetes.namespace_name = resources."k8s.namespace.name"
+}
+if exists(resources."k8s.pod.name") {
+  ._internal.kubernetes.pod_name = resources."k8s.pod.name"
+}
+if exists(resources."k8s.pod.uid") {
+  ._internal.kubernetes.pod_id = resources."k8s.pod.uid"
+}
+if exists(resources."k8s.container.name") {
+  ._internal.kubernetes.container_name = resources."k8s.container.name"
+}
+if exists(resources."service.name") {
+  ._internal.kubernetes.labels."app.kubernetes.io/name" = resources."service.name"
+}
+if is_string(._internal.severity_text) {
+  ._internal.level = downcase(string!(._internal.severity_text))
+}
+if !is_empty(object(._internal.attributes) ?? {}) {
+  ._internal.structured = ._internal.attributes
+}
+`
+
+	// httpServerAuthTmpl accepts the authorization header of a request when it presents the token with the case
+	// insensitive bearer scheme
+	httpServerAuthTmpl = `
+credentials = split(string(.headers.authorization) ?? "", " ", limit: 2)
+downcase(string(credentials[0]) ?? "") == "bearer" && sha2(string(credentials[1]) ?? "") == sha2("%s")
+`
+
+	attributionTmpl = `
+value = %s
+if value != null {
+  ._internal.%s = value
+}`
+
+	// recordsTmpl parses the timestamp of a record as an RFC 3339 string and uses the time it is received otherwise
+	recordsTmpl = `
+. = {"structured": .}
+.message = del(.structured.message)
+.timestamp = parse_timestamp(del(.structured.timestamp), format: "%%+") ?? now()
+if exists(.structured.level) {
+  .level = del(.structured.level)
+}
+%s
+`
+
+	fluentRecordVRL = `
+._internal.timestamp = del(._internal.structured.timestamp) || now()
+._internal.structured.fluent_tag = del(._internal.structured.tag)
+del(._internal.structured.host)
+._internal.message = del(._internal.structured.message) || del(._internal.structured.log)
+if exists(._internal.structured.level) {
+  ._internal.level = del(._internal.structured.level)
+}
+`
+
+	fluentTagTmpl = `
+tag, err = parse_regex(string(._internal.structured.fluent_tag) ?? "", r'%s')
+if err == null {
+  for_each(tag) -> |key, value| {
+    if key == "namespace" {
+      ._internal.kubernetes.namespace_name = value
+    } else if key == "pod" {
+      ._internal.kubernetes.pod_name = value
+    } else if key == "container" {
+      ._internal.kubernetes.container_name = value
+    } else if key == "app" {
+      ._internal.kubernetes.labels."app.kubernetes.io/name" = value
+    } else {
+      ._internal.structured = set!(._internal.structured, [key], value)
+    }
+  }
+}
+`
+
+	// splunkHECRecordVRL moves the message and timestamp of HEC events alongside their remaining structured content and
+	// keeps the index, source and sourcetype of the events for splunk outputs
+	splunkHECRecordVRL = `
+._internal.timestamp = del(._internal.structured.timestamp) || now()
+._internal.message = del(._internal.structured.message)
+del(._internal.structured.splunk_channel)
+if exists(._internal.structured.splunk_index) {
+  ._internal.splunk.index = del(._internal.structured.splunk_index)
+}
+if exists(._internal.structured.splunk_source) {
+  ._internal.splunk.source = del(._internal.structured.splunk_source)
+}
+if exists(._internal.structured.splunk_sourcetype) {
+  ._internal.splunk.sourcetype = del(._internal.structured.splunk_sourcetype)
+}
+if exists(._internal.structured.level) {
+  ._internal.level = del(._internal.structured.level)
+}
+`
+
+	otlpRecordsTmpl = `
+records = []
+for_each(array(.resourceLogs) ?? []) -> |_resource_index, resource_logs| {
+  resource = {}
+  for_each(array(resource_logs.resource.attributes) ?? []) -> |_attribute_index, attribute| {
+    value = values(object(attribute.value) ?? {})
+    resource = set!(resource, [to_string(attribute.key) ?? ""], value[0])
+  }
+  for_each(array(resource_logs.scopeLogs) ?? []) -> |_scope_index, scope_logs| {
+    for_each(array(scope_logs.logRecords) ?? []) -> |_record_index, log_record| {
+      attributes = {}
+      for_each(array(log_record.attributes) ?? []) -> |_attribute_index, attribute| {
+        value = values(object(attribute.value) ?? {})
+        attributes = set!(attributes, [to_string(attribute.key) ?? ""], value[0])
+      }
+      body = values(object(log_record.body) ?? {})
+      message = body[0]
+      if message != null && !is_string(message) {
+        message = encode_json(message)
+      }
+      nanos = to_int(log_record.timeUnixNano) ?? 0
+      if nanos == 0 {
+        nanos = to_int(log_record.observedTimeUnixNano) ?? 0
+      }
+      timestamp = now()
+      if nanos > 0 {
+        timestamp = from_unix_timestamp(nanos, unit: "nanoseconds")
+      }
+      record = {"structured": attributes, "message": message, "timestamp": timestamp}
+      if is_string(log_record.severityText) {
+        record.level = downcase(string!(log_record.severityText))
+      }
+      if is_string(log_record.traceId) {
+        record.trace_id = log_record.traceId
+      }
+      if is_string(log_record.spanId) {
+        record.span_id = log_record.spanId
+      }
+      if exists(resource."k8s.namespace.name") {
+        record.kubernetes.namespace_name = resource."k8s.namespace.name"
+      }
+      if exists(resource."service.name") {
+        record.kubernetes.labels."app.kubernetes.io/name" = resource."service.name"
+      }
+%s
+      records = push(records, record)
+    }
+  }
+}
+. = records
+`
+)
+
 func NewViaqReceiverSource(spec *adapters.Input, resNames factory.ForwarderResourceNames, secrets observability.Secrets, op utils.Options) (id string, source types.Source, tfs api.Transforms) {
 	tfs = api.Transforms{}
 	base := helpers.MakeInputID(spec.Name)
@@ -32,13 +239,73 @@ func NewViaqReceiverSource(spec *adapters.Input, resNames factory.ForwarderResou
 		return base, server, tfs
 	case obs.ReceiverTypeHTTP:
 		itemsID := helpers.MakeID(base, "items")
-		tfs[itemsID] = newItemsTransform(base, base)
 		server := sources.NewHttpServer(helpers.ListenOnAllLocalInterfacesAddress(), spec.Receiver.Port)
 		server.TLS = serverTls
 		server.Decoding = &sources.Decoding{
 			Codec: codec.CodecTypeJSON,
 		}
-		tfs[metaID] = NewAuditInternalNormalization(obs.AuditSourceKube, itemsID, false)
+		server.Auth = newHttpServerAuth(spec.Receiver.HTTP.Authentication)
+		if spec.Receiver.HTTP.Format == obs.HTTPReceiverFormatKubeAPIAudit {
+			tfs[itemsID] = newItemsTransform(base, base)
+			tfs[metaID] = NewAuditInternalNormalization(obs.AuditSourceKube, itemsID, false)
+			spec.Ids = append(spec.Ids, metaID)
+			return base, server, tfs
+		}
+		receiver := spec.Receiver.HTTP
+		server.Headers = attributionHeaders(receiver)
+		switch receiver.Format {
+		case obs.HTTPReceiverFormatNDJSON:
+			server.Framing = &sources.Framing{
+				Method: sources.FramingMethodNewlineDelimited,
+			}
+			tfs[itemsID] = newRecordsTransform(server.Headers, base)
+		case obs.HTTPReceiverFormatOTLP:
+			server.Path = otlpLogsPath
+			tfs[itemsID] = newOTLPRecordsTransform(server.Headers, base)
+		default:
+			tfs[itemsID] = newRecordsTransform(server.Headers, base)
+		}
+		tfs[metaID] = NewLogReceiverInternalNormalization(obs.ReceiverTypeHTTP, logType(receiver.LogType), setEnvelope, itemsID, attributionVRLs(receiver)...)
+		spec.Ids = append(spec.Ids, metaID)
+		return base, server, tfs
+	case obs.ReceiverTypeOTLP:
+		server := sources.NewOpenTelemetry(helpers.ListenOnAllLocalInterfacesAddress(), spec.Receiver.OTLP.GRPCPort, spec.Receiver.Port)
+		server.GRPC.TLS = serverTls
+		server.HTTP.TLS = serverTls
+		server.UseOTLPDecoding = true
+		recordsID := helpers.MakeID(base, "records")
+		tfs[recordsID] = transforms.NewRemap(otlpReceiverRecordsVRL, helpers.MakeRouteInputID(base, otlpLogsOutput))
+		tfs[metaID] = NewLogReceiverInternalNormalization(obs.ReceiverTypeOTLP, logType(spec.Receiver.OTLP.LogType), setEnvelope, recordsID, otlpResourceVRL)
+		spec.Ids = append(spec.Ids, metaID)
+		return base, server, tfs
+	case obs.ReceiverTypeFluentForward:
+		server := sources.NewFluent(helpers.ListenOnAllLocalInterfacesAddress(), spec.Receiver.Port)
+		server.TLS = serverTls
+		if serverTls != nil && serverTls.CAFile != "" {
+			// clients must present a certificate signed by the CA of the receiver
+			server.TLS.VerifyCertificate = utils.GetPtr(true)
+		}
+		receiver := spec.Receiver.FluentForward
+		if receiver == nil {
+			receiver = &obs.FluentForwardReceiver{}
+		}
+		if receiver.SharedKey != nil {
+			server.SharedKey = helpers.SecretFrom(receiver.SharedKey)
+		}
+		tfs[metaID] = NewLogReceiverInternalNormalization(obs.ReceiverTypeFluentForward, logType(receiver.LogType), setEnvelopeToStructured, base, fluentRecordVRLs(receiver.TagPattern)...)
+		spec.Ids = append(spec.Ids, metaID)
+		return base, server, tfs
+	case obs.ReceiverTypeSplunkHEC:
+		server := sources.NewSplunkHec(helpers.ListenOnAllLocalInterfacesAddress(), spec.Receiver.Port)
+		server.TLS = serverTls
+		receiver := spec.Receiver.SplunkHEC
+		for _, token := range receiver.Tokens {
+			server.ValidTokens = append(server.ValidTokens, helpers.SecretFrom(token))
+		}
+		if receiver.Acknowledgements {
+			server.Acknowledgements = &sources.Acknowledgements{Enabled: true}
+		}
+		tfs[metaID] = NewLogReceiverInternalNormalization(obs.ReceiverTypeSplunkHEC, logType(receiver.LogType), setEnvelopeToStructured, base, splunkHECRecordVRL)
 		spec.Ids = append(spec.Ids, metaID)
 		return base, server, tfs
 	default:
@@ -46,6 +313,16 @@ func NewViaqReceiverSource(spec *adapters.Input, resNames factory.ForwarderResou
 	}
 }
 
+// fluentRecordVRLs moves the message, timestamp and tag of fluent records alongside their remaining structured content
+// and sets the fields of the record from the named capture groups of the tag pattern
+func fluentRecordVRLs(tagPattern string) []string {
+	vrls := []string{fluentRecordVRL}
+	if tagPattern != "" {
+		vrls = append(vrls, fmt.Sprintf(fluentTagTmpl, tagPattern))
+	}
+	return vrls
+}
+
 func newItemsTransform(id, inputs string) types.Transform {
 	return transforms.NewRemap(`
 if exists(.items) {
@@ -59,3 +336,75 @@ if exists(.items) {
 }
 `, inputs)
 }
+
+// newHttpServerAuth requires senders to present the bearer token of the spec. The http_server source only supports
+// basic auth besides a VRL condition, which can not compare strings in constant time, so the digests of the tokens are
+// compared instead of the tokens to not expose the expected token through the time of the comparison
+func newHttpServerAuth(spec *obs.HTTPReceiverAuthentication) *sources.HttpServerAuth {
+	if spec == nil || spec.Token == nil {
+		return nil
+	}
+	return &sources.HttpServerAuth{
+		Strategy: sources.HttpServerAuthStrategyCustom,
+		Source:   strings.TrimSpace(fmt.Sprintf(httpServerAuthTmpl, helpers.SecretFrom(spec.Token))),
+	}
+}
+
+func logType(logType string) string {
+	if logType == "" {
+		return string(obs.InputTypeApplication)
+	}
+	return logType
+}
+
+// attributionHeaders returns the request headers added to each record to attribute it to a namespace or an application
+func attributionHeaders(spec *obs.HTTPReceiver) (headers []string) {
+	for _, a := range []*obs.HTTPReceiverAttribution{spec.Namespace, spec.App} {
+		if a != nil && a.Header != "" {
+			headers = append(headers, a.Header)
+		}
+	}
+	return headers
+}
+
+// attributionVRLs sets the namespace and application of a record from a request header or a field of the record
+func attributionVRLs(spec *obs.HTTPReceiver) (vrls []string) {
+	for path, a := range map[string]*obs.HTTPReceiverAttribution{
+		"kubernetes.namespace_name":                  spec.Namespace,
+		`kubernetes.labels."app.kubernetes.io/name"`: spec.App,
+	} {
+		if a == nil {
+			continue
+		}
+		value := fmt.Sprintf("._internal.structured%s", a.Field)
+		if a.Header != "" {
+			value = fmt.Sprintf("del(._internal.%q)", a.Header)
+		}
+		vrls = append(vrls, fmt.Sprintf(attributionTmpl, value, path))
+	}
+	sort.Strings(vrls)
+	return vrls
+}
+
+// headerVRLs moves the request headers added to the root of a record by the source to the given path
+func headerVRLs(headers []string, from, to string) string {
+	vrls := []string{}
+	for _, h := range headers {
+		vrls = append(vrls, fmt.Sprintf("%s.%q = del(%s.%q)", to, h, from, h))
+	}
+	return strings.Join(vrls, "\n")
+}
+
+// newRecordsTransform moves the message, timestamp and level of json records alongside their remaining structured content
+func newRecordsTransform(headers []string, inputs string) types.Transform {
+	return transforms.NewRemap(fmt.Sprintf(recordsTmpl, headerVRLs(headers, ".structured", "")), inputs)
+}
+
+// newOTLPRecordsTransform flattens the log records of an OTLP/HTTP JSON request
+func newOTLPRecordsTransform(headers []string, inputs string) types.Transform {
+	copies := []string{}
+	for _, h := range headers {
+		copies = append(copies, fmt.Sprintf("      record.%q = .%q", h, h))
+	}
+	return transforms.NewRemap(fmt.Sprintf(otlpRecordsTmpl, strings.Join(copies, "\n")), inputs)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	vectorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
 	"k8s.io/utils/set"
 )
@@ -64,7 +65,9 @@ func NewSource(input *adapters.Input, resNames factory.ForwarderResourceNames, s
 		eb.AddExtensions(excludeExtensions...)
 		includes := ib.Build()
 		excludes := eb.Build(infraNamespaces...)
-		sourceId, source, ctfs := NewContainerSource(input, includes, excludes, obs.InputTypeApplication, obs.InfrastructureSourceContainer)
+		clfSpec, _ := utils.GetOption(op, vectorhelpers.CLFSpec, internalobs.ClusterLogForwarderSpec{})
+		podHints := PodHintsVRL(input.InputSpec, clfSpec.PipelineNamesFrom(input.InputSpec))
+		sourceId, source, ctfs := NewContainerSource(input, includes, excludes, obs.InputTypeApplication, obs.InfrastructureSourceContainer, podHints...)
 		inputSources.Add(sourceId, source)
 		return inputSources, ctfs
 	case obs.InputTypeInfrastructure:
@@ -88,6 +91,11 @@ func NewSource(input *adapters.Input, resNames factory.ForwarderResourceNames, s
 			inputSources.Add(sourceId, source)
 			tfs.Merge(ctfs)
 		}
+		if sources.Has(obs.InfrastructureSourceFile) && input.Infrastructure.Files != nil {
+			sourceId, source, ctfs := NewNodeFileSource(input)
+			inputSources.Add(sourceId, source)
+			tfs.Merge(ctfs)
+		}
 		return inputSources, tfs
 	case obs.InputTypeAudit:
 		sources := set.Set[obs.AuditSource]{}
@@ -123,6 +131,14 @@ func NewSource(input *adapters.Input, resNames factory.ForwarderResourceNames, s
 		sourceId, source, ctfs := NewViaqReceiverSource(input, resNames, secrets, op)
 		inputSources.Add(sourceId, source)
 		tfs.Merge(ctfs)
+	case obs.InputTypeKafka:
+		sourceId, source, ctfs := NewKafkaSource(input, resNames, secrets, op)
+		inputSources.Add(sourceId, source)
+		tfs.Merge(ctfs)
+	case obs.InputTypeEvents:
+		sourceId, source, ctfs := NewEventsSource(input, op)
+		inputSources.Add(sourceId, source)
+		tfs.Merge(ctfs)
 	}
 	return inputSources, tfs
 }
@@ -14,9 +14,12 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/factory"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sources"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
 )
 
@@ -51,7 +54,7 @@ var _ = Describe("inputs", func() {
 			},
 		}
 		conf := api.NewConfig(func(config *api.Config) {
-			sources, transforms := NewSource(adapters.NewInput(input), *factory.ResourceNames(clf), secrets, framework.NoOptions)
+			sources, transforms := NewSource(adapters.NewInput(input), *factory.ResourceNames(clf), secrets, utils.Options{framework.OptionForwarderNamespace: clf.Namespace})
 			config.AddSources(sources)
 			config.AddTransforms(transforms)
 		})
@@ -249,6 +252,22 @@ var _ = Describe("inputs", func() {
 		},
 			"application_with_matchLabels.toml",
 		),
+		Entry("with an application that specs a namespace selector", obs.InputSpec{
+			Name: "my-app",
+			Type: obs.InputTypeApplication,
+			Application: &obs.Application{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"team": "payments",
+					},
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "environment", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"dev"}},
+					},
+				},
+			},
+		},
+			"application_with_namespace_selector.toml",
+		),
 		Entry("with an infrastructure input should generate a container and journal source", obs.InputSpec{
 			Name: string(obs.InputTypeInfrastructure),
 			Type: obs.InputTypeInfrastructure,
@@ -280,6 +299,76 @@ var _ = Describe("inputs", func() {
 		},
 			"infrastructure_journal.toml",
 		),
+		Entry("with an infrastructure input for node with journal tuning should generate a filtered journal source", obs.InputSpec{
+			Name: "myinfra",
+			Type: obs.InputTypeInfrastructure,
+			Infrastructure: &obs.Infrastructure{
+				Sources: []obs.InfrastructureSource{obs.InfrastructureSourceNode},
+				Tuning: &obs.InfrastructureInputTuningSpec{
+					Journal: &obs.JournalInputTuningSpec{
+						IncludeUnits: []string{"kubelet", "crio", "NetworkManager.service"},
+						ExcludeUnits: []string{"systemd-journald.service"},
+						Priority:     utils.GetPtr(obs.JournalPriorityWarning),
+						IncludeMatches: []obs.JournalMatch{
+							{Field: "_TRANSPORT", Values: []string{"journal", "syslog"}},
+						},
+						Since: obs.JournalSinceNow,
+					},
+				},
+			},
+		},
+			"infrastructure_journal_with_tuning.toml",
+		),
+		Entry("with an infrastructure input for node with only a journal priority should filter the priority at the source", obs.InputSpec{
+			Name: "myinfra",
+			Type: obs.InputTypeInfrastructure,
+			Infrastructure: &obs.Infrastructure{
+				Sources: []obs.InfrastructureSource{obs.InfrastructureSourceNode},
+				Tuning: &obs.InfrastructureInputTuningSpec{
+					Journal: &obs.JournalInputTuningSpec{
+						ExcludeUnits: []string{"systemd-journald.service"},
+						Priority:     utils.GetPtr(obs.JournalPriorityWarning),
+					},
+				},
+			},
+		},
+			"infrastructure_journal_with_priority.toml",
+		),
+		Entry("with an infrastructure input for files should generate a file source", obs.InputSpec{
+			Name: "myinfra",
+			Type: obs.InputTypeInfrastructure,
+			Infrastructure: &obs.Infrastructure{
+				Sources: []obs.InfrastructureSource{obs.InfrastructureSourceFile},
+				Files: &obs.NodeFiles{
+					Includes: []string{"/var/log/gpu/*.log", "/var/log/storage/**/*.log"},
+					Excludes: []string{"/var/log/storage/**/debug.log"},
+				},
+			},
+		},
+			"infrastructure_file.toml",
+		),
+		Entry("with an infrastructure input for files with multiline and fingerprint should generate a file source", obs.InputSpec{
+			Name: "myinfra",
+			Type: obs.InputTypeInfrastructure,
+			Infrastructure: &obs.Infrastructure{
+				Sources: []obs.InfrastructureSource{obs.InfrastructureSourceFile},
+				Files: &obs.NodeFiles{
+					Includes: []string{"/var/log/gpu/*.log"},
+					Multiline: &obs.NodeFileMultiline{
+						StartPattern:     `^\d{4}-\d{2}-\d{2}`,
+						ConditionPattern: `^\d{4}-\d{2}-\d{2}`,
+						Mode:             obs.NodeFileMultilineModeHaltBefore,
+						Timeout:          &metav1.Duration{Duration: 3 * time.Second},
+					},
+					Fingerprint: &obs.NodeFileFingerprint{
+						Strategy: obs.NodeFileFingerprintStrategyChecksum,
+						Lines:    utils.GetPtr[int64](3),
+					},
+				},
+			},
+		},
+			"infrastructure_file_with_multiline.toml",
+		),
 		Entry("with an audit input should generate file sources", obs.InputSpec{
 			Name:  string(obs.InputTypeAudit),
 			Type:  obs.InputTypeAudit,
@@ -375,6 +464,155 @@ var _ = Describe("inputs", func() {
 		},
 			"receiver_http_audit.toml",
 		),
+		Entry("with an http json receiver input should generate an http receiver application source", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeHTTP,
+				Port: 12345,
+				HTTP: &obs.HTTPReceiver{
+					Format: obs.HTTPReceiverFormatJSON,
+					Namespace: &obs.HTTPReceiverAttribution{
+						Header: "X-Namespace",
+					},
+					App: &obs.HTTPReceiverAttribution{
+						Field: ".service",
+					},
+					Authentication: &obs.HTTPReceiverAuthentication{
+						Token: &obs.SecretReference{
+							Key:        constants.TokenKey,
+							SecretName: secretName,
+						},
+					},
+				},
+			},
+		},
+			"receiver_http_json.toml",
+		),
+		Entry("with an http ndjson receiver input should generate an http receiver source with newline framing", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeHTTP,
+				Port: 12345,
+				HTTP: &obs.HTTPReceiver{
+					Format:  obs.HTTPReceiverFormatNDJSON,
+					LogType: string(obs.InputTypeInfrastructure),
+				},
+			},
+		},
+			"receiver_http_ndjson.toml",
+		),
+		Entry("with an http otlp receiver input should generate an http receiver source for OTLP logs", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeHTTP,
+				Port: 12345,
+				HTTP: &obs.HTTPReceiver{
+					Format: obs.HTTPReceiverFormatOTLP,
+					Namespace: &obs.HTTPReceiverAttribution{
+						Header: "X-Namespace",
+					},
+				},
+			},
+		},
+			"receiver_http_otlp.toml",
+		),
+		Entry("with an otlp receiver input should generate an opentelemetry source", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeOTLP,
+				Port: 12345,
+				OTLP: &obs.OTLPReceiver{
+					GRPCPort: 12346,
+				},
+			},
+		},
+			"receiver_otlp.toml",
+		),
+		Entry("with a fluentForward receiver input should generate a fluent source", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeFluentForward,
+				Port: 24224,
+				TLS: &obs.InputTLSSpec{
+					CA: &obs.ValueReference{Key: constants.TrustedCABundleKey, ConfigMapName: "client-ca"},
+					Certificate: &obs.ValueReference{
+						Key:        constants.ClientCertKey,
+						SecretName: "instance-myreceiver",
+					},
+					Key: &obs.SecretReference{
+						Key:        constants.ClientPrivateKey,
+						SecretName: "instance-myreceiver",
+					},
+				},
+				FluentForward: &obs.FluentForwardReceiver{
+					TagPattern: `^app\.(?P<namespace>[^.]+)\.(?P<app>[^.]+)$`,
+					SharedKey:  &obs.SecretReference{Key: "shared_key", SecretName: "fluent-key"},
+				},
+			},
+		},
+			"receiver_fluent_forward.toml",
+		),
+		Entry("with a splunkHEC receiver input should generate a splunk_hec source", obs.InputSpec{
+			Type: obs.InputTypeReceiver,
+			Name: "myreceiver",
+			Receiver: &obs.ReceiverSpec{
+				Type: obs.ReceiverTypeSplunkHEC,
+				Port: 8088,
+				SplunkHEC: &obs.SplunkHECReceiver{
+					Tokens: []*obs.SecretReference{
+						{Key: constants.SplunkHECTokenKey, SecretName: secretName},
+						{Key: constants.SplunkHECTokenKey, SecretName: "other-hec-token"},
+					},
+					Acknowledgements: true,
+				},
+			},
+		},
+			"receiver_splunk_hec.toml",
+		),
+		Entry("with a kafka input should generate a kafka source", obs.InputSpec{
+			Type: obs.InputTypeKafka,
+			Name: "mykafka",
+			Kafka: &obs.KafkaInput{
+				Brokers: []obs.BrokerURL{"tcp://broker1:9092", "tcp://broker2:9092"},
+				Topics:  []string{"app-logs", "other-logs"},
+			},
+		},
+			"kafka.toml",
+		),
+		Entry("with a kafka input with a topic pattern, json decoding, SASL and TLS should generate a kafka source", obs.InputSpec{
+			Type: obs.InputTypeKafka,
+			Name: "mykafka",
+			Kafka: &obs.KafkaInput{
+				Brokers:      []obs.BrokerURL{"tls://broker1:9093"},
+				TopicPattern: "app-.*",
+				GroupID:      "my-group",
+				Decoding:     obs.KafkaInputDecodingJSON,
+				LogType:      string(obs.InputTypeInfrastructure),
+				Authentication: &obs.KafkaAuthentication{
+					SASL: &obs.SASLAuthentication{
+						Username:  &obs.SecretReference{Key: constants.ClientUsername, SecretName: secretName},
+						Password:  &obs.SecretReference{Key: constants.ClientPassword, SecretName: secretName},
+						Mechanism: "SCRAM-SHA-512",
+					},
+				},
+				TLS: &obs.OutputTLSSpec{
+					InsecureSkipVerify: true,
+					TLSSpec: obs.TLSSpec{
+						CA: &obs.ValueReference{
+							Key:        constants.TrustedCABundleKey,
+							SecretName: secretName,
+						},
+					},
+				},
+			},
+		},
+			"kafka_with_sasl_and_tls.toml",
+		),
 		Entry("with a syslog receiver input should generate VIAQ syslog receiver", obs.InputSpec{
 			Type: obs.InputTypeReceiver,
 			Name: "myreceiver",
@@ -469,4 +707,141 @@ var _ = Describe("inputs", func() {
 			"infrastructure_container_with_throttle.toml",
 		),
 	)
+
+	Context("with a kafka input", func() {
+		var (
+			input = obs.InputSpec{
+				Type: obs.InputTypeKafka,
+				Name: "mykafka",
+				Kafka: &obs.KafkaInput{
+					Brokers: []obs.BrokerURL{"tcp://broker1:9092"},
+					Topics:  []string{"app-logs"},
+				},
+			}
+			clfSpec = internalobs.ClusterLogForwarderSpec{
+				Inputs: []obs.InputSpec{input},
+				Outputs: []obs.OutputSpec{
+					{
+						Name: "es",
+						Type: obs.OutputTypeElasticsearch,
+						Elasticsearch: &obs.Elasticsearch{
+							Tuning: &obs.ElasticsearchTuningSpec{
+								BaseOutputTuningSpec: obs.BaseOutputTuningSpec{
+									DeliveryMode: obs.DeliveryModeAtLeastOnce,
+								},
+							},
+						},
+					},
+					{
+						Name: "loki",
+						Type: obs.OutputTypeLoki,
+						Loki: &obs.Loki{},
+					},
+				},
+			}
+		)
+		newKafkaSource := func(pipelines ...obs.PipelineSpec) *sources.Kafka {
+			clfSpec.Pipelines = pipelines
+			op := utils.Options{helpers.CLFSpec: clfSpec}
+			inputSources, _ := NewSource(adapters.NewInput(input), *factory.ResourceNames(obs.ClusterLogForwarder{}), secrets, op)
+			return inputSources["input_mykafka"].(*sources.Kafka)
+		}
+
+		It("should commit offsets once logs are accepted by an output delivering at least once", func() {
+			source := newKafkaSource(obs.PipelineSpec{Name: "to-es", InputRefs: []string{"mykafka"}, OutputRefs: []string{"es", "loki"}})
+			Expect(source.Acknowledgements).To(Equal(&sources.Acknowledgements{Enabled: true}))
+		})
+
+		It("should not wait on the outputs to commit offsets when no output delivers at least once", func() {
+			source := newKafkaSource(obs.PipelineSpec{Name: "to-loki", InputRefs: []string{"mykafka"}, OutputRefs: []string{"loki"}})
+			Expect(source.Acknowledgements).To(BeNil())
+		})
+	})
+
+	Context("with an application input that allows pod annotations", func() {
+		It("should expose the allowed annotations as hints and drop the logs of pods opting out of collection", func() {
+			input := obs.InputSpec{
+				Name: "my-app",
+				Type: obs.InputTypeApplication,
+				Application: &obs.Application{
+					AllowedPodAnnotations: []obs.PodAnnotationKey{
+						obs.PodAnnotationCollect,
+						obs.PodAnnotationParser,
+						obs.PodAnnotationPipelineHint,
+					},
+				},
+			}
+			exp, err := tomlContent.ReadFile("application_with_pod_annotations.toml")
+			Expect(err).To(BeNil())
+			op := utils.Options{helpers.CLFSpec: internalobs.ClusterLogForwarderSpec{
+				Pipelines: []obs.PipelineSpec{
+					{Name: "to-es", InputRefs: []string{"my-app"}},
+					{Name: "to-loki", InputRefs: []string{"my-app", "other"}},
+					{Name: "other-only", InputRefs: []string{"other"}},
+				},
+			}}
+			conf := api.NewConfig(func(config *api.Config) {
+				sources, transforms := NewSource(adapters.NewInput(input), *factory.ResourceNames(obs.ClusterLogForwarder{}), secrets, op)
+				config.AddSources(sources)
+				config.AddTransforms(transforms)
+			})
+			Expect(exp).To(EqualConfigFrom(conf))
+		})
+	})
+
+	Context("with the inputs of tenants", func() {
+		It("should collect the namespaces of the tenants with a single container source routed to the rate limited input of each tenant", func() {
+			newTenantInput := func(name, namespace string, selector *metav1.LabelSelector) *adapters.Input {
+				return adapters.NewInput(obs.InputSpec{
+					Name: name,
+					Type: obs.InputTypeApplication,
+					Application: &obs.Application{
+						Selector: selector,
+						Includes: []obs.NamespaceContainerSpec{{Namespace: namespace}},
+					},
+				})
+			}
+			exp, err := tomlContent.ReadFile("tenants.toml")
+			Expect(err).To(BeNil())
+			inputs := []*adapters.Input{
+				newTenantInput("tenant-0000000a", "team-a", nil),
+				newTenantInput("tenant-0000000b", "team-b", &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "frontend"},
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "tier", Operator: metav1.LabelSelectorOpIn, Values: []string{"web", "api"}},
+						{Key: "canary", Operator: metav1.LabelSelectorOpDoesNotExist},
+					},
+				}),
+			}
+			conf := api.NewConfig(func(config *api.Config) {
+				sources, transforms := NewTenantsSource(inputs, &obs.LimitSpec{MaxRecordsPerSecond: 100})
+				config.AddSources(sources)
+				config.AddTransforms(transforms)
+			})
+			Expect(exp).To(EqualConfigFrom(conf))
+			Expect(inputs[0].Ids).To(Equal([]string{"input_tenant_0000000a_rate_limit"}))
+			Expect(inputs[1].Ids).To(Equal([]string{"input_tenant_0000000b_rate_limit"}))
+		})
+	})
+
+	Context("with an events input", func() {
+		It("should generate an http_client source watching the events of the cluster", func() {
+			input := obs.InputSpec{
+				Type: obs.InputTypeEvents,
+				Name: "myevents",
+				Events: &obs.EventsInput{
+					Types: []obs.EventType{obs.EventTypeWarning},
+				},
+			}
+			exp, err := tomlContent.ReadFile("events.toml")
+			Expect(err).To(BeNil())
+			op := utils.Options{framework.OptionServiceAccountTokenSecretName: "logcollector-token"}
+			conf := api.NewConfig(func(config *api.Config) {
+				sources, transforms := NewSource(adapters.NewInput(input), *factory.ResourceNames(obs.ClusterLogForwarder{}), secrets, op)
+				config.AddSources(sources)
+				config.AddTransforms(transforms)
+			})
+			Expect(exp).To(EqualConfigFrom(conf))
+		})
+	})
 })
@@ -0,0 +1,102 @@
+package input
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	vectorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// tenantsInputName is the name of the container source shared by the inputs of the tenants
+	tenantsInputName = "tenants"
+
+	fmtPodLabel = `._internal.kubernetes.labels.%q`
+)
+
+// NewTenantsSource generates a single container source for the inputs of the LogForwarders of the tenants served by a
+// forwarder and routes the records to the input of each tenant by namespace and pod labels. The input of each tenant is
+// throttled by the rate limit of the tenancy
+func NewTenantsSource(inputs []*adapters.Input, rateLimit *obs.LimitSpec) (inputSources api.Sources, tfs api.Transforms) {
+	inputSources = api.Sources{}
+	ib := helpers.NewContainerPathGlobBuilder()
+	eb := helpers.NewContainerPathGlobBuilder()
+	routes := map[string]string{}
+	namespaces := []string{}
+	for _, i := range inputs {
+		var conditions []string
+		for _, in := range i.Application.Includes {
+			ib.AddCombined(helpers.NamespaceContainer{Namespace: in.Namespace})
+			namespaces = append(namespaces, in.Namespace)
+			conditions = append(conditions, fmt.Sprintf(`._internal.kubernetes.namespace_name == %q`, in.Namespace))
+		}
+		conditions = append(conditions, labelSelectorConditions(i.Application.Selector)...)
+		routes[vectorhelpers.FormatComponentID(i.Name)] = strings.Join(conditions, " && ")
+	}
+	for _, ns := range pruneInfraNS(namespaces) {
+		eb.AddCombined(helpers.NamespaceContainer{Namespace: ns})
+	}
+	eb.AddExtensions(excludeExtensions...)
+
+	shared := adapters.NewInput(obs.InputSpec{
+		Name:        tenantsInputName,
+		Type:        obs.InputTypeApplication,
+		Application: &obs.Application{},
+	})
+	base, source, tfs := NewContainerSource(shared, ib.Build(), eb.Build(infraNamespaces...), obs.InputTypeApplication, obs.InfrastructureSourceContainer)
+	inputSources.Add(base, source)
+
+	routeID := vectorhelpers.MakeInputID(tenantsInputName, "route")
+	tfs[routeID] = transforms.NewRoute(func(r *transforms.Route) {
+		r.Routes = routes
+	}, shared.InputIDs()...)
+
+	for _, i := range inputs {
+		id := vectorhelpers.MakeRouteInputID(routeID, vectorhelpers.FormatComponentID(i.Name))
+		if threshold, hasPolicy := internalobs.Threshold(rateLimit); hasPolicy {
+			rateLimitID := vectorhelpers.MakeInputID(i.Name, "rate_limit")
+			tfs.Add(rateLimitID, AddRateLimitToInput(id, threshold))
+			id = rateLimitID
+		}
+		i.Ids = append(i.Ids, id)
+	}
+	return inputSources, tfs
+}
+
+// labelSelectorConditions returns the VRL conditions matching the pod labels of a record to a label selector
+func labelSelectorConditions(selector *metav1.LabelSelector) (conditions []string) {
+	if selector == nil {
+		return nil
+	}
+	for key, value := range selector.MatchLabels {
+		conditions = append(conditions, fmt.Sprintf(fmtPodLabel+` == %q`, key, value))
+	}
+	for _, r := range selector.MatchExpressions {
+		label := fmt.Sprintf(fmtPodLabel, r.Key)
+		var values []string
+		for _, v := range r.Values {
+			values = append(values, fmt.Sprintf("%q", v))
+		}
+		sort.Strings(values)
+		switch r.Operator {
+		case metav1.LabelSelectorOpExists:
+			conditions = append(conditions, fmt.Sprintf("exists(%s)", label))
+		case metav1.LabelSelectorOpDoesNotExist:
+			conditions = append(conditions, fmt.Sprintf("!exists(%s)", label))
+		case metav1.LabelSelectorOpIn:
+			conditions = append(conditions, fmt.Sprintf("includes([%s], %s)", strings.Join(values, ", "), label))
+		case metav1.LabelSelectorOpNotIn:
+			conditions = append(conditions, fmt.Sprintf("!includes([%s], %s)", strings.Join(values, ", "), label))
+		}
+	}
+	sort.Strings(conditions)
+	return conditions
+}
@@ -16,3 +16,12 @@ func AddThrottleToInput(input string, maxRecordsPerSec int64) types.Transform {
 		perContainerLimitKeyField,
 	)
 }
+
+// AddRateLimitToInput throttles the records of all the containers of an input together
+func AddRateLimitToInput(input string, maxRecordsPerSec int64) types.Transform {
+	return common.NewThrottle(
+		[]string{input},
+		maxRecordsPerSec,
+		"",
+	)
+}
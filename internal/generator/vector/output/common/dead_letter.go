@@ -0,0 +1,60 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+)
+
+const (
+	// DeadLetterField is the field of a rejected record annotated with the rejecting output and the reason
+	DeadLetterField = "dead_letter"
+
+	// AcceptedRoute is the route of the records an output delivers
+	AcceptedRoute = "accepted"
+
+	// RejectedRoute is the route of the records an output rejects before delivery
+	RejectedRoute = "rejected"
+
+	rejectReasonField = "._internal.dead_letter_reason"
+)
+
+// NewRejectRemap returns a remap that records the reason an output rejects a record before delivery. Only records
+// without a log_type or exceeding the maximum write size of the output are rejected, the records the endpoint rejects
+// are dropped by the sink
+func NewRejectRemap(o observability.TunableOutput, inputs ...string) *transforms.Remap {
+	vrl := []string{
+		`if !exists(.log_type) {`,
+		fmt.Sprintf(`  %s = "record does not have a log_type"`, rejectReasonField),
+	}
+	if maxWrite := o.GetTuning().MaxWrite; maxWrite != nil && maxWrite.Value() > 0 {
+		vrl = append(vrl,
+			fmt.Sprintf(`} else if length(encode_json(.)) > %d {`, maxWrite.Value()),
+			fmt.Sprintf(`  %s = "record exceeds the maximum write size of the output"`, rejectReasonField),
+		)
+	}
+	vrl = append(vrl, `}`)
+	return transforms.NewRemap(strings.Join(vrl, "\n"), inputs...)
+}
+
+// NewRejectRoute splits the records annotated by the reject remap from those the output delivers
+func NewRejectRoute(inputs ...string) *transforms.Route {
+	return transforms.NewRoute(func(r *transforms.Route) {
+		r.Routes = map[string]string{
+			AcceptedRoute: fmt.Sprintf(`!exists(%s)`, rejectReasonField),
+			RejectedRoute: fmt.Sprintf(`exists(%s)`, rejectReasonField),
+		}
+	}, inputs...)
+}
+
+// NewDeadLetterRemap annotates the records rejected by an output with the output name and rejection reason
+func NewDeadLetterRemap(outputName string, inputs ...string) *transforms.Remap {
+	return transforms.NewRemap(fmt.Sprintf(`
+.%s = {
+  "output": %q,
+  "reason": del(%s)
+}
+`, DeadLetterField, outputName, rejectReasonField), inputs...)
+}
@@ -1,10 +1,26 @@
 package common
 
 import (
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sinks"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/codec"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 )
 
+var timestampFormats = map[obs.TimestampFormat]string{
+	obs.TimestampFormatRFC3339:   "rfc3339",
+	obs.TimestampFormatUnix:      "unix",
+	obs.TimestampFormatUnixMs:    "unix_ms",
+	obs.TimestampFormatUnixUs:    "unix_us",
+	obs.TimestampFormatUnixNs:    "unix_ns",
+	obs.TimestampFormatUnixFloat: "unix_float",
+}
+
 func NewApiEncoding(codecType codec.CodecType) (e *sinks.Encoding) {
 	e = &sinks.Encoding{
 		Codec:        codecType,
@@ -12,3 +28,49 @@ func NewApiEncoding(codecType codec.CodecType) (e *sinks.Encoding) {
 	}
 	return e
 }
+
+// NewEncoding returns the encoding of a payload defined by an HTTP codec spec. Records are encoded as JSON when the spec is nil.
+// The avro schema is read from the ConfigMaps given by the generator options while the protobuf descriptor is read
+// from the mounted ConfigMap
+func NewEncoding(spec *obs.HTTPCodec, op utils.Options) (e *sinks.Encoding) {
+	e = NewApiEncoding(codec.CodecTypeJSON)
+	if spec == nil {
+		return e
+	}
+	switch spec.Type {
+	case obs.HTTPCodecTypeText:
+		e.Codec = codec.CodecTypeText
+	case obs.HTTPCodecTypeCSV:
+		e.Codec = codec.CodecTypeCSV
+		e.CSV = &codec.CSV{
+			Fields: fieldPaths(spec.CSVFields),
+		}
+	case obs.HTTPCodecTypeProtobuf:
+		e.Codec = codec.CodecTypeProtobuf
+		if spec.Protobuf != nil {
+			e.Protobuf = &codec.Protobuf{
+				DescFile:    helpers.ConfigPath(spec.Protobuf.Descriptor.ConfigMapName, spec.Protobuf.Descriptor.Key, "%s"),
+				MessageType: spec.Protobuf.MessageType,
+			}
+		}
+	case obs.HTTPCodecTypeAvro:
+		e.Codec = codec.CodecTypeAvro
+		if spec.Avro != nil {
+			configMaps, _ := utils.GetOption(op, framework.OptionConfigMaps, observability.ConfigMaps{})
+			e.Avro = &codec.Avro{
+				Schema: configMaps.AsString(&spec.Avro.Schema),
+			}
+		}
+	}
+	e.OnlyFields = fieldPaths(spec.OnlyFields)
+	e.ExceptFields = append(e.ExceptFields, fieldPaths(spec.ExceptFields)...)
+	e.TimestampFormat = timestampFormats[spec.TimestampFormat]
+	return e
+}
+
+func fieldPaths(paths []obs.FieldPath) (fields []string) {
+	for _, f := range paths {
+		fields = append(fields, strings.TrimPrefix(string(f), "."))
+	}
+	return fields
+}
@@ -0,0 +1,99 @@
+package datadog
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sinks"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/common/tls"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
+	commontemplate "github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common/template"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+)
+
+const (
+	DefaultSite = "datadoghq.com"
+
+	defaultService = `to_string!(._internal.kubernetes.container_name || ._internal.log_source || "unknown")`
+	defaultSource  = `"openshift"`
+
+	// tagTmpl appends a tag to the list of tags when the field of the record exists
+	tagTmpl = `
+value = ._internal%s
+if value != null {
+  ddtags = push(ddtags, %q + (to_string(value) ?? encode_json(value)))
+}`
+)
+
+func New(id string, o *adapters.Output, inputs []string, secrets observability.Secrets, op utils.Options) (_ string, sink types.Sink, tfs api.Transforms) {
+	tfs = api.Transforms{}
+	if o.Datadog == nil {
+		return "", nil, nil
+	}
+	d := o.Datadog
+	metadataID := helpers.MakeID(id, "metadata")
+	tfs[metadataID] = Metadata(d, inputs...)
+	sink = sinks.NewDatadogLogs(func(s *sinks.DatadogLogs) {
+		s.DefaultApiKey = apiKey(d.Authentication)
+		s.Site = site(d)
+		s.Endpoint = d.URL
+		s.Compression = sinks.CompressionType(o.GetTuning().Compression)
+		s.Encoding = common.NewApiEncoding("")
+		s.Batch = common.NewApiBatch(o)
+		s.Buffer = common.NewApiBuffer(o)
+		s.Request = common.NewApiRequest(o)
+		s.TLS = tls.NewTls(o, secrets, op)
+	}, metadataID)
+	return id, sink, tfs
+}
+
+func apiKey(spec *obs.DatadogAuthentication) string {
+	if spec == nil || spec.APIKey == nil {
+		return ""
+	}
+	return helpers.SecretFrom(spec.APIKey)
+}
+
+func site(d *obs.Datadog) string {
+	if d.Site == "" {
+		return DefaultSite
+	}
+	return d.Site
+}
+
+// Metadata sets the reserved attributes of a Datadog log event
+// Ref: https://docs.datadoghq.com/logs/log_configuration/attributes_naming_convention/#reserved-attributes
+func Metadata(d *obs.Datadog, inputs ...string) types.Transform {
+	service := defaultService
+	if d.Service != "" {
+		service = commontemplate.TransformUserTemplateToVRL(d.Service)
+	}
+	source := defaultSource
+	if d.Source != "" {
+		source = commontemplate.TransformUserTemplateToVRL(d.Source)
+	}
+	vrl := []string{
+		`.hostname = ._internal.hostname`,
+		fmt.Sprintf(`.service = %s`, service),
+		fmt.Sprintf(`.ddsource = %s`, source),
+	}
+	if len(d.Tags) > 0 {
+		vrl = append(vrl, `ddtags = []`)
+		for _, tag := range d.Tags {
+			vrl = append(vrl, fmt.Sprintf(tagTmpl, tag.Field, tag.Name+":"))
+		}
+		vrl = append(vrl,
+			`if length(ddtags) > 0 {`,
+			`  .ddtags = join!(ddtags, ",")`,
+			`}`,
+		)
+	}
+	return transforms.NewRemap(strings.Join(vrl, "\n"), inputs...)
+}
@@ -0,0 +1,103 @@
+package datadog_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/datadog"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("Generate Vector config", func() {
+	const (
+		secretName = "datadog-secret"
+		apiKey     = "api-key"
+	)
+	var (
+		adapter *adapters.Output
+		tlsSpec = &obs.OutputTLSSpec{
+			TLSSpec: obs.TLSSpec{
+				CA: &obs.ValueReference{
+					Key:        constants.TrustedCABundleKey,
+					SecretName: secretName,
+				},
+			},
+		}
+		initOutput = func() obs.OutputSpec {
+			return obs.OutputSpec{
+				Type: obs.OutputTypeDatadog,
+				Name: "datadog",
+				Datadog: &obs.Datadog{
+					Authentication: &obs.DatadogAuthentication{
+						APIKey: &obs.SecretReference{
+							Key:        apiKey,
+							SecretName: secretName,
+						},
+					},
+				},
+			}
+		}
+		secrets = map[string]*corev1.Secret{
+			secretName: {
+				Data: map[string][]byte{
+					apiKey:                       []byte("dummy"),
+					constants.TrustedCABundleKey: []byte("dummy"),
+				},
+			},
+		}
+
+		baseTune = &obs.BaseOutputTuningSpec{
+			DeliveryMode:     obs.DeliveryModeAtLeastOnce,
+			MaxWrite:         utils.GetPtr(resource.MustParse("10M")),
+			MaxRetryDuration: utils.GetPtr(time.Duration(35)),
+			MinRetryDuration: utils.GetPtr(time.Duration(20)),
+		}
+	)
+	DescribeTable("For Datadog output", func(visit func(spec *obs.OutputSpec), op utils.Options, expFile string) {
+		exp, err := tomlContent.ReadFile(expFile)
+		if err != nil {
+			Fail(fmt.Sprintf("Error reading the file %q with exp config: %v", expFile, err))
+		}
+		outputSpec := initOutput()
+		if visit != nil {
+			visit(&outputSpec)
+		}
+		adapter = adapters.NewOutput(outputSpec)
+		id, sink, transforms := datadog.New(outputSpec.Name, adapter, []string{"application"}, secrets, op)
+		Expect(exp).To(EqualConfigFrom(api.NewConfig(func(c *api.Config) {
+			c.Sinks[id] = sink
+			c.AddTransforms(transforms)
+		})))
+	},
+		Entry("with defaults", nil, framework.NoOptions, "datadog_with_defaults.toml"),
+		Entry("with site, service, source and tags", func(spec *obs.OutputSpec) {
+			spec.Datadog.Site = "datadoghq.eu"
+			spec.Datadog.Service = `{.kubernetes.labels."app.kubernetes.io/name"||"unknown"}`
+			spec.Datadog.Source = "kubernetes"
+			spec.Datadog.Tags = []obs.DatadogTag{
+				{Name: "namespace", Field: ".kubernetes.namespace_name"},
+				{Name: "env", Field: `.kubernetes.labels."env"`},
+			}
+		}, framework.NoOptions, "datadog_with_metadata.toml"),
+		Entry("with URL and TLS", func(spec *obs.OutputSpec) {
+			spec.Datadog.URL = "https://datadog-proxy.example.com:8443"
+			spec.TLS = tlsSpec
+		}, framework.NoOptions, "datadog_with_url_and_tls.toml"),
+		Entry("with tuning", func(spec *obs.OutputSpec) {
+			spec.Datadog.Tuning = &obs.DatadogTuningSpec{
+				BaseOutputTuningSpec: *baseTune,
+				Compression:          "gzip",
+			}
+		}, framework.NoOptions, "datadog_with_tuning.toml"),
+	)
+})
@@ -13,13 +13,17 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/aws/s3"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/azure/azurelogsingestion"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/azure/azuremonitor"
+	outputcommon "github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/datadog"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/elasticsearch"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/gcl"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/http"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/kafka"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/loki"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/lokistack"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/newrelic"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/otlp"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/socket"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/splunk"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/syslog"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
@@ -38,6 +42,14 @@ func New(o *adapters.Output, inputs []string, secrets map[string]*corev1.Secret,
 		transforms.Add(throttleID, common.NewThrottle(inputs, threshold, ""))
 		inputs = []string{throttleID}
 	}
+	if o.PreDeliveryRejectionsOutputRef != "" {
+		rejectID := helpers.MakeID(baseID, "reject")
+		rejectRouteID := helpers.MakeID(baseID, "reject_route")
+		transforms.Add(rejectID, outputcommon.NewRejectRemap(o, inputs...))
+		transforms.Add(rejectRouteID, outputcommon.NewRejectRoute(rejectID))
+		transforms.Add(adapters.DeadLetterInputID(o.Name), outputcommon.NewDeadLetterRemap(o.Name, helpers.MakeRouteInputID(rejectRouteID, outputcommon.RejectedRoute)))
+		inputs = []string{helpers.MakeRouteInputID(rejectRouteID, outputcommon.AcceptedRoute)}
+	}
 	var sinkId string
 	var sink types.Sink
 	var sinkTransforms api.Transforms
@@ -57,11 +69,19 @@ func New(o *adapters.Output, inputs []string, secrets map[string]*corev1.Secret,
 	case obs.OutputTypeS3:
 		sinkId, sink, sinkTransforms = s3.New(baseID, o, inputs, secrets, op)
 	case obs.OutputTypeGoogleCloudLogging:
-		sinkId, sink, sinkTransforms = gcl.New(baseID, o, inputs, secrets, op)
+		outputSinks, outputTransforms := gcl.New(baseID, o, inputs, secrets, op)
+		sinks.Merge(outputSinks)
+		transforms.Merge(outputTransforms)
+	case obs.OutputTypeDatadog:
+		sinkId, sink, sinkTransforms = datadog.New(baseID, o, inputs, secrets, op)
+	case obs.OutputTypeNewRelic:
+		sinkId, sink, sinkTransforms = newrelic.New(baseID, o, inputs, secrets, op)
 	case obs.OutputTypeSplunk:
 		sinkId, sink, sinkTransforms = splunk.New(baseID, o, inputs, secrets, op)
 	case obs.OutputTypeHTTP:
 		sinkId, sink, sinkTransforms = http.New(baseID, o, inputs, secrets, op)
+	case obs.OutputTypeSocket:
+		sinkId, sink, sinkTransforms = socket.New(baseID, o, inputs, secrets, op)
 	case obs.OutputTypeSyslog:
 		sinkId, sink, sinkTransforms = syslog.New(baseID, o, inputs, secrets, op)
 	case obs.OutputTypeAzureLogsIngestion:
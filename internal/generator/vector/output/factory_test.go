@@ -12,6 +12,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/utils"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 var _ = Describe("output/factory.go", func() {
@@ -68,5 +69,24 @@ var _ = Describe("output/factory.go", func() {
 			},
 			"factory_test_loki_with_throttle.toml",
 		),
+		Entry("should route rejected records to the dead-letter output when present",
+			obs.OutputSpec{
+				Type:                           obs.OutputTypeHTTP,
+				Name:                           "primary",
+				PreDeliveryRejectionsOutputRef: "rejects",
+				HTTP: &obs.HTTP{
+					URLSpec: obs.URLSpec{
+						URL: "http://primary.example.com:8080",
+					},
+					Tuning: &obs.HTTPTuningSpec{
+						BaseOutputTuningSpec: obs.BaseOutputTuningSpec{
+							MaxWrite: utils.GetPtr(resource.MustParse("1M")),
+						},
+					},
+				},
+			},
+			nil,
+			"factory_test_http_with_dead_letter.toml",
+		),
 	)
 })
@@ -2,9 +2,12 @@ package gcl
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sinks"
@@ -20,34 +23,212 @@ import (
 const (
 	DefaultSeverityKey              = "level"
 	GoogleApplicationCredentialsKey = "google-application-credentials.json"
+
+	// ContainerResourceType and NodeResourceType are the types of the default monitored resources of container
+	// records and of the other records
+	ContainerResourceType = "k8s_container"
+	NodeResourceType      = "k8s_node"
+
+	// resourceTypeKey is the field of the monitored resource of a record holding its type
+	resourceTypeKey = "type"
+
+	// resourceTypeTmpl sets the type of the default monitored resource of a record from its log source
+	resourceTypeTmpl = `
+._internal.gcl.resource.%[1]s = %[2]q
+if ._internal.log_source == "container" {
+  ._internal.gcl.resource.%[1]s = %[3]q
+}`
+
+	// DefaultLocation is the location of the default monitored resources when the cluster has no cloud region
+	DefaultLocation = "global"
+
+	// LabelsKey, TraceKey and SpanIdKey are the special fields of the payload for the labels, trace and span of an entry
+	LabelsKey = "logging.googleapis.com/labels"
+	TraceKey  = "logging.googleapis.com/trace"
+	SpanIdKey = "logging.googleapis.com/spanId"
+
+	// traceTmpl sets the trace of the entry when the field of the record exists
+	traceTmpl = `
+trace = ._internal%s
+if trace != null {
+  trace = to_string(trace) ?? encode_json(trace)%s
+  .%q = trace
+}`
+
+	// traceNameTmpl prefixes a trace ID with the resource name of the traces of the project
+	traceNameTmpl = `
+  if !starts_with(trace, "projects/") {
+    trace = %q + trace
+  }`
+
+	// severityTmpl normalizes the severity held by the field of the record
+	severityTmpl = `
+# Set audit log level to 'INFO'
+if .log_type == "audit" {
+  %[1]s = "INFO"
+} else if !exists(%[1]s) {
+  %[1]s = "DEFAULT"
+} else if %[1]s == "warn" {
+  %[1]s = "WARNING"
+} else if %[1]s == "trace" {
+  %[1]s = "DEBUG"
+} else {
+  %[1]s = upcase!(%[1]s)
+}`
+
+	// spanIdTmpl sets the span of the entry when the field of the record exists
+	spanIdTmpl = `
+span_id = ._internal%s
+if span_id != null {
+  .%q = to_string(span_id) ?? encode_json(span_id)
+}`
+)
+
+var (
+	// defaultResourceLabels are the fields of the record of the labels of the default monitored resources
+	defaultResourceLabels = map[string]string{
+		"cluster_name":   "._internal.openshift.cluster_id",
+		"namespace_name": "._internal.kubernetes.namespace_name",
+		"pod_name":       "._internal.kubernetes.pod_name",
+		"container_name": "._internal.kubernetes.container_name",
+		"node_name":      "._internal.hostname",
+	}
 )
 
-func New(id string, o *adapters.Output, inputs []string, secrets observability.Secrets, op utils.Options) (_ string, sink types.Sink, tfs api.Transforms) {
+// New generates a sink with the monitored resource of the spec or, by default, a k8s_container resource for container
+// records and a k8s_node resource for the other records
+func New(id string, o *adapters.Output, inputs []string, secrets observability.Secrets, op utils.Options) (sinkMap api.Sinks, tfs api.Transforms) {
 	tfs = api.Transforms{}
+	sinkMap = api.Sinks{}
 	if o.GoogleCloudLogging == nil {
-		return "", nil, nil
+		return nil, nil
 	}
 	componentID := helpers.MakeID(id, "log_id")
+	metadataID := helpers.MakeID(id, "metadata")
 	gclSeverityID := helpers.MakeID(id, "normalize_severity")
-	tfs[gclSeverityID] = NormalizeSeverity(componentID)
-	tfs[componentID] = commontemplate.NewTemplateRemap(inputs, o.GoogleCloudLogging.LogId, componentID)
 	g := o.GoogleCloudLogging
-	sink = sinks.NewGcpStackdriverLogs(func(s *sinks.GcpStackdriverLogs) {
-		LogDestination(s, o.GoogleCloudLogging)
-		s.LogId = fmt.Sprintf("{{ _internal.%s }}", componentID)
-		s.SeverityKey = DefaultSeverityKey
-		s.CredentialsPath = auth(g.Authentication)
-		s.Encoding = common.NewApiEncoding("")
-		s.Batch = common.NewApiBatch(o)
-		s.Buffer = common.NewApiBuffer(o)
-		s.Request = common.NewApiRequest(o)
-		s.TLS = tls.NewTls(o, secrets, op)
-		s.Resource = map[string]string{
-			"type":      "k8s_node",
-			"node_name": "{{hostname}}",
+	severityKey := severityKey(g)
+	tfs[componentID] = commontemplate.NewTemplateRemap(inputs, g.LogId, componentID)
+	tfs[metadataID] = Metadata(g, op, componentID)
+	tfs[gclSeverityID] = NormalizeSeverity(severityKey, metadataID)
+	newSink := func(resource map[string]string, inputs ...string) types.Sink {
+		return sinks.NewGcpStackdriverLogs(func(s *sinks.GcpStackdriverLogs) {
+			LogDestination(s, g)
+			s.LogId = fmt.Sprintf("{{ _internal.%s }}", componentID)
+			s.SeverityKey = severityKey
+			s.CredentialsPath = auth(g.Authentication)
+			s.Encoding = common.NewApiEncoding("")
+			s.Batch = common.NewApiBatch(o)
+			s.Buffer = common.NewApiBuffer(o)
+			s.Request = common.NewApiRequest(o)
+			s.TLS = tls.NewTls(o, secrets, op)
+			s.Resource = resource
+		}, inputs...)
+	}
+	if g.Resource != nil {
+		labels := make([]string, 0, len(g.Resource.Labels))
+		for k := range g.Resource.Labels {
+			labels = append(labels, k)
 		}
-	}, gclSeverityID)
-	return id, sink, tfs
+		sinkMap[id] = newSink(Resource(g.Resource.Type, labels), gclSeverityID)
+		return sinkMap, tfs
+	}
+
+	sinkMap[id] = newSink(Resource(fmt.Sprintf("{{ _internal.gcl.resource.%s }}", resourceTypeKey), defaultResourceLabelNames()), gclSeverityID)
+	return sinkMap, tfs
+}
+
+// defaultResourceLabelNames returns the labels of the default monitored resources. The labels of a record not
+// applying to the type of its resource are empty
+func defaultResourceLabelNames() []string {
+	labels := []string{"location", "project_id"}
+	for k := range defaultResourceLabels {
+		labels = append(labels, k)
+	}
+	sort.Strings(labels)
+	return labels
+}
+
+func severityKey(g *obs.GoogleCloudLogging) string {
+	if g.SeverityKey == "" {
+		return DefaultSeverityKey
+	}
+	return strings.TrimPrefix(string(g.SeverityKey), ".")
+}
+
+// resourceLabels returns the VRL expression of each label of the monitored resources of the entries
+func resourceLabels(g *obs.GoogleCloudLogging, op utils.Options) map[string]string {
+	labels := map[string]string{}
+	if g.Resource != nil {
+		for k, v := range g.Resource.Labels {
+			labels[k] = commontemplate.TransformUserTemplateToVRL(v)
+		}
+		return labels
+	}
+	for k, path := range defaultResourceLabels {
+		labels[k] = fmt.Sprintf(`to_string(%s) ?? ""`, path)
+	}
+	location, _ := utils.GetOption(op, framework.OptionClusterRegion, DefaultLocation)
+	labels["location"] = fmt.Sprintf("%q", location)
+	// the project of the k8s resources is required and is the parent of the entries when it is not a project
+	labels["project_id"] = fmt.Sprintf("%q", g.ID.Value)
+	return labels
+}
+
+// Resource is the monitored resource of a sink whose labels are rendered from the values set by the metadata transform
+func Resource(resourceType string, labels []string) map[string]string {
+	resource := map[string]string{
+		"type": resourceType,
+	}
+	for _, k := range labels {
+		resource[k] = fmt.Sprintf("{{ _internal.gcl.resource.%s }}", k)
+	}
+	return resource
+}
+
+// Metadata sets the values of the monitored resource labels along with the labels, trace and span of an entry
+func Metadata(g *obs.GoogleCloudLogging, op utils.Options, inputs ...string) types.Transform {
+	vrl := []string{
+		fmt.Sprintf("._internal.gcl.resource = %s", vrlObject(resourceLabels(g, op))),
+	}
+	if g.Resource == nil {
+		vrl = append(vrl, fmt.Sprintf(resourceTypeTmpl, resourceTypeKey, NodeResourceType, ContainerResourceType))
+	}
+	if len(g.Labels) > 0 {
+		labels := map[string]string{}
+		for k, v := range g.Labels {
+			labels[k] = commontemplate.TransformUserTemplateToVRL(v)
+		}
+		vrl = append(vrl, fmt.Sprintf(".%q = %s", LabelsKey, vrlObject(labels)))
+	}
+	if g.TraceKey != "" {
+		traceName := ""
+		if g.ID.Type == obs.GoogleCloudLoggingIdTypeProject {
+			traceName = fmt.Sprintf(traceNameTmpl, fmt.Sprintf("projects/%s/traces/", g.ID.Value))
+		}
+		vrl = append(vrl, fmt.Sprintf(traceTmpl, g.TraceKey, traceName, TraceKey))
+	}
+	if g.SpanIdKey != "" {
+		vrl = append(vrl, fmt.Sprintf(spanIdTmpl, g.SpanIdKey, SpanIdKey))
+	}
+	return transforms.NewRemap(strings.Join(vrl, "\n"), inputs...)
+}
+
+// vrlObject renders an object literal from the VRL expressions of its fields sorted by name
+func vrlObject(fields map[string]string) string {
+	if len(fields) == 0 {
+		return "{}"
+	}
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	entries := make([]string, 0, len(keys))
+	for _, k := range keys {
+		entries = append(entries, fmt.Sprintf("  %q: %s", k, fields[k]))
+	}
+	return fmt.Sprintf("{\n%s\n}", strings.Join(entries, ",\n"))
 }
 
 func auth(spec *obs.GoogleCloudLoggingAuthentication) string {
@@ -72,23 +253,9 @@ func LogDestination(sink *sinks.GcpStackdriverLogs, g *obs.GoogleCloudLogging) {
 	}
 }
 
-// NormalizeSeverity normalizes log severity to conform to GCL's standard
+// NormalizeSeverity normalizes the log severity held by the key to conform to GCL's standard
 // Accepted Severity: DEFAULT, EMERGENCY, ALERT, CRITICAL, ERROR, WARNING, NOTICE, INFO, DEBUG
 // Ref: https://cloud.google.com/logging/docs/reference/v2/rest/v2/LogEntry#logseverity
-func NormalizeSeverity(inputs ...string) types.Transform {
-	var vrl = `
-# Set audit log level to 'INFO'
-if .log_type == "audit" {
-	.level = "INFO"
-} else if !exists(.level) {
-  	.level = "DEFAULT"
-} else if .level == "warn" {
-	.level = "WARNING"
-} else if .level == "trace" {
-	.level = "DEBUG"
-} else {
-	.level = upcase!(.level) 
-}
-`
-	return transforms.NewRemap(vrl, inputs...)
+func NormalizeSeverity(key string, inputs ...string) types.Transform {
+	return transforms.NewRemap(fmt.Sprintf(severityTmpl, "."+key), inputs...)
 }
@@ -91,9 +91,9 @@ var _ = Describe("Generate Vector config", func() {
 			visit(&outputSpec)
 		}
 		adapter = adapters.NewOutput(outputSpec)
-		id, sink, transforms := gcl.New(outputSpec.Name, adapter, []string{"application"}, secrets, op)
+		sinks, transforms := gcl.New(outputSpec.Name, adapter, []string{"application"}, secrets, op)
 		Expect(exp).To(EqualConfigFrom(api.NewConfig(func(c *api.Config) {
-			c.Sinks[id] = sink
+			c.Sinks.Merge(sinks)
 			c.AddTransforms(transforms)
 		})))
 	},
@@ -120,5 +120,40 @@ var _ = Describe("Generate Vector config", func() {
 				BaseOutputTuningSpec: *baseTune,
 			}
 		}, framework.NoOptions, "gcl_with_tuning.toml"),
+		Entry("with project ID", func(spec *obs.OutputSpec) {
+			spec.GoogleCloudLogging.ID = obs.GoogleCloudLoggingId{
+				Type:  obs.GoogleCloudLoggingIdTypeProject,
+				Value: "my-project",
+			}
+		}, framework.NoOptions, "gcl_with_project_id.toml"),
+		Entry("with the region of the cluster as the location of the resources", func(spec *obs.OutputSpec) {
+			spec.GoogleCloudLogging.ID = obs.GoogleCloudLoggingId{
+				Type:  obs.GoogleCloudLoggingIdTypeProject,
+				Value: "my-project",
+			}
+		}, utils.Options{framework.OptionClusterRegion: "us-east1"}, "gcl_with_cluster_region.toml"),
+		Entry("with custom resource", func(spec *obs.OutputSpec) {
+			spec.GoogleCloudLogging.Resource = &obs.GoogleCloudLoggingResource{
+				Type: "generic_node",
+				Labels: map[string]string{
+					"location":  "us-east1",
+					"namespace": `{.kubernetes.namespace_name||"none"}`,
+					"node_id":   `{.hostname||"unknown"}`,
+				},
+			}
+		}, framework.NoOptions, "gcl_with_resource.toml"),
+		Entry("with labels, severity, trace and span keys", func(spec *obs.OutputSpec) {
+			spec.GoogleCloudLogging.ID = obs.GoogleCloudLoggingId{
+				Type:  obs.GoogleCloudLoggingIdTypeProject,
+				Value: "my-project",
+			}
+			spec.GoogleCloudLogging.SeverityKey = ".structured.severity"
+			spec.GoogleCloudLogging.Labels = map[string]string{
+				"app":      `{.kubernetes.labels."app.kubernetes.io/name"||"none"}`,
+				"log_type": `{.log_type||"none"}`,
+			}
+			spec.GoogleCloudLogging.TraceKey = ".trace_id"
+			spec.GoogleCloudLogging.SpanIdKey = ".span_id"
+		}, framework.NoOptions, "gcl_with_entry_metadata.toml"),
 	)
 })
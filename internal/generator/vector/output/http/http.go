@@ -9,7 +9,6 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sinks"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
-	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/codec"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/common/tls"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
@@ -20,7 +19,7 @@ func New(id string, o *adapters.Output, inputs []string, secrets observability.S
 		s.URI = o.HTTP.URL
 		s.Framing = framing(o.HTTP)
 		s.Auth = common.NewHttpAuth(o.HTTP.Authentication, op)
-		s.Encoding = common.NewApiEncoding(codec.CodecTypeJSON)
+		s.Encoding = common.NewEncoding(o.HTTP.Codec, op)
 		s.Compression = sinks.CompressionType(o.GetTuning().Compression)
 		s.Batch = common.NewApiBatch(o)
 		s.Buffer = common.NewApiBuffer(o)
@@ -39,6 +38,19 @@ func New(id string, o *adapters.Output, inputs []string, secrets observability.S
 }
 
 func framing(h *obs.HTTP) *sinks.Framing {
+	if h.Codec != nil {
+		switch h.Codec.Type {
+		case obs.HTTPCodecTypeNDJSON, obs.HTTPCodecTypeText, obs.HTTPCodecTypeCSV:
+			return &sinks.Framing{
+				Method: sinks.FramingMethodNewlineDelimited,
+			}
+		case obs.HTTPCodecTypeProtobuf:
+			return &sinks.Framing{
+				Method: sinks.FramingMethodLengthDelimited,
+			}
+		}
+		return nil
+	}
 	if h.Format == obs.HTTPFormatNDJSON {
 		return &sinks.Framing{
 			Method: sinks.FramingMethodNewlineDelimited,
@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
@@ -148,6 +149,43 @@ var _ = Describe("Generate vector config", func() {
 			Entry("with ndjson", func(spec *obs.OutputSpec) {
 				spec.HTTP.Format = obs.HTTPFormatNDJSON
 			}, secrets, framework.NoOptions, "http_with_ndjson.toml"),
+			Entry("with csv codec", func(spec *obs.OutputSpec) {
+				spec.HTTP.Authentication = nil
+				spec.HTTP.Codec = &obs.HTTPCodec{
+					Type:            obs.HTTPCodecTypeCSV,
+					CSVFields:       []obs.FieldPath{".timestamp", ".kubernetes.namespace_name", ".message"},
+					TimestampFormat: obs.TimestampFormatUnixMs,
+				}
+			}, secrets, framework.NoOptions, "http_with_csv_codec.toml"),
+			Entry("with protobuf codec", func(spec *obs.OutputSpec) {
+				spec.HTTP.Authentication = nil
+				spec.HTTP.Codec = &obs.HTTPCodec{
+					Type: obs.HTTPCodecTypeProtobuf,
+					Protobuf: &obs.ProtobufCodec{
+						Descriptor:  obs.ConfigMapReference{ConfigMapName: "codecs", Key: "records.desc"},
+						MessageType: "logs.Record",
+					},
+					ExceptFields: []obs.FieldPath{".kubernetes.labels"},
+				}
+			}, secrets, framework.NoOptions, "http_with_protobuf_codec.toml"),
+			Entry("with avro codec", func(spec *obs.OutputSpec) {
+				spec.HTTP.Authentication = nil
+				spec.HTTP.Codec = &obs.HTTPCodec{
+					Type: obs.HTTPCodecTypeAvro,
+					Avro: &obs.AvroCodec{
+						Schema: obs.ConfigMapReference{ConfigMapName: "codecs", Key: "schema.avsc"},
+					},
+					OnlyFields: []obs.FieldPath{".message", ".level"},
+				}
+			}, secrets, framework.Options{
+				framework.OptionConfigMaps: internalobs.ConfigMaps{
+					"codecs": {
+						Data: map[string]string{
+							"schema.avsc": `{"type":"record","name":"log","fields":[{"name":"message","type":"string"},{"name":"level","type":"string"}]}`,
+						},
+					},
+				},
+			}, "http_with_avro_codec.toml"),
 			Entry("with proxy", func(spec *obs.OutputSpec) {
 				spec.HTTP.ProxyURL = "http://somewhere.org/proxy"
 				spec.HTTP.Headers = nil
@@ -72,20 +72,52 @@ func determineTenants(inputSpecs []obs.InputSpec) *sets.String {
 		case obs.InputTypeInfrastructure:
 			tenants.Insert(string(obs.InputTypeInfrastructure))
 		case obs.InputTypeReceiver:
-			tenants.Insert(getTenantForReceiver(inputSpec.Receiver.Type))
+			tenants.Insert(getTenantForReceiver(inputSpec.Receiver))
+		case obs.InputTypeKafka:
+			if inputSpec.Kafka != nil {
+				tenants.Insert(receiverLogType(inputSpec.Kafka.LogType))
+			}
+		case obs.InputTypeEvents:
+			tenants.Insert(string(obs.InputTypeInfrastructure))
 		}
 	}
 
 	return tenants
 }
 
-func getTenantForReceiver(receiverType obs.ReceiverType) string {
-	if receiverType == obs.ReceiverTypeHTTP {
+func getTenantForReceiver(receiver *obs.ReceiverSpec) string {
+	switch receiver.Type {
+	case obs.ReceiverTypeHTTP:
+		if receiver.HTTP != nil && receiver.HTTP.Format != obs.HTTPReceiverFormatKubeAPIAudit {
+			return receiverLogType(receiver.HTTP.LogType)
+		}
 		return string(obs.InputTypeAudit)
+	case obs.ReceiverTypeOTLP:
+		if receiver.OTLP != nil {
+			return receiverLogType(receiver.OTLP.LogType)
+		}
+		return string(obs.InputTypeApplication)
+	case obs.ReceiverTypeFluentForward:
+		if receiver.FluentForward != nil {
+			return receiverLogType(receiver.FluentForward.LogType)
+		}
+		return string(obs.InputTypeApplication)
+	case obs.ReceiverTypeSplunkHEC:
+		if receiver.SplunkHEC != nil {
+			return receiverLogType(receiver.SplunkHEC.LogType)
+		}
+		return string(obs.InputTypeApplication)
 	}
 	return string(obs.InputTypeInfrastructure)
 }
 
+func receiverLogType(logType string) string {
+	if logType == "" {
+		return string(obs.InputTypeApplication)
+	}
+	return logType
+}
+
 func buildRoutes(tenants *sets.String) map[string]string {
 	routes := make(map[string]string, tenants.Len())
 	for _, tenant := range tenants.List() {
@@ -122,10 +154,20 @@ func getInputSources(inputSpecs []obs.InputSpec, inputType obs.InputType) []stri
 
 func addReceiverSources(inputSources *[]string, inputSpecs []obs.InputSpec, inputType obs.InputType) {
 	for _, is := range inputSpecs {
+		if is.Type == obs.InputTypeKafka && is.Kafka != nil && receiverLogType(is.Kafka.LogType) == string(inputType) {
+			*inputSources = append(*inputSources, observability.Inputs{is}.ReceiverLogSources()...)
+		}
+		if is.Type == obs.InputTypeEvents && inputType == obs.InputTypeInfrastructure {
+			*inputSources = append(*inputSources, observability.Inputs{is}.ReceiverLogSources()...)
+		}
 		if is.Type != obs.InputTypeReceiver {
 			continue
 		}
 
+		if getTenantForReceiver(is.Receiver) == string(inputType) {
+			*inputSources = append(*inputSources, observability.Inputs{is}.ReceiverLogSources()...)
+		}
+
 		if inputType == obs.InputTypeAudit && is.Receiver.Type == obs.ReceiverTypeHTTP {
 			*inputSources = append(*inputSources, "receiver.http")
 		}
@@ -193,3 +193,83 @@ var _ = Describe("Generate vector config", func() {
 		Entry("with ViaQ datamodel with receiver", "lokistack_viaq_receiver.toml", initReceiverOptions(), func(spec *obs.OutputSpec) {}),
 	)
 })
+
+var _ = DescribeTable("#getTenantForReceiver", func(receiver obs.ReceiverSpec, exp obs.InputType) {
+	Expect(getTenantForReceiver(&receiver)).To(Equal(string(exp)))
+},
+	Entry("should be audit for an HTTP receiver of kubeAPIAudit logs", obs.ReceiverSpec{Type: obs.ReceiverTypeHTTP, HTTP: &obs.HTTPReceiver{Format: obs.HTTPReceiverFormatKubeAPIAudit}}, obs.InputTypeAudit),
+	Entry("should be application for an HTTP receiver of json logs", obs.ReceiverSpec{Type: obs.ReceiverTypeHTTP, HTTP: &obs.HTTPReceiver{Format: obs.HTTPReceiverFormatJSON}}, obs.InputTypeApplication),
+	Entry("should be the log type of an HTTP receiver of ndjson logs", obs.ReceiverSpec{Type: obs.ReceiverTypeHTTP, HTTP: &obs.HTTPReceiver{Format: obs.HTTPReceiverFormatNDJSON, LogType: string(obs.InputTypeInfrastructure)}}, obs.InputTypeInfrastructure),
+	Entry("should be application for an otlp receiver", obs.ReceiverSpec{Type: obs.ReceiverTypeOTLP, OTLP: &obs.OTLPReceiver{GRPCPort: 4317}}, obs.InputTypeApplication),
+	Entry("should be the log type of a fluentForward receiver", obs.ReceiverSpec{Type: obs.ReceiverTypeFluentForward, FluentForward: &obs.FluentForwardReceiver{LogType: string(obs.InputTypeInfrastructure)}}, obs.InputTypeInfrastructure),
+	Entry("should be application for a splunkHEC receiver", obs.ReceiverSpec{Type: obs.ReceiverTypeSplunkHEC, SplunkHEC: &obs.SplunkHECReceiver{}}, obs.InputTypeApplication),
+	Entry("should be infrastructure for a syslog receiver", obs.ReceiverSpec{Type: obs.ReceiverTypeSyslog}, obs.InputTypeInfrastructure),
+)
+
+var _ = Describe("#determineTenants", func() {
+	It("should use the log type of kafka inputs", func() {
+		inputs := []obs.InputSpec{
+			{Name: "app-topics", Type: obs.InputTypeKafka, Kafka: &obs.KafkaInput{}},
+			{Name: "infra-topics", Type: obs.InputTypeKafka, Kafka: &obs.KafkaInput{LogType: string(obs.InputTypeInfrastructure)}},
+		}
+		Expect(determineTenants(inputs).List()).To(Equal([]string{string(obs.InputTypeApplication), string(obs.InputTypeInfrastructure)}))
+	})
+	It("should use the infrastructure tenant for events inputs", func() {
+		inputs := []obs.InputSpec{
+			{Name: "cluster-events", Type: obs.InputTypeEvents, Events: &obs.EventsInput{}},
+		}
+		Expect(determineTenants(inputs).List()).To(Equal([]string{string(obs.InputTypeInfrastructure)}))
+	})
+})
+
+var _ = Describe("#getInputSources", func() {
+	It("should include the log source of receivers for their tenant only", func() {
+		inputs := []obs.InputSpec{
+			{Name: obs.InputTypeApplication.String(), Type: obs.InputTypeApplication},
+			{Name: "my-http", Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{Type: obs.ReceiverTypeHTTP, HTTP: &obs.HTTPReceiver{Format: obs.HTTPReceiverFormatJSON}}},
+		}
+		Expect(getInputSources(inputs, obs.InputTypeApplication)).To(ConsistOf(string(obs.ApplicationSourceContainer), string(obs.ReceiverTypeHTTP)))
+		Expect(getInputSources(inputs, obs.InputTypeInfrastructure)).To(BeEmpty())
+	})
+	It("should include the log source of otlp receivers", func() {
+		inputs := []obs.InputSpec{
+			{Name: "my-otlp", Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{Type: obs.ReceiverTypeOTLP, OTLP: &obs.OTLPReceiver{GRPCPort: 4317}}},
+		}
+		Expect(getInputSources(inputs, obs.InputTypeApplication)).To(ConsistOf(string(obs.ReceiverTypeOTLP)))
+	})
+	It("should include the log source of fluentForward receivers for the tenant of their log type", func() {
+		inputs := []obs.InputSpec{
+			{Name: "my-fluent", Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{Type: obs.ReceiverTypeFluentForward, FluentForward: &obs.FluentForwardReceiver{LogType: string(obs.InputTypeInfrastructure)}}},
+		}
+		Expect(getInputSources(inputs, obs.InputTypeInfrastructure)).To(ConsistOf(string(obs.ReceiverTypeFluentForward)))
+		Expect(getInputSources(inputs, obs.InputTypeApplication)).To(BeEmpty())
+	})
+	It("should include the log source of splunkHEC receivers", func() {
+		inputs := []obs.InputSpec{
+			{Name: "my-hec", Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{Type: obs.ReceiverTypeSplunkHEC, SplunkHEC: &obs.SplunkHECReceiver{}}},
+		}
+		Expect(getInputSources(inputs, obs.InputTypeApplication)).To(ConsistOf(string(obs.ReceiverTypeSplunkHEC)))
+	})
+	It("should include the log source of kafka inputs for the tenant of their log type", func() {
+		inputs := []obs.InputSpec{
+			{Name: "infra-topics", Type: obs.InputTypeKafka, Kafka: &obs.KafkaInput{LogType: string(obs.InputTypeInfrastructure)}},
+		}
+		Expect(getInputSources(inputs, obs.InputTypeInfrastructure)).To(ConsistOf(string(obs.InputTypeKafka)))
+		Expect(getInputSources(inputs, obs.InputTypeApplication)).To(BeEmpty())
+	})
+	It("should include the log source of events inputs for the infrastructure tenant", func() {
+		inputs := []obs.InputSpec{
+			{Name: "cluster-events", Type: obs.InputTypeEvents, Events: &obs.EventsInput{}},
+		}
+		Expect(getInputSources(inputs, obs.InputTypeInfrastructure)).To(ConsistOf(string(obs.InputTypeEvents)))
+	})
+	It("should include the file source of infrastructure inputs", func() {
+		inputs := []obs.InputSpec{
+			{Name: "gpu-logs", Type: obs.InputTypeInfrastructure, Infrastructure: &obs.Infrastructure{
+				Sources: []obs.InfrastructureSource{obs.InfrastructureSourceFile},
+				Files:   &obs.NodeFiles{Includes: []string{"/var/log/gpu/*.log"}},
+			}},
+		}
+		Expect(getInputSources(inputs, obs.InputTypeInfrastructure)).To(ConsistOf(string(obs.InfrastructureSourceFile)))
+	})
+})
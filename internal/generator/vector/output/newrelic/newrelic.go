@@ -0,0 +1,44 @@
+package newrelic
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sinks"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+)
+
+func New(id string, o *adapters.Output, inputs []string, secrets observability.Secrets, op utils.Options) (_ string, sink types.Sink, tfs api.Transforms) {
+	if o.NewRelic == nil {
+		return "", nil, nil
+	}
+	n := o.NewRelic
+	sink = sinks.NewNewRelic(n.AccountID, func(s *sinks.NewRelic) {
+		s.LicenseKey = licenseKey(n.Authentication)
+		s.Region = string(region(n))
+		s.Compression = sinks.CompressionType(o.GetTuning().Compression)
+		s.Encoding = common.NewApiEncoding("")
+		s.Batch = common.NewApiBatch(o)
+		s.Buffer = common.NewApiBuffer(o)
+		s.Request = common.NewApiRequest(o)
+	}, inputs...)
+	return id, sink, tfs
+}
+
+func licenseKey(spec *obs.NewRelicAuthentication) string {
+	if spec == nil || spec.LicenseKey == nil {
+		return ""
+	}
+	return helpers.SecretFrom(spec.LicenseKey)
+}
+
+func region(n *obs.NewRelic) obs.NewRelicRegion {
+	if n.Region == "" {
+		return obs.NewRelicRegionUS
+	}
+	return n.Region
+}
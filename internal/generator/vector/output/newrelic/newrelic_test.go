@@ -0,0 +1,84 @@
+package newrelic_test
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/newrelic"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("Generate Vector config", func() {
+	const (
+		secretName = "newrelic-secret"
+		licenseKey = "license-key"
+	)
+	var (
+		adapter    *adapters.Output
+		initOutput = func() obs.OutputSpec {
+			return obs.OutputSpec{
+				Type: obs.OutputTypeNewRelic,
+				Name: "newrelic",
+				NewRelic: &obs.NewRelic{
+					AccountID: "1234567",
+					Authentication: &obs.NewRelicAuthentication{
+						LicenseKey: &obs.SecretReference{
+							Key:        licenseKey,
+							SecretName: secretName,
+						},
+					},
+				},
+			}
+		}
+		secrets = map[string]*corev1.Secret{
+			secretName: {
+				Data: map[string][]byte{
+					licenseKey: []byte("dummy"),
+				},
+			},
+		}
+
+		baseTune = &obs.BaseOutputTuningSpec{
+			DeliveryMode:     obs.DeliveryModeAtLeastOnce,
+			MaxWrite:         utils.GetPtr(resource.MustParse("10M")),
+			MaxRetryDuration: utils.GetPtr(time.Duration(35)),
+			MinRetryDuration: utils.GetPtr(time.Duration(20)),
+		}
+	)
+	DescribeTable("For New Relic output", func(visit func(spec *obs.OutputSpec), op utils.Options, expFile string) {
+		exp, err := tomlContent.ReadFile(expFile)
+		if err != nil {
+			Fail(fmt.Sprintf("Error reading the file %q with exp config: %v", expFile, err))
+		}
+		outputSpec := initOutput()
+		if visit != nil {
+			visit(&outputSpec)
+		}
+		adapter = adapters.NewOutput(outputSpec)
+		id, sink, transforms := newrelic.New(outputSpec.Name, adapter, []string{"application"}, secrets, op)
+		Expect(exp).To(EqualConfigFrom(api.NewConfig(func(c *api.Config) {
+			c.Sinks[id] = sink
+			c.AddTransforms(transforms)
+		})))
+	},
+		Entry("with defaults", nil, framework.NoOptions, "newrelic_with_defaults.toml"),
+		Entry("with EU region", func(spec *obs.OutputSpec) {
+			spec.NewRelic.Region = obs.NewRelicRegionEU
+		}, framework.NoOptions, "newrelic_with_eu_region.toml"),
+		Entry("with tuning", func(spec *obs.OutputSpec) {
+			spec.NewRelic.Tuning = &obs.NewRelicTuningSpec{
+				BaseOutputTuningSpec: *baseTune,
+				Compression:          "none",
+			}
+		}, framework.NoOptions, "newrelic_with_tuning.toml"),
+	)
+})
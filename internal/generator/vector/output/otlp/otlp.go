@@ -29,16 +29,25 @@ const (
 )
 
 const (
-	logSourceContainer    = string(obs.ApplicationSourceContainer)
-	logSourceNode         = string(obs.InfrastructureSourceNode)
-	logSourceAuditd       = string(obs.AuditSourceAuditd)
-	logSourceKubeAPI      = string(obs.AuditSourceKube)
-	logSourceOpenshiftAPI = string(obs.AuditSourceOpenShift)
-	logSourceOvn          = string(obs.AuditSourceOVN)
+	logSourceContainer     = string(obs.ApplicationSourceContainer)
+	logSourceNode          = string(obs.InfrastructureSourceNode)
+	logSourceFile          = string(obs.InfrastructureSourceFile)
+	logSourceAuditd        = string(obs.AuditSourceAuditd)
+	logSourceKubeAPI       = string(obs.AuditSourceKube)
+	logSourceOpenshiftAPI  = string(obs.AuditSourceOpenShift)
+	logSourceOvn           = string(obs.AuditSourceOVN)
+	logSourceOTLP          = string(obs.ReceiverTypeOTLP)
+	logSourceHTTP          = string(obs.ReceiverTypeHTTP)
+	logSourceFluentForward = string(obs.ReceiverTypeFluentForward)
+	logSourceSplunkHEC     = string(obs.ReceiverTypeSplunkHEC)
+	logSourceKafka         = string(obs.InputTypeKafka)
+	logSourceEvents        = string(obs.InputTypeEvents)
 )
 
 var (
 	allLogSources = []string{logSourceContainer, logSourceNode, logSourceAuditd, logSourceKubeAPI, logSourceOpenshiftAPI, logSourceOvn}
+	// receiverLogSources are the log sources of the receiver, kafka and events inputs which are transformed alike
+	receiverLogSources = []string{logSourceHTTP, logSourceFluentForward, logSourceSplunkHEC, logSourceKafka, logSourceEvents}
 )
 
 type logSources []string
@@ -58,7 +67,20 @@ func New(id string, o *adapters.Output, inputs []string, secrets observability.S
 	if len(opSources) == 0 {
 		panic("InputSources not found while generating config")
 	}
-	sources := logSources(opSources)
+	sources := append(logSources{}, opSources...)
+	// Logs of the receivers and the files of the node are only routed when received by the output
+	clfSpec, _ := utils.GetOption(op, helpers.CLFSpec, observability.ClusterLogForwarderSpec{})
+	inputSpecs := observability.Inputs(clfSpec.InputSpecsTo(o.OutputSpec))
+	for _, source := range inputSpecs.ReceiverLogSources() {
+		if !sources.Has(source) {
+			sources = append(sources, source)
+		}
+	}
+	if inputSpecs.HasNodeFileSource() && !sources.Has(logSourceFile) {
+		sources = append(sources, logSourceFile)
+	}
+	// Logs of OTLP receivers are forwarded with their original resource, scope and log record
+	otlpReceiver := sources.Has(logSourceOTLP)
 	tfs := api.Transforms{}
 	rerouteID := helpers.MakeID(id, "reroute") // "output_my_id_reroute
 
@@ -97,6 +119,15 @@ func New(id string, o *adapters.Output, inputs []string, secrets observability.S
 		groupByHostInputs = append(groupByHostInputs, transformNodeID)
 	}
 
+	if sources.Has(logSourceFile) {
+		// Node files
+		transformNodeFileID := helpers.MakeID(id, logSourceFile)
+		transformNodeFileRouteID := helpers.MakeRouteInputID(rerouteID, logSourceFile)
+		tfs[transformNodeFileID] = TransformNodeFile([]string{transformNodeFileRouteID})
+
+		groupByHostInputs = append(groupByHostInputs, transformNodeFileID)
+	}
+
 	if sources.Has(logSourceAuditd) {
 		// Audit
 		transformAuditHostID := helpers.MakeID(id, logSourceAuditd)
@@ -124,6 +155,14 @@ func New(id string, o *adapters.Output, inputs []string, secrets observability.S
 		groupBySourceInputs = append(groupBySourceInputs, transformAuditOvnID)
 	}
 
+	for _, source := range receiverLogSources {
+		if sources.Has(source) {
+			transformReceiverID := helpers.MakeID(id, source)
+			tfs[transformReceiverID] = TransformReceiver([]string{helpers.MakeRouteInputID(rerouteID, source)})
+			groupByHostInputs = append(groupByHostInputs, transformReceiverID)
+		}
+	}
+
 	// Group by cluster_id, log_source
 	if len(groupBySourceInputs) > 0 {
 		reduceSourceID := helpers.MakeID(id, "groupby", "source")
@@ -140,6 +179,13 @@ func New(id string, o *adapters.Output, inputs []string, secrets observability.S
 	// Normalize all into resource and scopeLogs objects
 	formatResourceLogsID := helpers.MakeID(id, "resource", "logs")
 	tfs[formatResourceLogsID] = FormatResourceLog(reduceInputs)
+	sinkInputs := []string{formatResourceLogsID}
+
+	if otlpReceiver {
+		transformOTLPID := helpers.MakeID(id, logSourceOTLP)
+		tfs[transformOTLPID] = TransformOTLPReceiver([]string{helpers.MakeRouteInputID(rerouteID, logSourceOTLP)})
+		sinkInputs = append(sinkInputs, transformOTLPID)
+	}
 
 	return id, sinks.NewOpenTelemetry(o.OTLP.URL, func(s *sinks.OpenTelemetry) {
 			s.Protocol.Type = "http"
@@ -158,7 +204,7 @@ func New(id string, o *adapters.Output, inputs []string, secrets observability.S
 			}
 			s.Protocol.Auth = common.NewHttpAuth(o.OTLP.Authentication, op)
 
-		}, formatResourceLogsID),
+		}, sinkInputs...),
 		tfs
 }
 
@@ -75,6 +75,27 @@ var _ = Describe("Generate vector config", func() {
 				}),
 			}
 		}
+		// withReceiverInput returns options for an output receiving the container logs and the logs of the given input
+		withReceiverInput = func(input obs.InputSpec) utils.Options {
+			output := initOutput()
+			return utils.Options{
+				OtlpLogSourcesOption: []string{obs.ApplicationSourceContainer.String()},
+				helpers.CLFSpec: observability.ClusterLogForwarderSpec(obs.ClusterLogForwarderSpec{
+					Outputs: []obs.OutputSpec{output},
+					Pipelines: []obs.PipelineSpec{
+						{
+							Name:       "otel",
+							InputRefs:  []string{obs.InputTypeApplication.String(), input.Name},
+							OutputRefs: []string{output.Name},
+						},
+					},
+					Inputs: []obs.InputSpec{
+						{Name: obs.InputTypeApplication.String(), Type: obs.InputTypeApplication},
+						input,
+					},
+				}),
+			}
+		}
 	)
 
 	DescribeTable("for OTLP output", func(secret observability.Secrets, op utils.Options, tune bool, visit func(spec *obs.OutputSpec), expFile string) {
@@ -196,5 +217,117 @@ var _ = Describe("Generate vector config", func() {
 			},
 			"otlp_with_auth_basic.toml",
 		),
+		Entry("with an OTLP receiver",
+			nil,
+			utils.Options{
+				OtlpLogSourcesOption: []string{obs.ApplicationSourceContainer.String()},
+				helpers.CLFSpec: observability.ClusterLogForwarderSpec(obs.ClusterLogForwarderSpec{
+					Outputs: []obs.OutputSpec{initOutput()},
+					Pipelines: []obs.PipelineSpec{
+						{
+							Name:       "otel",
+							InputRefs:  []string{obs.InputTypeApplication.String(), "my-otlp"},
+							OutputRefs: []string{initOutput().Name},
+						},
+					},
+					Inputs: []obs.InputSpec{
+						{Name: obs.InputTypeApplication.String(), Type: obs.InputTypeApplication},
+						{
+							Name: "my-otlp",
+							Type: obs.InputTypeReceiver,
+							Receiver: &obs.ReceiverSpec{
+								Type: obs.ReceiverTypeOTLP,
+								Port: 4318,
+								OTLP: &obs.OTLPReceiver{GRPCPort: 4317},
+							},
+						},
+					},
+				}),
+			},
+			false,
+			nil,
+			"otlp_with_otlp_receiver.toml",
+		),
+		Entry("with an HTTP receiver of application logs",
+			nil,
+			withReceiverInput(obs.InputSpec{
+				Name: "my-http",
+				Type: obs.InputTypeReceiver,
+				Receiver: &obs.ReceiverSpec{
+					Type: obs.ReceiverTypeHTTP,
+					Port: 8080,
+					HTTP: &obs.HTTPReceiver{Format: obs.HTTPReceiverFormatJSON},
+				},
+			}),
+			false,
+			nil,
+			"otlp_with_http_receiver.toml",
+		),
+		Entry("with a fluentForward receiver",
+			nil,
+			withReceiverInput(obs.InputSpec{
+				Name: "my-fluent",
+				Type: obs.InputTypeReceiver,
+				Receiver: &obs.ReceiverSpec{
+					Type:          obs.ReceiverTypeFluentForward,
+					Port:          24224,
+					FluentForward: &obs.FluentForwardReceiver{},
+				},
+			}),
+			false,
+			nil,
+			"otlp_with_fluent_forward_receiver.toml",
+		),
+		Entry("with a splunkHEC receiver",
+			nil,
+			withReceiverInput(obs.InputSpec{
+				Name: "my-hec",
+				Type: obs.InputTypeReceiver,
+				Receiver: &obs.ReceiverSpec{
+					Type:      obs.ReceiverTypeSplunkHEC,
+					Port:      8088,
+					SplunkHEC: &obs.SplunkHECReceiver{},
+				},
+			}),
+			false,
+			nil,
+			"otlp_with_splunk_hec_receiver.toml",
+		),
+		Entry("with a kafka input",
+			nil,
+			withReceiverInput(obs.InputSpec{
+				Name:  "my-topics",
+				Type:  obs.InputTypeKafka,
+				Kafka: &obs.KafkaInput{},
+			}),
+			false,
+			nil,
+			"otlp_with_kafka_input.toml",
+		),
+		Entry("with an events input",
+			nil,
+			withReceiverInput(obs.InputSpec{
+				Name:   "cluster-events",
+				Type:   obs.InputTypeEvents,
+				Events: &obs.EventsInput{},
+			}),
+			false,
+			nil,
+			"otlp_with_events_input.toml",
+		),
+		Entry("with a file infrastructure source",
+			nil,
+			withReceiverInput(obs.InputSpec{
+				Name: "gpu-logs",
+				Type: obs.InputTypeInfrastructure,
+				Infrastructure: &obs.Infrastructure{
+					Sources: []obs.InfrastructureSource{obs.InfrastructureSourceFile},
+					Files:   &obs.NodeFiles{Includes: []string{"/var/log/gpu/*.log"}},
+				},
+			}),
+			false,
+			nil,
+			"otlp_with_node_file_source.toml",
+		),
 	)
 })
@@ -113,6 +113,12 @@ r.attributes = append(r.attributes,
 	{"key": "level", "value": {"stringValue": .level}}
   ]
 )
+`
+	ReceiverLogAttributes = `
+if exists(.level) { r.severityText = .level }
+if is_string(.kubernetes.namespace_name) {
+  r.attributes = push(r.attributes, {"key": "k8s.namespace.name", "value": {"stringValue": .kubernetes.namespace_name}})
+}
 `
 	NodeResourceAttributes = `
 resource.attributes = append(resource.attributes,
@@ -127,6 +133,14 @@ resource.attributes = append(resource.attributes,
 `
 	NodeLogAttributes = `
 r.attributes = append(r.attributes, [{"key": "level", "value": {"stringValue": .level}}])
+`
+	NodeFileLogAttributes = `
+r.attributes = append(r.attributes,
+  [
+	{"key": "log.file.path", "value": {"stringValue": .file}},
+	{"key": "level", "value": {"stringValue": .level}}
+  ]
+)
 `
 	FinalGrouping = `
 # Openshift object for grouping (dropped before sending)
@@ -180,6 +194,40 @@ resource.attributes = append( resource.attributes,
 	{"key": "kubernetes.container_name", "value": {"stringValue": .kubernetes.container_name}},
 	{"key": "kubernetes.namespace_name", "value": {"stringValue": .kubernetes.namespace_name}}]
 )
+`
+
+	// OTLPReceiverResourceLogs restores the original resource, scope and log record of the OTLP receivers. The fields
+	// of the record may be modified by the filters of the pipeline, so the labels of the record are added to the resource
+	// and the attributes, body and severity removed from the record are removed from the log record
+	OTLPReceiverResourceLogs = `
+resource_log = object(._internal.otlp) ?? {}
+resource = object(resource_log.resource) ?? {}
+for_each(object(.openshift.labels) ?? {}) -> |key, value| {
+  resource.attributes = push(array(resource.attributes) ?? [], {"key": "openshift.label." + key, "value": {"stringValue": value}})
+}
+scope_log = object(resource_log.scopeLogs[0]) ?? {}
+r = object(scope_log.logRecords[0]) ?? {}
+if exists(r.attributes) {
+  structured_keys = keys(object(.structured) ?? {})
+  attributes = []
+  for_each(array(r.attributes) ?? []) -> |_index, attribute| {
+    if includes(structured_keys, attribute.key) {
+      attributes = push(attributes, attribute)
+    }
+  }
+  r.attributes = attributes
+}
+if !exists(.message) {
+  del(r.body)
+}
+if !exists(.level) {
+  del(r.severityText)
+  del(r.severityNumber)
+}
+scope_log.logRecords = [r]
+resource_log.resource = resource
+resource_log.scopeLogs = [scope_log]
+. = resource_log
 `
 )
 
@@ -214,6 +262,33 @@ func nodeLogsVRL() string {
 	}), "\n")
 }
 
+func receiverLogsVRL() string {
+	return strings.Join(helpers.TrimSpaces([]string{
+		BaseResourceAttributes,
+		BackwardCompatBaseResourceAttributes,
+		LogRecord,
+		BodyFromMessage,
+		LogRecordTraceContext,
+		LogAttributes,
+		ReceiverLogAttributes,
+		FinalGrouping,
+	}), "\n")
+}
+
+func nodeFileLogsVRL() string {
+	return strings.Join(helpers.TrimSpaces([]string{
+		BaseResourceAttributes,
+		BackwardCompatBaseResourceAttributes,
+		LogRecord,
+		LogRecordSeverity,
+		BodyFromMessage,
+		LogRecordTraceContext,
+		LogAttributes,
+		NodeFileLogAttributes,
+		FinalGrouping,
+	}), "\n")
+}
+
 func auditHostLogsVRL() string {
 	return strings.Join(helpers.TrimSpaces([]string{
 		BaseResourceAttributes,
@@ -260,6 +335,16 @@ func TransformJournal(inputs []string) types.Transform {
 	return transforms.NewRemap(nodeLogsVRL(), inputs...)
 }
 
+// TransformNodeFile transforms the logs of the files of the node
+func TransformNodeFile(inputs []string) types.Transform {
+	return transforms.NewRemap(nodeFileLogsVRL(), inputs...)
+}
+
+// TransformReceiver transforms the logs of the receiver, kafka and events inputs, which are grouped by the host of the collector
+func TransformReceiver(inputs []string) types.Transform {
+	return transforms.NewRemap(receiverLogsVRL(), inputs...)
+}
+
 func TransformAuditHost(inputs []string) types.Transform {
 	return transforms.NewRemap(auditHostLogsVRL(), inputs...)
 }
@@ -276,6 +361,11 @@ func TransformAuditOvn(inputs []string) types.Transform {
 	return transforms.NewRemap(auditOVNLogsVRL(), inputs...)
 }
 
+// TransformOTLPReceiver forwards the logs of OTLP receivers with their original resource, scope and log record
+func TransformOTLPReceiver(inputs []string) types.Transform {
+	return transforms.NewRemap(OTLPReceiverResourceLogs, inputs...)
+}
+
 // FormatResourceLog Drops everything except resource.attributes and scopeLogs.logRecords
 func FormatResourceLog(inputs []string) types.Transform {
 	return transforms.NewRemap(`
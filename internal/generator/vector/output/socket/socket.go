@@ -0,0 +1,159 @@
+package socket
+
+import (
+	"net/url"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sinks"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/codec"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/transport"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/common/tls"
+	vectorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+)
+
+const (
+	TCP  = `tcp`
+	TLS  = `tls`
+	UDP  = `udp`
+	Unix = `unix`
+
+	// gelfVRL ensures the fields required by the GELF codec exist
+	gelfVRL = `
+if !exists(.host) {
+  .host = .hostname
+}
+if !is_string(.message) {
+  .message = encode_json(.message)
+}
+`
+)
+
+func New(id string, o *adapters.Output, inputs []string, secrets observability.Secrets, op utils.Options) (_ string, sink types.Sink, tfs api.Transforms) {
+	tfs = api.Transforms{}
+	codecType := obs.SocketCodecTypeJSON
+	if o.Socket.Codec != nil {
+		codecType = o.Socket.Codec.Type
+	}
+	if codecType == obs.SocketCodecTypeGELF {
+		gelfID := vectorhelpers.MakeID(id, "gelf")
+		tfs[gelfID] = transforms.NewRemap(gelfVRL, inputs...)
+		inputs = []string{gelfID}
+	}
+
+	// the URL is validated by the pattern of the API, so it always parses
+	u, _ := url.Parse(o.Socket.URL)
+	scheme := strings.ToLower(u.Scheme)
+	mode := socketMode(scheme)
+	sink = sinks.NewSocket(mode, func(s *sinks.Socket) {
+		if mode == sinks.SocketModeUnixStream {
+			s.Path = u.Path
+		} else {
+			s.Address = u.Host
+		}
+		if mode == sinks.SocketModeTCP {
+			s.Keepalive = &sinks.Keepalive{
+				TimeSecs: 60,
+			}
+			s.TLS = newTls(scheme, o, secrets, op)
+		}
+		if mode != sinks.SocketModeUDP {
+			s.Framing = newFraming(o.Socket.Framing)
+		}
+		s.Encoding = newEncoding(codecType, o.Socket.Codec)
+		s.Buffer = common.NewApiBuffer(o)
+	}, inputs...)
+	return id, sink, tfs
+}
+
+func socketMode(scheme string) sinks.SocketMode {
+	switch scheme {
+	case TCP, TLS:
+		return sinks.SocketModeTCP
+	case UDP:
+		return sinks.SocketModeUDP
+	case Unix:
+		return sinks.SocketModeUnixStream
+	default:
+		return sinks.SocketMode(scheme)
+	}
+}
+
+// newTls enables TLS for the `tls` scheme even when no CA is given so the server is verified using the system trust
+func newTls(scheme string, o *adapters.Output, secrets observability.Secrets, op utils.Options) *transport.TlsEnabled {
+	if scheme != TLS {
+		return tls.NewTlsEnabled(o, secrets, op)
+	}
+	conf := tls.NewTls(o, secrets, op)
+	if conf == nil {
+		conf = &transport.TLS{}
+	}
+	return &transport.TlsEnabled{
+		TLS:     *conf,
+		Enabled: true,
+	}
+}
+
+func newFraming(spec *obs.SocketFraming) *sinks.Framing {
+	if spec == nil {
+		return &sinks.Framing{
+			Method: sinks.FramingMethodNewlineDelimited,
+		}
+	}
+	switch spec.Method {
+	case obs.SocketFramingMethodLengthDelimited:
+		return &sinks.Framing{
+			Method: sinks.FramingMethodLengthDelimited,
+		}
+	case obs.SocketFramingMethodCharacterDelimited:
+		return &sinks.Framing{
+			Method: sinks.FramingMethodCharacterDelimited,
+			CharacterDelimited: &sinks.CharacterDelimitedFraming{
+				Delimiter: spec.Delimiter,
+			},
+		}
+	}
+	return &sinks.Framing{
+		Method: sinks.FramingMethodNewlineDelimited,
+	}
+}
+
+func newEncoding(codecType obs.SocketCodecType, spec *obs.SocketCodec) *sinks.SocketEncoding {
+	switch codecType {
+	case obs.SocketCodecTypeText:
+		return &sinks.SocketEncoding{
+			Codec: codec.CodecTypeText,
+		}
+	case obs.SocketCodecTypeRawMessage:
+		return &sinks.SocketEncoding{
+			Codec: codec.CodecTypeRawMessage,
+		}
+	case obs.SocketCodecTypeGELF:
+		return &sinks.SocketEncoding{
+			Codec:        codec.CodecTypeGELF,
+			ExceptFields: []string{"_internal"},
+		}
+	case obs.SocketCodecTypeCSV:
+		fields := []string{}
+		for _, f := range spec.CSVFields {
+			fields = append(fields, strings.TrimPrefix(string(f), "."))
+		}
+		return &sinks.SocketEncoding{
+			Codec: codec.CodecTypeCSV,
+			CSV: &codec.CSV{
+				Fields: fields,
+			},
+		}
+	}
+	return &sinks.SocketEncoding{
+		Codec:        codec.CodecTypeJSON,
+		ExceptFields: []string{"_internal"},
+	}
+}
@@ -0,0 +1,100 @@
+package socket_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/socket"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("vector socket clf output", func() {
+
+	const (
+		secretName = "socket-tls"
+	)
+
+	var (
+		initOutput = func() obs.OutputSpec {
+			return obs.OutputSpec{
+				Type: obs.OutputTypeSocket,
+				Name: "example",
+				Socket: &obs.Socket{
+					URL: "tcp://collector.example.com:5170",
+				},
+			}
+		}
+		secrets = map[string]*corev1.Secret{
+			secretName: {
+				Data: map[string][]byte{
+					"ca-bundle.crt": []byte("baz"),
+				},
+			},
+		}
+	)
+
+	DescribeTable("#New", func(expFile string, visit func(spec *obs.OutputSpec)) {
+		exp, err := tomlContent.ReadFile(expFile)
+		if err != nil {
+			Fail(fmt.Sprintf("Error reading the file %q with exp config: %v", expFile, err))
+		}
+		outputSpec := initOutput()
+		if visit != nil {
+			visit(&outputSpec)
+		}
+
+		adapter := adapters.NewOutput(outputSpec)
+		id, sink, transforms := socket.New(helpers.MakeID("output", outputSpec.Name), adapter, []string{"application"}, secrets, framework.NoOptions)
+		Expect(exp).To(EqualConfigFrom(api.NewConfig(func(c *api.Config) {
+			c.Sinks[id] = sink
+			c.AddTransforms(transforms)
+		})))
+	},
+		Entry("with tcp and the default json codec and newline framing", "tcp_with_defaults.toml", nil),
+		Entry("with tls, csv codec and character delimited framing", "tls_with_csv.toml", func(spec *obs.OutputSpec) {
+			spec.Socket.URL = "tls://collector.example.com:6514"
+			spec.TLS = &obs.OutputTLSSpec{
+				TLSSpec: obs.TLSSpec{
+					CA: &obs.ValueReference{
+						Key:        constants.TrustedCABundleKey,
+						SecretName: secretName,
+					},
+				},
+			}
+			spec.Socket.Framing = &obs.SocketFraming{
+				Method:    obs.SocketFramingMethodCharacterDelimited,
+				Delimiter: "|",
+			}
+			spec.Socket.Codec = &obs.SocketCodec{
+				Type:      obs.SocketCodecTypeCSV,
+				CSVFields: []obs.FieldPath{".kubernetes.namespace_name", `.kubernetes.labels."app.kubernetes.io/name"`, ".message"},
+			}
+		}),
+		Entry("with udp and the gelf codec", "udp_with_gelf.toml", func(spec *obs.OutputSpec) {
+			spec.Socket.URL = "udp://graylog.example.com:12201"
+			spec.Socket.Codec = &obs.SocketCodec{
+				Type: obs.SocketCodecTypeGELF,
+			}
+			spec.Socket.Tuning = &obs.SocketTuningSpec{
+				DeliveryMode: obs.DeliveryModeAtMostOnce,
+			}
+		}),
+		Entry("with unix, raw message codec and length delimited framing", "unix_with_raw_message.toml", func(spec *obs.OutputSpec) {
+			spec.Socket.URL = "unix:///var/run/collector/logs.sock"
+			spec.Socket.Framing = &obs.SocketFraming{
+				Method: obs.SocketFramingMethodLengthDelimited,
+			}
+			spec.Socket.Codec = &obs.SocketCodec{
+				Type: obs.SocketCodecTypeRawMessage,
+			}
+		}),
+	)
+})
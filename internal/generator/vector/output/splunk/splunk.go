@@ -100,6 +100,18 @@ for_each(indexed_fields) -> |_, field| {
 }
 `
 
+// VRL to keep the 'sourcetype' of events received by Splunk HEC receivers
+var hecSourceTypeVRL = `
+hec_sourcetype = ._internal.splunk.sourcetype
+`
+
+// VRL to restore the 'sourcetype' of events received by Splunk HEC receivers
+var restoreHECSourceTypeVRL = `
+if ._internal.log_source == "splunkHEC" && hec_sourcetype != null {
+	._internal.splunk.sourcetype = hec_sourcetype
+}
+`
+
 func New(id string, o *adapters.Output, inputs []string, secrets observability.Secrets, op utils.Options) (_ string, sink types.Sink, tfs api.Transforms) {
 	inputID := vectorhelpers.MakeID(id, "timestamp")
 	tfs = api.Transforms{}
@@ -110,7 +122,15 @@ func New(id string, o *adapters.Output, inputs []string, secrets observability.S
 		inputID = splunkIndexID
 	}
 
+	// Events of Splunk HEC receivers keep their index and sourcetype unless they are specified by the output
+	clfSpec, _ := utils.GetOption(op, vectorhelpers.CLFSpec, observability.ClusterLogForwarderSpec{})
+	hecReceiver := observability.Inputs(clfSpec.InputSpecsTo(o.OutputSpec)).HasReceiverType(obs.ReceiverTypeSplunkHEC)
+	keepHECSourceType := hecReceiver && o.Splunk.SourceType == ""
+
 	var builder strings.Builder
+	if keepHECSourceType {
+		builder.WriteString(hecSourceTypeVRL)
+	}
 	if o.Splunk.Source != "" {
 		builder.WriteString(fmt.Sprintf("._internal.splunk.source = %s", commontemplate.TransformUserTemplateToVRL(o.Splunk.Source)))
 	} else {
@@ -129,6 +149,9 @@ func New(id string, o *adapters.Output, inputs []string, secrets observability.S
 	} else {
 		builder.WriteString("\n._internal.splunk.sourcetype = \"_json\"\n")
 	}
+	if keepHECSourceType {
+		builder.WriteString(restoreHECSourceTypeVRL)
+	}
 
 	var indexedFields []string
 	if o.Splunk.IndexedFields != nil {
@@ -142,7 +165,7 @@ func New(id string, o *adapters.Output, inputs []string, secrets observability.S
 
 	tfs[vectorhelpers.MakeID(id, "timestamp")] = fixTimestampFormat(inputs)
 	sink = sinks.NewSplunkHecLogs(o.Splunk.URL, func(s *sinks.SplunkHecLogs) {
-		s.Index = tenant(o.Splunk, splunkIndexID)
+		s.Index = tenant(o.Splunk, splunkIndexID, hecReceiver)
 		s.DefaultToken = defaultToken(o.Splunk)
 		s.Compression = sinks.CompressionType(o.GetTuning().Compression)
 		s.Source = "{{ ._internal.splunk.source }}"
@@ -183,8 +206,11 @@ func hasIndexKey(s *obs.Splunk) bool {
 	return s != nil && s.Index != ""
 }
 
-func tenant(s *obs.Splunk, index string) string {
+func tenant(s *obs.Splunk, index string, hecReceiver bool) string {
 	if !hasIndexKey(s) {
+		if hecReceiver {
+			return "{{ ._internal.splunk.index }}"
+		}
 		return ""
 	}
 	return fmt.Sprintf("{{ ._internal.%s }}", index)
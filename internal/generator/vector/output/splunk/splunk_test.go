@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/api/observability"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/generator/framework"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/adapters"
@@ -131,5 +132,30 @@ var _ = Describe("Generating vector config for Splunk output", func() {
 		Entry("with payloadKey and static sourceType", "splunk_sink_with_payloadkey_and_static_sourcetype.toml", framework.NoOptions, func(spec *obs.OutputSpec) {
 			spec.Splunk.PayloadKey = ".message"
 			spec.Splunk.SourceType = "custom-type"
-		}))
+		}),
+		Entry("with a splunkHEC receiver", "splunk_sink_with_splunk_hec_receiver.toml", utils.Options{
+			helpers.CLFSpec: observability.ClusterLogForwarderSpec(obs.ClusterLogForwarderSpec{
+				Outputs: []obs.OutputSpec{initOutput()},
+				Pipelines: []obs.PipelineSpec{
+					{
+						Name:       "hec",
+						InputRefs:  []string{"my-hec"},
+						OutputRefs: []string{initOutput().Name},
+					},
+				},
+				Inputs: []obs.InputSpec{
+					{
+						Name: "my-hec",
+						Type: obs.InputTypeReceiver,
+						Receiver: &obs.ReceiverSpec{
+							Type: obs.ReceiverTypeSplunkHEC,
+							Port: 8088,
+							SplunkHEC: &obs.SplunkHECReceiver{
+								Tokens: []*obs.SecretReference{{Key: constants.SplunkHECTokenKey, SecretName: secretName}},
+							},
+						},
+					},
+				},
+			}),
+		}, nil))
 })
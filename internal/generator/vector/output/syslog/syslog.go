@@ -12,6 +12,7 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/sinks"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/transforms"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/api/types/codec"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/common/tls"
 	vectorhelpers "github.com/openshift/cluster-logging-operator/internal/generator/vector/helpers"
 	"github.com/openshift/cluster-logging-operator/internal/generator/vector/output/common"
@@ -204,7 +205,7 @@ func buildSocketEncoding(o obs.OutputSpec) *sinks.SocketEncoding {
 	}
 
 	return &sinks.SocketEncoding{
-		Codec:  "syslog",
+		Codec:  codec.CodecTypeSyslog,
 		Syslog: syslogConfig,
 	}
 }
@@ -0,0 +1,138 @@
+package metrics
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+)
+
+const (
+	// thanosQuerierTenancyURL is the query endpoint of the cluster monitoring stack which restricts a query to the
+	// metrics of a namespace
+	thanosQuerierTenancyURL = "https://thanos-querier.openshift-monitoring.svc:9092/api/v1/query"
+	serviceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	serviceAccountCAFile    = "/var/run/secrets/kubernetes.io/serviceaccount/service-ca.crt"
+)
+
+// Querier evaluates instant queries of the metrics of a namespace
+type Querier interface {
+	// Query returns the sum of the values of the samples of an instant query
+	Query(namespace, query string) (float64, error)
+}
+
+type thanosQuerier struct {
+	url       string
+	tokenFile string
+	client    *http.Client
+}
+
+// NewThanosQuerier returns a Querier of the cluster monitoring stack which authenticates as the service account of
+// the operator
+func NewThanosQuerier() (Querier, error) {
+	ca, err := os.ReadFile(serviceAccountCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the service CA bundle: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("no certificates found in %s", serviceAccountCAFile)
+	}
+	client := &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12},
+		},
+	}
+	return newThanosQuerier(thanosQuerierTenancyURL, serviceAccountTokenFile, client), nil
+}
+
+func newThanosQuerier(url, tokenFile string, client *http.Client) *thanosQuerier {
+	return &thanosQuerier{
+		url:       url,
+		tokenFile: tokenFile,
+		client:    client,
+	}
+}
+
+// queryResponse is the response of the Prometheus HTTP API to an instant query
+type queryResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Value []interface{} `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+func (q *thanosQuerier) Query(namespace, query string) (float64, error) {
+	// the token is read for every query since it is rotated
+	token, err := os.ReadFile(q.tokenFile)
+	if err != nil {
+		return 0, fmt.Errorf("unable to read the service account token: %v", err)
+	}
+	request, err := http.NewRequest(http.MethodGet, q.url, nil)
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Add("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	request.Header.Add("Accept", "application/json")
+	params := request.URL.Query()
+	params.Add("namespace", namespace)
+	params.Add("query", query)
+	request.URL.RawQuery = params.Encode()
+
+	response, err := q.client.Do(request)
+	if err != nil {
+		return 0, fmt.Errorf("error querying the metrics: %v", err)
+	}
+	defer func() {
+		if err := response.Body.Close(); err != nil {
+			log.V(3).Error(err, "Failed to close response body")
+		}
+	}()
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read the query response: %v", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status %d for query %q: %s", response.StatusCode, query, strings.TrimSpace(string(body)))
+	}
+
+	result := queryResponse{}
+	if err = json.Unmarshal(body, &result); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal the query response: %v", err)
+	}
+	if result.Status != "success" {
+		return 0, fmt.Errorf("query %q failed: %s", query, result.Error)
+	}
+	if result.Data.ResultType != "vector" {
+		return 0, fmt.Errorf("unexpected result type %q for query %q", result.Data.ResultType, query)
+	}
+	sum := 0.0
+	for _, sample := range result.Data.Result {
+		if len(sample.Value) != 2 {
+			return 0, fmt.Errorf("unexpected sample %v for query %q", sample.Value, query)
+		}
+		value, ok := sample.Value[1].(string)
+		if !ok {
+			return 0, fmt.Errorf("unexpected sample %v for query %q", sample.Value, query)
+		}
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, err
+		}
+		sum += parsed
+	}
+	return sum, nil
+}
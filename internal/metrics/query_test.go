@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("#thanosQuerier", func() {
+	var (
+		server    *httptest.Server
+		tokenFile string
+		request   *http.Request
+		status    int
+		response  string
+	)
+	BeforeEach(func() {
+		tokenFile = path.Join(GinkgoT().TempDir(), "token")
+		Expect(os.WriteFile(tokenFile, []byte("mytoken\n"), 0600)).To(Succeed())
+		status = http.StatusOK
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			request = r
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(response))
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	It("should query the metrics of the namespace with the token of the service account", func() {
+		response = `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"pod":"a"},"value":[1700000000,"2"]},{"metric":{"pod":"b"},"value":[1700000000,"1.5"]}]}}`
+		value, err := newThanosQuerier(server.URL, tokenFile, server.Client()).Query("mynamespace", "vector_component_errors_total")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(3.5))
+		Expect(request.Header.Get("Authorization")).To(Equal("Bearer mytoken"))
+		Expect(request.URL.Query().Get("namespace")).To(Equal("mynamespace"))
+		Expect(request.URL.Query().Get("query")).To(Equal("vector_component_errors_total"))
+	})
+
+	It("should return zero when the query has no samples", func() {
+		response = `{"status":"success","data":{"resultType":"vector","result":[]}}`
+		Expect(newThanosQuerier(server.URL, tokenFile, server.Client()).Query("mynamespace", "up")).To(BeZero())
+	})
+
+	It("should fail when the query is not authorized", func() {
+		status = http.StatusForbidden
+		response = "Forbidden"
+		_, err := newThanosQuerier(server.URL, tokenFile, server.Client()).Query("mynamespace", "up")
+		Expect(err).To(MatchError(ContainSubstring("unexpected status 403")))
+	})
+})
@@ -6,10 +6,13 @@ import (
 
 	loggingv1alpha1 "github.com/openshift/cluster-logging-operator/api/logging/v1alpha1"
 	obsv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/factory"
 	"github.com/openshift/cluster-logging-operator/internal/reconcile"
 	"github.com/openshift/cluster-logging-operator/internal/runtime"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -18,13 +21,58 @@ import (
 // ReconcileClusterLogForwarderNetworkPolicy reconciles the NetworkPolicy for the clusterlogforwarder
 // It handles both AllowAllIngressEgress and RestrictIngressEgress rule sets, parsing ports from outputs and inputs when needed.
 func ReconcileClusterLogForwarderNetworkPolicy(k8Client client.Client, namespace, policyName, instanceName, component string, policyRuleSet obsv1.NetworkPolicyRuleSetType, outputs []obsv1.OutputSpec, inputs []obsv1.InputSpec, ownerRef metav1.OwnerReference, visitor func(o runtime.Object)) error {
+	return reconcile.NetworkPolicy(k8Client, NewClusterLogForwarderNetworkPolicy(namespace, policyName, instanceName, component, policyRuleSet, outputs, inputs, ownerRef, visitor))
+}
+
+// NewClusterLogForwarderNetworkPolicy returns the NetworkPolicy for the clusterlogforwarder
+func NewClusterLogForwarderNetworkPolicy(namespace, policyName, instanceName, component string, policyRuleSet obsv1.NetworkPolicyRuleSetType, outputs []obsv1.OutputSpec, inputs []obsv1.InputSpec, ownerRef metav1.OwnerReference, visitor func(o runtime.Object)) *networkingv1.NetworkPolicy {
 	egressPorts := DetermineEgressPortProtocols(outputs, policyRuleSet)
 	ingressPorts := DetermineIngressPortProtocols(inputs, policyRuleSet)
 
 	desired := factory.NewNetworkPolicyWithProtocolPorts(namespace, policyName, instanceName, component, string(policyRuleSet), egressPorts, ingressPorts, visitor)
 	utils.AddOwnerRefToObject(desired, ownerRef)
 
-	return reconcile.NetworkPolicy(k8Client, desired)
+	return desired
+}
+
+// ReconcileAgentNetworkPolicy reconciles the NetworkPolicy for the agent tier of a two-tier collector which
+// only egresses to the aggregator tier instead of the outputs
+func ReconcileAgentNetworkPolicy(k8Client client.Client, namespace, policyName, instanceName, component string, policyRuleSet obsv1.NetworkPolicyRuleSetType, inputs []obsv1.InputSpec, ownerRef metav1.OwnerReference, visitor func(o runtime.Object)) error {
+	return reconcile.NetworkPolicy(k8Client, NewAgentNetworkPolicy(namespace, policyName, instanceName, component, policyRuleSet, inputs, ownerRef, visitor))
+}
+
+// NewAgentNetworkPolicy returns the NetworkPolicy for the agent tier
+func NewAgentNetworkPolicy(namespace, policyName, instanceName, component string, policyRuleSet obsv1.NetworkPolicyRuleSetType, inputs []obsv1.InputSpec, ownerRef metav1.OwnerReference, visitor func(o runtime.Object)) *networkingv1.NetworkPolicy {
+	var egressPorts []factory.PortProtocol
+	if policyRuleSet == obsv1.NetworkPolicyRuleSetTypeRestrictIngressEgress {
+		egressPorts = []factory.PortProtocol{{Port: constants.AggregatorPort, Protocol: corev1.ProtocolTCP}}
+	}
+	ingressPorts := DetermineIngressPortProtocols(inputs, policyRuleSet)
+
+	desired := factory.NewNetworkPolicyWithProtocolPorts(namespace, policyName, instanceName, component, string(policyRuleSet), egressPorts, ingressPorts, visitor)
+	utils.AddOwnerRefToObject(desired, ownerRef)
+
+	return desired
+}
+
+// ReconcileAggregatorNetworkPolicy reconciles the NetworkPolicy for the aggregator tier of a two-tier collector which
+// only ingresses from the agent tier and egresses to the outputs
+func ReconcileAggregatorNetworkPolicy(k8Client client.Client, namespace, policyName, instanceName, component string, policyRuleSet obsv1.NetworkPolicyRuleSetType, outputs []obsv1.OutputSpec, ownerRef metav1.OwnerReference, visitor func(o runtime.Object)) error {
+	return reconcile.NetworkPolicy(k8Client, NewAggregatorNetworkPolicy(namespace, policyName, instanceName, component, policyRuleSet, outputs, ownerRef, visitor))
+}
+
+// NewAggregatorNetworkPolicy returns the NetworkPolicy for the aggregator tier
+func NewAggregatorNetworkPolicy(namespace, policyName, instanceName, component string, policyRuleSet obsv1.NetworkPolicyRuleSetType, outputs []obsv1.OutputSpec, ownerRef metav1.OwnerReference, visitor func(o runtime.Object)) *networkingv1.NetworkPolicy {
+	egressPorts := DetermineEgressPortProtocols(outputs, policyRuleSet)
+	var ingressPorts []int32
+	if policyRuleSet == obsv1.NetworkPolicyRuleSetTypeRestrictIngressEgress {
+		ingressPorts = []int32{constants.AggregatorPort}
+	}
+
+	desired := factory.NewNetworkPolicyWithProtocolPorts(namespace, policyName, instanceName, component, string(policyRuleSet), egressPorts, ingressPorts, visitor)
+	utils.AddOwnerRefToObject(desired, ownerRef)
+
+	return desired
 }
 
 // ReconcileLogFileMetricsExporterNetworkPolicy reconciles the NetworkPolicy for the logfilemetricexporter
@@ -349,6 +349,109 @@ var _ = Describe("Reconcile NetworkPolicy", func() {
 			}
 			Expect(egressRule.Ports).To(ConsistOf(expectedEgressPorts))
 		})
+
+		It("should restrict the egress of the agent tier to the aggregator port", func() {
+			inputs := []obsv1.InputSpec{
+				{
+					Name: "http-receiver",
+					Type: obsv1.InputTypeReceiver,
+					Receiver: &obsv1.ReceiverSpec{
+						Type: obsv1.ReceiverTypeHTTP,
+						Port: 8080,
+					},
+				},
+			}
+			Expect(ReconcileAgentNetworkPolicy(
+				reqClient,
+				constants.OpenshiftNS,
+				policyName,
+				instanceName,
+				componentName,
+				obsv1.NetworkPolicyRuleSetTypeRestrictIngressEgress,
+				inputs,
+				owner,
+				commonLabels)).To(Succeed())
+
+			Expect(reqClient.Get(context.TODO(), policyKey, policyInstance)).Should(Succeed())
+
+			Expect(policyInstance.Spec.Ingress).To(HaveLen(1))
+			Expect(policyInstance.Spec.Ingress[0].Ports).To(ConsistOf(
+				networkingv1.NetworkPolicyPort{
+					Protocol: &protocolTCP,
+					Port:     &intstr.IntOrString{Type: intstr.Int, IntVal: constants.MetricsPort},
+				},
+				networkingv1.NetworkPolicyPort{
+					Protocol: &protocolTCP,
+					Port:     &intstr.IntOrString{Type: intstr.Int, IntVal: 8080},
+				},
+			))
+			Expect(policyInstance.Spec.Egress).To(HaveLen(1))
+			Expect(policyInstance.Spec.Egress[0].Ports).To(ConsistOf(
+				networkingv1.NetworkPolicyPort{
+					Protocol: &protocolTCP,
+					Port:     &intstr.IntOrString{Type: intstr.Int, IntVal: constants.AggregatorPort},
+				},
+				networkingv1.NetworkPolicyPort{
+					Protocol: &protocolTCP,
+					Port:     &intstr.IntOrString{Type: intstr.Int, IntVal: factory.KubeAPIPort},
+				},
+				networkingv1.NetworkPolicyPort{
+					Protocol: &protocolUDP,
+					Port:     &intstr.IntOrString{Type: intstr.String, StrVal: factory.DNSPortName},
+				},
+			))
+		})
+
+		It("should restrict the ingress of the aggregator tier to the aggregator port", func() {
+			outputs := []obsv1.OutputSpec{
+				{
+					Name: "kafka-output",
+					Type: obsv1.OutputTypeKafka,
+					Kafka: &obsv1.Kafka{
+						URL: "tcp://kafka.example.com:9092",
+					},
+				},
+			}
+			Expect(ReconcileAggregatorNetworkPolicy(
+				reqClient,
+				constants.OpenshiftNS,
+				policyName,
+				instanceName,
+				componentName,
+				obsv1.NetworkPolicyRuleSetTypeRestrictIngressEgress,
+				outputs,
+				owner,
+				commonLabels)).To(Succeed())
+
+			Expect(reqClient.Get(context.TODO(), policyKey, policyInstance)).Should(Succeed())
+
+			Expect(policyInstance.Spec.Ingress).To(HaveLen(1))
+			Expect(policyInstance.Spec.Ingress[0].Ports).To(ConsistOf(
+				networkingv1.NetworkPolicyPort{
+					Protocol: &protocolTCP,
+					Port:     &intstr.IntOrString{Type: intstr.Int, IntVal: constants.MetricsPort},
+				},
+				networkingv1.NetworkPolicyPort{
+					Protocol: &protocolTCP,
+					Port:     &intstr.IntOrString{Type: intstr.Int, IntVal: constants.AggregatorPort},
+				},
+			))
+			Expect(policyInstance.Spec.Egress).To(HaveLen(1))
+			Expect(policyInstance.Spec.Egress[0].Ports).To(ConsistOf(
+				networkingv1.NetworkPolicyPort{
+					Protocol: &protocolTCP,
+					Port:     &intstr.IntOrString{Type: intstr.Int, IntVal: 9092},
+				},
+				networkingv1.NetworkPolicyPort{
+					Protocol: &protocolTCP,
+					Port:     &intstr.IntOrString{Type: intstr.Int, IntVal: factory.KubeAPIPort},
+				},
+				networkingv1.NetworkPolicyPort{
+					Protocol: &protocolUDP,
+					Port:     &intstr.IntOrString{Type: intstr.String, StrVal: factory.DNSPortName},
+				},
+			))
+		})
 	})
 
 	Context("when the logfilemetricexporter NetworkPolicy is reconciled", func() {
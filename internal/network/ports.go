@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+	"strings"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
@@ -70,6 +71,9 @@ func GetInputPorts(inputs []obs.InputSpec) []int32 {
 	for _, input := range inputs {
 		if input.Type == obs.InputTypeReceiver && input.Receiver != nil && input.Receiver.Port > 0 {
 			portSet.Insert(input.Receiver.Port)
+			if input.Receiver.OTLP != nil && input.Receiver.OTLP.GRPCPort > 0 {
+				portSet.Insert(input.Receiver.OTLP.GRPCPort)
+			}
 		}
 	}
 
@@ -80,7 +84,7 @@ func GetInputPorts(inputs []obs.InputSpec) []int32 {
 // For most outputs, it returns a slice with a single port protocol.
 // For Kafka, it returns ports from all brokers or the URL if provided.
 // For HTTP, it returns ports from the URL and proxy URL if provided.
-// Returns port 443 for Google Cloud Logging and Azure Monitor as well as Cloudwatch and S3 if no URL is provided.
+// Returns port 443 for Google Cloud Logging, Azure Monitor and New Relic as well as Cloudwatch, Datadog and S3 if no URL is provided.
 func getPortProtocolFromOutputURLs(output obs.OutputSpec) []factory.PortProtocol {
 	// Gather all URL strings from the output spec
 	var urlSlice []string
@@ -102,6 +106,20 @@ func getPortProtocolFromOutputURLs(output obs.OutputSpec) []factory.PortProtocol
 		if output.Syslog != nil {
 			urlSlice = append(urlSlice, output.Syslog.URL)
 		}
+	case obs.OutputTypeDatadog:
+		if output.Datadog == nil {
+			return nil
+		}
+		// Datadog URL is optional; the intake of the site uses HTTPS port 443
+		if output.Datadog.URL == "" {
+			return []factory.PortProtocol{defaultHTTPSTCPPort}
+		}
+		urlSlice = append(urlSlice, output.Datadog.URL)
+	case obs.OutputTypeSocket:
+		// Unix sockets are local to the node and require no egress port
+		if output.Socket != nil && !strings.HasPrefix(output.Socket.URL, "unix:") {
+			urlSlice = append(urlSlice, output.Socket.URL)
+		}
 	case obs.OutputTypeOTLP:
 		if output.OTLP != nil {
 			urlSlice = append(urlSlice, output.OTLP.URL)
@@ -139,8 +157,8 @@ func getPortProtocolFromOutputURLs(output obs.OutputSpec) []factory.PortProtocol
 	// LokiStack internal port is 8080 for both HTTP and OTLP
 	case obs.OutputTypeLokiStack:
 		return []factory.PortProtocol{{Port: 8080, Protocol: corev1.ProtocolTCP}}
-	case obs.OutputTypeGoogleCloudLogging, obs.OutputTypeAzureMonitor:
-		// GCL and Azure Monitor don't have URL fields and use the default HTTPS port
+	case obs.OutputTypeGoogleCloudLogging, obs.OutputTypeAzureMonitor, obs.OutputTypeNewRelic:
+		// GCL, Azure Monitor and New Relic don't have URL fields and use the default HTTPS port
 		return []factory.PortProtocol{defaultHTTPSTCPPort}
 	default:
 		panic(fmt.Sprintf("Unsupported output type: %s", output.Type))
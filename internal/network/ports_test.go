@@ -198,6 +198,24 @@ var _ = Describe("Network Ports", func() {
 				"tls://syslog.example.com", nil, true),
 		)
 
+		DescribeTable("Socket",
+			func(urlStr string, expectedPorts []factory.PortProtocol) {
+				output := obs.OutputSpec{
+					Type:   obs.OutputTypeSocket,
+					Socket: &obs.Socket{URL: urlStr},
+				}
+				Expect(getPortProtocolFromOutputURLs(output)).To(Equal(expectedPorts))
+			},
+			Entry("should extract port from Socket TCP URL",
+				"tcp://collector.example.com:5170", makeTCPPorts(5170)),
+			Entry("should extract port from Socket TLS URL",
+				"tls://collector.example.com:6514", makeTCPPorts(6514)),
+			Entry("should extract port from Socket UDP URL",
+				"udp://collector.example.com:12201", makePortProtocol(12201, corev1.ProtocolUDP)),
+			Entry("should not require a port for a Socket Unix URL",
+				"unix:///var/run/collector/logs.sock", []factory.PortProtocol{}),
+		)
+
 		DescribeTable("OTLP",
 			func(urlStr string, expectedPort int32) {
 				output := obs.OutputSpec{
@@ -277,6 +295,20 @@ var _ = Describe("Network Ports", func() {
 				"", constants.DefaultHTTPSPort),
 		)
 
+		DescribeTable("Datadog",
+			func(urlStr string, expectedPort int32) {
+				output := obs.OutputSpec{
+					Type:    obs.OutputTypeDatadog,
+					Datadog: &obs.Datadog{URL: urlStr},
+				}
+				Expect(getPortProtocolFromOutputURLs(output)).To(Equal(makeTCPPorts(expectedPort)))
+			},
+			Entry("should extract port from Datadog URL",
+				"https://datadog-proxy.example.com:8443", int32(8443)),
+			Entry("should use default HTTPS port when URL is not defined",
+				"", constants.DefaultHTTPSPort),
+		)
+
 		DescribeTable("Kafka",
 			func(urlStr string, brokers []obs.BrokerURL, expectedPorts []int32) {
 				output := obs.OutputSpec{
@@ -323,6 +355,13 @@ var _ = Describe("Network Ports", func() {
 			Expect(getPortProtocolFromOutputURLs(output)).To(Equal(makeTCPPorts(443)))
 		})
 
+		It("should return default HTTPS port, 443, for New Relic", func() {
+			output := obs.OutputSpec{
+				Type: obs.OutputTypeNewRelic,
+			}
+			Expect(getPortProtocolFromOutputURLs(output)).To(Equal(makeTCPPorts(443)))
+		})
+
 		DescribeTable("AzureLogsIngestion",
 			func(urlStr string, expectedPort int32) {
 				output := obs.OutputSpec{
@@ -552,6 +591,23 @@ var _ = Describe("Network Ports", func() {
 				Expect(ports).To(BeEmpty())
 			})
 
+			It("should include the gRPC port of otlp receivers", func() {
+				inputs := []obs.InputSpec{
+					{
+						Type: obs.InputTypeReceiver,
+						Receiver: &obs.ReceiverSpec{
+							Type: obs.ReceiverTypeOTLP,
+							Port: 4318,
+							OTLP: &obs.OTLPReceiver{
+								GRPCPort: 4317,
+							},
+						},
+					},
+				}
+				ports := GetInputPorts(inputs)
+				Expect(ports).To(ConsistOf(int32(4318), int32(4317)))
+			})
+
 			It("should handle empty input list", func() {
 				ports := GetInputPorts([]obs.InputSpec{})
 				Expect(ports).To(BeEmpty())
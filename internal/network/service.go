@@ -24,6 +24,11 @@ func withServiceTypeLabel(serviceType string) func(o runtime.Object) {
 
 // ReconcileService reconciles the service that exposes metrics
 func ReconcileService(k8sClient client.Client, namespace, name, instanceName, component, portName, certSecretName string, portNum int32, owner metav1.OwnerReference, visitors func(o runtime.Object)) error {
+	return reconcile.Service(k8sClient, NewMetricsService(namespace, name, instanceName, component, portName, certSecretName, portNum, owner, visitors))
+}
+
+// NewMetricsService returns the service that exposes metrics
+func NewMetricsService(namespace, name, instanceName, component, portName, certSecretName string, portNum int32, owner metav1.OwnerReference, visitors func(o runtime.Object)) *v1.Service {
 	desired := factory.NewService(
 		name,
 		namespace,
@@ -44,25 +49,80 @@ func ReconcileService(k8sClient client.Client, namespace, name, instanceName, co
 		constants.AnnotationServingCertSecretName: certSecretName,
 	}
 	utils.AddOwnerRefToObject(desired, owner)
-	return reconcile.Service(k8sClient, desired)
+	return desired
 }
 
-func ReconcileInputService(k8sClient client.Client, namespace, name, instance, certSecretName string, port, targetPort int32, receiverType obs.ReceiverType, owner metav1.OwnerReference, visitors func(o runtime.Object)) error {
+// ReconcileAggregatorService reconciles the service of the aggregator tier of a two-tier collector which exposes
+// the port receiving from the agent tier along with the metrics port. The serving certificate is used for both
+func ReconcileAggregatorService(k8sClient client.Client, namespace, name, instanceName, component, certSecretName string, owner metav1.OwnerReference, visitors func(o runtime.Object)) error {
+	return reconcile.Service(k8sClient, NewAggregatorService(namespace, name, instanceName, component, certSecretName, owner, visitors))
+}
+
+// NewAggregatorService returns the service of the aggregator tier
+func NewAggregatorService(namespace, name, instanceName, component, certSecretName string, owner metav1.OwnerReference, visitors func(o runtime.Object)) *v1.Service {
 	desired := factory.NewService(
 		name,
 		namespace,
-		constants.CollectorName,
-		instance,
+		component,
+		instanceName,
 		[]v1.ServicePort{
 			{
-				Port: port,
-				TargetPort: intstr.IntOrString{
-					Type:   intstr.Int,
-					IntVal: targetPort,
-				},
-				Protocol: v1.ProtocolTCP,
+				Port:       constants.AggregatorPort,
+				TargetPort: intstr.FromString(constants.AggregatorPortName),
+				Name:       constants.AggregatorPortName,
+			},
+			{
+				Port:       constants.MetricsPort,
+				TargetPort: intstr.FromString(constants.MetricsPortName),
+				Name:       constants.MetricsPortName,
 			},
 		},
+		withServiceTypeLabel(constants.ServiceTypeMetrics),
+		visitors,
+	)
+
+	desired.Annotations = map[string]string{
+		constants.AnnotationServingCertSecretName: certSecretName,
+	}
+	utils.AddOwnerRefToObject(desired, owner)
+	return desired
+}
+
+// InputServicePorts returns the service ports of a receiver input
+func InputServicePorts(receiver obs.ReceiverSpec) []v1.ServicePort {
+	if receiver.Type == obs.ReceiverTypeOTLP && receiver.OTLP != nil {
+		return []v1.ServicePort{
+			newInputServicePort("grpc", receiver.OTLP.GRPCPort),
+			newInputServicePort("http", receiver.Port),
+		}
+	}
+	return []v1.ServicePort{newInputServicePort("", receiver.Port)}
+}
+
+func newInputServicePort(name string, port int32) v1.ServicePort {
+	return v1.ServicePort{
+		Name: name,
+		Port: port,
+		TargetPort: intstr.IntOrString{
+			Type:   intstr.Int,
+			IntVal: port,
+		},
+		Protocol: v1.ProtocolTCP,
+	}
+}
+
+func ReconcileInputService(k8sClient client.Client, namespace, name, instance, certSecretName string, ports []v1.ServicePort, receiverType obs.ReceiverType, owner metav1.OwnerReference, visitors func(o runtime.Object)) error {
+	return reconcile.Service(k8sClient, NewInputService(namespace, name, instance, certSecretName, ports, receiverType, owner, visitors))
+}
+
+// NewInputService returns the service of a receiver input
+func NewInputService(namespace, name, instance, certSecretName string, ports []v1.ServicePort, receiverType obs.ReceiverType, owner metav1.OwnerReference, visitors func(o runtime.Object)) *v1.Service {
+	desired := factory.NewService(
+		name,
+		namespace,
+		constants.CollectorName,
+		instance,
+		ports,
 		withServiceTypeLabel(constants.ServiceTypeInput),
 		visitors,
 	)
@@ -75,5 +135,5 @@ func ReconcileInputService(k8sClient client.Client, namespace, name, instance, c
 	}
 
 	utils.AddOwnerRefToObject(desired, owner)
-	return reconcile.Service(k8sClient, desired)
+	return desired
 }
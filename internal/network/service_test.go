@@ -6,6 +6,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/runtime"
 	corev1 "k8s.io/api/core/v1"
@@ -73,4 +74,58 @@ var _ = Describe("Reconcile Service", func() {
 			To(Equal(certSecret))
 	})
 
+	It("should reconcile an input service with the gRPC and HTTP ports of an otlp receiver", func() {
+		receiver := obs.ReceiverSpec{
+			Type: obs.ReceiverTypeOTLP,
+			Port: 4318,
+			OTLP: &obs.OTLPReceiver{
+				GRPCPort: 4317,
+			},
+		}
+		inputServiceName := "test-otlp-input"
+		Expect(ReconcileInputService(
+			reqClient,
+			constants.OpenshiftNS,
+			inputServiceName,
+			serviceName,
+			certSecret,
+			InputServicePorts(receiver),
+			receiver.Type,
+			owner,
+			commonLabels)).To(Succeed())
+
+		inputService := &corev1.Service{}
+		Expect(reqClient.Get(context.TODO(), types.NamespacedName{Name: inputServiceName, Namespace: namespace.Name}, inputService)).Should(Succeed())
+		Expect(inputService.Spec.Ports).To(HaveLen(2))
+		Expect(inputService.Spec.Ports[0].Name).To(Equal("grpc"))
+		Expect(inputService.Spec.Ports[0].Port).To(Equal(int32(4317)))
+		Expect(inputService.Spec.Ports[1].Name).To(Equal("http"))
+		Expect(inputService.Spec.Ports[1].Port).To(Equal(int32(4318)))
+		Expect(inputService.Labels[constants.LabelLoggingInputServiceType]).To(Equal(string(obs.ReceiverTypeOTLP)))
+		Expect(inputService.Annotations[constants.AnnotationServingCertSecretName]).To(Equal(certSecret))
+	})
+
+	It("should reconcile an aggregator service with the aggregator and metrics ports", func() {
+		aggregatorServiceName := "test-aggregator"
+		Expect(ReconcileAggregatorService(
+			reqClient,
+			constants.OpenshiftNS,
+			aggregatorServiceName,
+			aggregatorServiceName,
+			componentName,
+			certSecret,
+			owner,
+			commonLabels)).To(Succeed())
+
+		aggregatorService := &corev1.Service{}
+		Expect(reqClient.Get(context.TODO(), types.NamespacedName{Name: aggregatorServiceName, Namespace: namespace.Name}, aggregatorService)).Should(Succeed())
+		Expect(aggregatorService.Spec.Ports).To(HaveLen(2))
+		Expect(aggregatorService.Spec.Ports[0].Name).To(Equal(constants.AggregatorPortName))
+		Expect(aggregatorService.Spec.Ports[0].Port).To(Equal(constants.AggregatorPort))
+		Expect(aggregatorService.Spec.Ports[1].Name).To(Equal(constants.MetricsPortName))
+		Expect(aggregatorService.Spec.Ports[1].Port).To(Equal(constants.MetricsPort))
+		Expect(aggregatorService.Labels[constants.LabelLoggingServiceType]).To(Equal(constants.ServiceTypeMetrics))
+		Expect(aggregatorService.Annotations[constants.AnnotationServingCertSecretName]).To(Equal(certSecret))
+	})
+
 })
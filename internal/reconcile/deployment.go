@@ -12,13 +12,19 @@ import (
 )
 
 // Deployment reconciles a Deployment to the desired spec returning an error
-// if there is an issue creating or updating to the desired state
+// if there is an issue creating or updating to the desired state. The replicas of an existing
+// Deployment are preserved when the desired spec does not define them
 func Deployment(k8Client client.Client, desired *apps.Deployment) error {
 	dpl := runtime.NewDeployment(desired.Namespace, desired.Name)
 	op, err := controllerutil.CreateOrUpdate(context.TODO(), k8Client, dpl, func() error {
 		// Update the deployment with our desired state
+		replicas := dpl.Spec.Replicas
 		dpl.Labels = desired.Labels
 		dpl.Spec = desired.Spec
+		if desired.Spec.Replicas == nil {
+			// replicas are managed by a HorizontalPodAutoscaler
+			dpl.Spec.Replicas = replicas
+		}
 		dpl.OwnerReferences = desired.OwnerReferences
 		return nil
 	})
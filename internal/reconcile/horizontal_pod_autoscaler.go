@@ -0,0 +1,30 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// HorizontalPodAutoscaler creates or updates a HorizontalPodAutoscaler returning an error
+// if there is an issue creating or updating the HorizontalPodAutoscaler to the desired state
+func HorizontalPodAutoscaler(k8Client client.Client, desired *autoscalingv2.HorizontalPodAutoscaler) error {
+	hpa := runtime.NewHorizontalPodAutoscaler(desired.Namespace, desired.Name)
+	op, err := controllerutil.CreateOrUpdate(context.TODO(), k8Client, hpa, func() error {
+		hpa.Labels = desired.Labels
+		hpa.Spec = desired.Spec
+		hpa.OwnerReferences = desired.OwnerReferences
+		return nil
+	})
+
+	if err == nil {
+		log.V(3).Info(fmt.Sprintf("reconciled horizontalpodautoscaler - operation: %s", op))
+	}
+
+	return err
+}
@@ -0,0 +1,30 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	policyv1 "k8s.io/api/policy/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// PodDisruptionBudget creates or updates a PodDisruptionBudget returning an error
+// if there is an issue creating or updating the PodDisruptionBudget to the desired state
+func PodDisruptionBudget(k8Client client.Client, desired *policyv1.PodDisruptionBudget) error {
+	pdb := runtime.NewPodDisruptionBudget(desired.Namespace, desired.Name)
+	op, err := controllerutil.CreateOrUpdate(context.TODO(), k8Client, pdb, func() error {
+		pdb.Labels = desired.Labels
+		pdb.Spec = desired.Spec
+		pdb.OwnerReferences = desired.OwnerReferences
+		return nil
+	})
+
+	if err == nil {
+		log.V(3).Info(fmt.Sprintf("reconciled poddisruptionbudget - operation: %s", op))
+	}
+
+	return err
+}
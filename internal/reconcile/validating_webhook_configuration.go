@@ -0,0 +1,43 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// ValidatingWebhookConfiguration reconciles a ValidatingWebhookConfiguration to the desired spec returning an error
+// if there is an issue creating or updating to the desired state. The CA bundle injected into an existing
+// configuration is retained
+func ValidatingWebhookConfiguration(k8Client client.Client, desired *admissionregistrationv1.ValidatingWebhookConfiguration) error {
+	current := &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: desired.Name,
+		},
+	}
+	op, err := controllerutil.CreateOrUpdate(context.TODO(), k8Client, current, func() error {
+		caBundles := map[string][]byte{}
+		for _, webhook := range current.Webhooks {
+			caBundles[webhook.Name] = webhook.ClientConfig.CABundle
+		}
+		current.Labels = desired.Labels
+		current.Annotations = desired.Annotations
+		current.Webhooks = desired.DeepCopy().Webhooks
+		for i, webhook := range current.Webhooks {
+			if len(webhook.ClientConfig.CABundle) == 0 {
+				current.Webhooks[i].ClientConfig.CABundle = caBundles[webhook.Name]
+			}
+		}
+		return nil
+	})
+
+	if err == nil {
+		log.V(3).Info(fmt.Sprintf("reconciled validatingwebhookconfiguration - operation: %s", op))
+	}
+	return err
+}
@@ -2,8 +2,11 @@ package runtime
 
 import (
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -83,9 +86,30 @@ func NewDeployment(namespace, name string, visitors ...func(o runtime.Object)) *
 	return dpl
 }
 
+// NewHorizontalPodAutoscaler returns an autoscaling/v2.HorizontalPodAutoscaler with namespace and name.
+func NewHorizontalPodAutoscaler(namespace, name string, visitors ...func(o runtime.Object)) *autoscalingv2.HorizontalPodAutoscaler {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	Initialize(hpa, namespace, name, visitors...)
+	return hpa
+}
+
+// NewPodDisruptionBudget returns a policy/v1.PodDisruptionBudget with namespace and name.
+func NewPodDisruptionBudget(namespace, name string, visitors ...func(o runtime.Object)) *policyv1.PodDisruptionBudget {
+	pdb := &policyv1.PodDisruptionBudget{}
+	Initialize(pdb, namespace, name, visitors...)
+	return pdb
+}
+
 // NewNetworkPolicy returns a networking.k8s.io/v1.NetworkPolicy with namespace and name.
 func NewNetworkPolicy(namespace, name string, visitors ...func(o runtime.Object)) *networkingv1.NetworkPolicy {
 	np := &networkingv1.NetworkPolicy{}
 	Initialize(np, namespace, name, visitors...)
 	return np
 }
+
+// NewJob returns a batch/v1.Job with namespace and name.
+func NewJob(namespace, name string, visitors ...func(o runtime.Object)) *batchv1.Job {
+	job := &batchv1.Job{}
+	Initialize(job, namespace, name, visitors...)
+	return job
+}
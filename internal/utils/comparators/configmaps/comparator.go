@@ -2,9 +2,12 @@ package configmaps
 
 import (
 	"reflect"
+	"strings"
 
 	log "github.com/ViaQ/logerr/v2/log/static"
 	"github.com/openshift/cluster-logging-operator/internal/utils/comparators"
+	"github.com/openshift/cluster-logging-operator/internal/utils/sets"
+	"github.com/pmezard/go-difflib/difflib"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -28,3 +31,40 @@ func AreSame(actual *corev1.ConfigMap, desired *corev1.ConfigMap, options ...com
 	log.V(5).Info("Compare configmaps", "dateAreEqual", dataAreEqual, "labelsAreEqual", labelsAreEqual, "annotationsAreEqual", annotationsAreEqual)
 	return dataAreEqual && labelsAreEqual && annotationsAreEqual
 }
+
+// Diff returns a unified diff of the data of the actual and desired configmaps for each key which is different
+func Diff(actual *corev1.ConfigMap, desired *corev1.ConfigMap) string {
+	keys := sets.NewString()
+	for key := range actual.Data {
+		keys.Insert(key)
+	}
+	for key := range desired.Data {
+		keys.Insert(key)
+	}
+	result := ""
+	for _, key := range keys.List() {
+		diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        splitLines(actual.Data[key]),
+			B:        splitLines(desired.Data[key]),
+			FromFile: "actual/" + key,
+			ToFile:   "desired/" + key,
+			Context:  3,
+		})
+		result += diff
+	}
+	return result
+}
+
+// splitLines splits the value into lines which each end with a newline
+func splitLines(value string) (lines []string) {
+	for _, line := range strings.SplitAfter(value, "\n") {
+		if line == "" {
+			continue
+		}
+		if !strings.HasSuffix(line, "\n") {
+			line += "\n"
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
@@ -53,3 +53,26 @@ var _ = Describe("configmaps#AreSame", func() {
 		})
 	})
 })
+
+var _ = Describe("configmaps#Diff", func() {
+
+	It("should be empty when the data is the same", func() {
+		current := &v1.ConfigMap{Data: map[string]string{"foo": "bar\n"}}
+		Expect(configmaps.Diff(current, current.DeepCopy())).To(BeEmpty())
+	})
+	It("should return a unified diff of each key that is different", func() {
+		current := &v1.ConfigMap{Data: map[string]string{"foo": "one\ntwo\n", "same": "value", "old": "gone\n"}}
+		desired := &v1.ConfigMap{Data: map[string]string{"foo": "one\nthree\n", "same": "value"}}
+		Expect(configmaps.Diff(current, desired)).To(Equal(`--- actual/foo
++++ desired/foo
+@@ -1,2 +1,2 @@
+ one
+-two
++three
+--- actual/old
++++ desired/old
+@@ -1 +0,0 @@
+-gone
+`))
+	})
+})
@@ -0,0 +1,19 @@
+package objects
+
+import (
+	"github.com/google/go-cmp/cmp"
+)
+
+// ignoreUnsetDesired ignores the fields which are not set by the desired object (e.g. those defaulted by the API server)
+// while retaining the elements of slices and maps which are added or removed
+var ignoreUnsetDesired = cmp.FilterPath(func(path cmp.Path) bool {
+	_, desired := path.Last().Values()
+	return desired.IsValid() && desired.IsZero()
+}, cmp.Ignore())
+
+// Diff returns the differences between the actual and desired objects where '-' identifies the actual values and '+' the
+// desired values. Fields which are not set in the desired object are not compared. The result is empty when the
+// objects are the same
+func Diff(actual, desired interface{}) string {
+	return cmp.Diff(actual, desired, ignoreUnsetDesired)
+}
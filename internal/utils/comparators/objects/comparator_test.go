@@ -0,0 +1,52 @@
+package objects_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/cluster-logging-operator/internal/utils/comparators/objects"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("objects#Diff", func() {
+
+	var (
+		current, desired *v1.PodSpec
+	)
+
+	BeforeEach(func() {
+		desired = &v1.PodSpec{
+			NodeSelector: map[string]string{"foo": "bar"},
+			Containers: []v1.Container{
+				{
+					Name:  "collector",
+					Image: "vector:1",
+					Resources: v1.ResourceRequirements{
+						Limits: v1.ResourceList{v1.ResourceMemory: resource.MustParse("1Gi")},
+					},
+				},
+			},
+		}
+		current = desired.DeepCopy()
+	})
+
+	It("should be empty when the objects are the same", func() {
+		Expect(objects.Diff(current, desired)).To(BeEmpty())
+	})
+	It("should ignore the fields which are not set by the desired object", func() {
+		current.RestartPolicy = v1.RestartPolicyAlways
+		current.Containers[0].TerminationMessagePath = "/dev/termination-log"
+		current.Containers[0].Resources.Limits[v1.ResourceMemory] = resource.MustParse("1024Mi")
+		Expect(objects.Diff(current, desired)).To(BeEmpty())
+	})
+	It("should return the fields which are different", func() {
+		desired.Containers[0].Image = "vector:2"
+		Expect(objects.Diff(current, desired)).To(And(ContainSubstring(`-`), ContainSubstring(`"vector:1"`), ContainSubstring(`"vector:2"`)))
+	})
+	It("should return the elements which are added and removed", func() {
+		desired.NodeSelector = map[string]string{"abc": "xyz"}
+		desired.Containers = append(desired.Containers, v1.Container{Name: "sidecar"})
+		diff := objects.Diff(current, desired)
+		Expect(diff).To(And(ContainSubstring(`"foo"`), ContainSubstring(`"abc"`), ContainSubstring(`"sidecar"`)))
+	})
+})
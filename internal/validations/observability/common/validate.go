@@ -9,13 +9,14 @@ import (
 	"strings"
 )
 
-// ValidateValueReference checks for valid names and keys referenced in secrets and configMaps
+// ValidateValueReference checks for valid names and keys referenced in secrets and configMaps. A nil map of secrets
+// or configMaps means their state is unknown (e.g. admission) and references to them are not evaluated
 func ValidateValueReference(configs []*obsv1.ValueReference, secrets map[string]*corev1.Secret, configMaps map[string]*corev1.ConfigMap) (messages []string) {
 	for _, entry := range configs {
 		switch {
-		case entry.SecretName != "":
+		case entry.SecretName != "" && secrets != nil:
 			messages = append(messages, validateSecret(entry.SecretName, entry.Key, secrets)...)
-		case entry.ConfigMapName != "":
+		case entry.ConfigMapName != "" && configMaps != nil:
 			messages = append(messages, validateConfigMap(entry.ConfigMapName, entry.Key, configMaps)...)
 		}
 	}
@@ -40,7 +41,11 @@ func validateConfigMap(configMapName, key string, configMaps map[string]*corev1.
 	if !found {
 		return []string{fmt.Sprintf("configmap[%s] not found", configMapName)}
 	}
-	if value, keyFound := cm.Data[key]; !keyFound {
+	if value, keyFound := cm.BinaryData[key]; keyFound {
+		if len(value) == 0 {
+			messages = append(messages, fmt.Sprintf("configmap[%s.%s] value is empty", configMapName, key))
+		}
+	} else if value, keyFound := cm.Data[key]; !keyFound {
 		messages = append(messages, fmt.Sprintf("configmap[%s.%s] not found", configMapName, key))
 	} else if strings.TrimSpace(value) == "" {
 		messages = append(messages, fmt.Sprintf("configmap[%s.%s] value is empty", configMapName, key))
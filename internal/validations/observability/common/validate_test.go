@@ -126,6 +126,15 @@ var _ = Describe("#ValidateValueReference", func() {
 			configMaps[cmKey.ConfigMapName] = aConfigMap
 			Expect(ValidateValueReference(cmKeys, secrets, configMaps)).To(BeEmpty())
 		})
+		It("should pass when the key exists in the binary data of the configmap", func() {
+			cmKeys = append(cmKeys, cmKey)
+			configMaps[cmKey.ConfigMapName] = &corev1.ConfigMap{
+				BinaryData: map[string][]byte{
+					keyName: {0x0a, 0x01},
+				},
+			}
+			Expect(ValidateValueReference(cmKeys, secrets, configMaps)).To(BeEmpty())
+		})
 		It("should pass when there are no keys or names are spec'd", func() {
 			Expect(ValidateValueReference([]*obs.ValueReference{}, secrets, configMaps)).To(BeEmpty())
 		})
@@ -3,6 +3,7 @@ package inputs
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
@@ -45,9 +46,18 @@ func ValidateApplication(spec obs.InputSpec) (conditions []metav1.Condition) {
 			}
 		}
 	}
+	var selectorMessages []string
+	for name, selector := range map[string]*metav1.LabelSelector{"selector": spec.Application.Selector, "namespaceSelector": spec.Application.NamespaceSelector} {
+		if _, err := metav1.LabelSelectorAsSelector(selector); err != nil {
+			selectorMessages = append(selectorMessages, fmt.Sprintf("%s is invalid: %v", name, err))
+		}
+	}
+	sort.Strings(selectorMessages)
 	if len(messages) > 0 {
 		msg := fmt.Sprintf("globs must match %q for: %s", globRE, strings.Join(messages, ","))
 		conditions = append(conditions, internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, msg))
+	} else if len(selectorMessages) > 0 {
+		conditions = append(conditions, internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, strings.Join(selectorMessages, ",")))
 	} else {
 		conditions = append(conditions, internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, true, obs.ReasonValidationSuccess, fmt.Sprintf("input %q is valid", spec.Name)))
 	}
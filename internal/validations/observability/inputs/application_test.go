@@ -5,6 +5,7 @@ import (
 	. "github.com/onsi/gomega"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ = Describe("#ValidateApplication", func() {
@@ -116,4 +117,38 @@ var _ = Describe("#ValidateApplication", func() {
 			Expect(ValidateApplication(input)).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
 		})
 	})
+
+	Context("of selectors", func() {
+		It("should pass for a valid namespace selector", func() {
+			input.Application.NamespaceSelector = &metav1.LabelSelector{
+				MatchLabels: map[string]string{"team": "payments"},
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "environment", Operator: metav1.LabelSelectorOpIn, Values: []string{"production"}},
+				},
+			}
+			Expect(ValidateApplication(input)).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
+		It("should fail for a namespace selector with an invalid label value", func() {
+			input.Application.NamespaceSelector = &metav1.LabelSelector{
+				MatchLabels: map[string]string{"team": "pay ments"},
+			}
+			Expect(ValidateApplication(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `namespaceSelector is invalid`))
+		})
+		It("should fail for a namespace selector expression without values", func() {
+			input.Application.NamespaceSelector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "team", Operator: metav1.LabelSelectorOpIn},
+				},
+			}
+			Expect(ValidateApplication(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `namespaceSelector is invalid`))
+		})
+		It("should fail for a pod selector with an unknown operator", func() {
+			input.Application.Selector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "app", Operator: "Matches", Values: []string{"foo"}},
+				},
+			}
+			Expect(ValidateApplication(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `selector is invalid`))
+		})
+	})
 })
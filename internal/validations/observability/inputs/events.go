@@ -0,0 +1,38 @@
+package inputs
+
+import (
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ValidateEvents validates events input specs. Events are collected by a single collector replica which
+// can not collect the logs of the nodes and is neither scaled nor autoscaled
+func ValidateEvents(spec obs.InputSpec, forwarderSpec obs.ClusterLogForwarderSpec) []metav1.Condition {
+	if spec.Type != obs.InputTypeEvents {
+		return nil
+	}
+	if collector := forwarderSpec.Collector; collector != nil && collector.Deployment != nil {
+		deployment := collector.Deployment
+		if (deployment.Replicas != nil && *deployment.Replicas != 1) || deployment.Autoscaling != nil {
+			return []metav1.Condition{
+				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure,
+					fmt.Sprintf("%s is collected by a single replica, remove the replicas and autoscaling of the collector deployment", spec.Name)),
+			}
+		}
+	}
+	for _, i := range forwarderSpec.Inputs {
+		switch i.Type {
+		case obs.InputTypeApplication, obs.InputTypeInfrastructure, obs.InputTypeAudit:
+			return []metav1.Condition{
+				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure,
+					fmt.Sprintf("%s can not be collected by a forwarder with %s inputs, use a separate forwarder for events", spec.Name, i.Type)),
+			}
+		}
+	}
+	return []metav1.Condition{
+		internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, true, obs.ReasonValidationSuccess, fmt.Sprintf("input %q is valid", spec.Name)),
+	}
+}
@@ -0,0 +1,59 @@
+package inputs
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("#ValidateEvents", func() {
+
+	var (
+		spec               obs.InputSpec
+		expConditionTypeRE = obs.ConditionTypeValidInputPrefix + "-.*"
+	)
+	BeforeEach(func() {
+		spec = obs.InputSpec{
+			Name:   "myevents",
+			Type:   obs.InputTypeEvents,
+			Events: &obs.EventsInput{},
+		}
+	})
+	It("should skip the validation when not an events type", func() {
+		spec.Type = obs.InputTypeApplication
+		Expect(ValidateEvents(spec, obs.ClusterLogForwarderSpec{Inputs: []obs.InputSpec{spec}})).To(BeEmpty())
+	})
+	It("should pass when the other inputs do not collect node logs", func() {
+		inputs := []obs.InputSpec{spec, {Name: "myreceiver", Type: obs.InputTypeReceiver}}
+		conds := ValidateEvents(spec, obs.ClusterLogForwarderSpec{Inputs: inputs})
+		Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+	})
+	It("should fail when another input collects node logs", func() {
+		inputs := []obs.InputSpec{spec, {Name: "application", Type: obs.InputTypeApplication}}
+		conds := ValidateEvents(spec, obs.ClusterLogForwarderSpec{Inputs: inputs})
+		Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "myevents can not be collected by a forwarder with application inputs"))
+	})
+	It("should pass when the collector deployment is a single replica", func() {
+		forwarderSpec := obs.ClusterLogForwarderSpec{
+			Inputs:    []obs.InputSpec{spec},
+			Collector: &obs.CollectorSpec{Deployment: &obs.CollectorDeploymentSpec{Replicas: utils.GetPtr(int32(1))}},
+		}
+		conds := ValidateEvents(spec, forwarderSpec)
+		Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+	})
+	It("should fail when the collector deployment is scaled or autoscaled", func() {
+		for _, deployment := range []*obs.CollectorDeploymentSpec{
+			{Replicas: utils.GetPtr(int32(3))},
+			{Autoscaling: &obs.CollectorAutoscalingSpec{MaxReplicas: 3}},
+		} {
+			forwarderSpec := obs.ClusterLogForwarderSpec{
+				Inputs:    []obs.InputSpec{spec},
+				Collector: &obs.CollectorSpec{Deployment: deployment},
+			}
+			conds := ValidateEvents(spec, forwarderSpec)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "myevents is collected by a single replica"))
+		}
+	})
+})
@@ -2,10 +2,13 @@ package inputs
 
 import (
 	"fmt"
+	"path"
+	"regexp"
 	"strings"
 
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/auth"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/set"
 )
@@ -32,7 +35,61 @@ func ValidateInfrastructure(spec obs.InputSpec) []metav1.Condition {
 			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s tuning section available only for \"container\" source type, but found %s", spec.Name, strSources)),
 		}
 	}
+	if tuning := spec.Infrastructure.Tuning; tuning != nil && tuning.Journal != nil {
+		if len(spec.Infrastructure.Sources) > 0 && !set.New(spec.Infrastructure.Sources...).Has(obs.InfrastructureSourceNode) {
+			return []metav1.Condition{
+				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s journal tuning section available only for %q source type", spec.Name, obs.InfrastructureSourceNode)),
+			}
+		}
+		if tuning.Journal.Priority != nil {
+			for _, match := range tuning.Journal.IncludeMatches {
+				if match.Field == "PRIORITY" {
+					return []metav1.Condition{
+						internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s journal tuning can not define both a priority and includeMatches for the PRIORITY field", spec.Name)),
+					}
+				}
+			}
+		}
+	}
+	if set.New(spec.Infrastructure.Sources...).Has(obs.InfrastructureSourceFile) {
+		if spec.Infrastructure.Files == nil {
+			return []metav1.Condition{
+				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonMissingSpec, fmt.Sprintf("%s has nil files spec for the %q source", spec.Name, obs.InfrastructureSourceFile)),
+			}
+		}
+		if msg := validateNodeFiles(*spec.Infrastructure.Files); msg != "" {
+			return []metav1.Condition{
+				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s %s", spec.Name, msg)),
+			}
+		}
+	}
 	return []metav1.Condition{
 		internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, true, obs.ReasonValidationSuccess, fmt.Sprintf("input %q is valid", spec.Name)),
 	}
 }
+
+// validateNodeFiles verifies the files of the node are below the allowed log paths and are not collected by another source
+func validateNodeFiles(files obs.NodeFiles) string {
+	for _, include := range files.Includes {
+		if !path.IsAbs(include) {
+			return fmt.Sprintf("include %q must be an absolute path", include)
+		}
+		dir := internalobs.NodeFileDir(include)
+		if !auth.IsAllowedNodeLogPath(dir) {
+			return fmt.Sprintf("include %q must be in a subdirectory of %s", include, strings.Join(auth.AllowedNodeLogPaths, ","))
+		}
+		for _, reserved := range internalobs.ReservedNodeLogDirs.List() {
+			if dir == reserved || strings.HasPrefix(dir, reserved+"/") {
+				return fmt.Sprintf("include %q is in %s which is collected by another source", include, reserved)
+			}
+		}
+	}
+	if ml := files.Multiline; ml != nil {
+		for _, pattern := range []string{ml.StartPattern, ml.ConditionPattern} {
+			if _, err := regexp.Compile(pattern); err != nil {
+				return fmt.Sprintf("multiline pattern %q is not a valid regular expression: %v", pattern, err)
+			}
+		}
+	}
+	return ""
+}
@@ -80,4 +80,73 @@ var _ = Describe("#ValidateInfrastructure", func() {
 		}
 		Expect(ValidateInfrastructure(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "tuning section available only for \"container\" source type, but found node"))
 	})
+	Context("with journal tuning", func() {
+		BeforeEach(func() {
+			input.Infrastructure = &obs.Infrastructure{
+				Sources: []obs.InfrastructureSource{obs.InfrastructureSourceNode},
+				Tuning: &obs.InfrastructureInputTuningSpec{
+					Journal: &obs.JournalInputTuningSpec{
+						ExcludeUnits: []string{"crio"},
+						Priority:     utils.GetPtr(obs.JournalPriorityInfo),
+					},
+				},
+			}
+		})
+		It("should pass for the node source", func() {
+			Expect(ValidateInfrastructure(input)).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
+		It("should pass when sources is empty", func() {
+			input.Infrastructure.Sources = nil
+			Expect(ValidateInfrastructure(input)).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
+		It("should fail when the node source is not collected", func() {
+			input.Infrastructure.Sources = []obs.InfrastructureSource{obs.InfrastructureSourceContainer}
+			Expect(ValidateInfrastructure(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `journal tuning section available only for "node" source type`))
+		})
+		It("should fail when both a priority and includeMatches for the PRIORITY field are defined", func() {
+			input.Infrastructure.Tuning.Journal.IncludeMatches = []obs.JournalMatch{{Field: "PRIORITY", Values: []string{"0"}}}
+			Expect(ValidateInfrastructure(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `can not define both a priority and includeMatches`))
+		})
+	})
+	Context("with the file source", func() {
+		BeforeEach(func() {
+			input.Infrastructure = &obs.Infrastructure{
+				Sources: []obs.InfrastructureSource{obs.InfrastructureSourceFile},
+				Files: &obs.NodeFiles{
+					Includes: []string{"/var/log/myapp/*.log"},
+					Multiline: &obs.NodeFileMultiline{
+						StartPattern:     `^\d{4}-`,
+						ConditionPattern: `^\s+`,
+					},
+				},
+			}
+		})
+		It("should pass for files in a subdirectory of /var/log", func() {
+			Expect(ValidateInfrastructure(input)).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
+		It("should fail when the files spec is missing", func() {
+			input.Infrastructure.Files = nil
+			Expect(ValidateInfrastructure(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonMissingSpec, `nil files spec`))
+		})
+		It("should fail for a relative include", func() {
+			input.Infrastructure.Files.Includes = []string{"myapp/*.log"}
+			Expect(ValidateInfrastructure(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `must be an absolute path`))
+		})
+		It("should fail for files outside of /var/log", func() {
+			input.Infrastructure.Files.Includes = []string{"/etc/kubernetes/*.conf"}
+			Expect(ValidateInfrastructure(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `must be in a subdirectory of /var/log`))
+		})
+		It("should fail for files directly matched in /var/log", func() {
+			input.Infrastructure.Files.Includes = []string{"/var/log/*/*.log"}
+			Expect(ValidateInfrastructure(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `must be in a subdirectory of /var/log`))
+		})
+		It("should fail for files collected by another source", func() {
+			input.Infrastructure.Files.Includes = []string{"/var/log/pods/*/*/*.log"}
+			Expect(ValidateInfrastructure(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `is in /var/log/pods which is collected by another source`))
+		})
+		It("should fail for an invalid multiline pattern", func() {
+			input.Infrastructure.Files.Multiline.ConditionPattern = `^(\s+`
+			Expect(ValidateInfrastructure(input)).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, `is not a valid regular expression`))
+		})
+	})
 })
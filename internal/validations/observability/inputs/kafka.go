@@ -0,0 +1,61 @@
+package inputs
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/validations/observability/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ValidateKafka validates kafka input specs
+func ValidateKafka(spec obs.InputSpec, secrets map[string]*corev1.Secret, configMaps map[string]*corev1.ConfigMap) []metav1.Condition {
+	if spec.Type != obs.InputTypeKafka {
+		return nil
+	}
+	if spec.Kafka == nil {
+		return []metav1.Condition{
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonMissingSpec, fmt.Sprintf("%s has nil kafka spec", spec.Name)),
+		}
+	}
+	if (len(spec.Kafka.Topics) == 0) == (spec.Kafka.TopicPattern == "") {
+		return []metav1.Condition{
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s must specify exactly one of topics or topicPattern", spec.Name)),
+		}
+	}
+	if spec.Kafka.TopicPattern != "" {
+		if message := validateTopicPattern(spec.Kafka.TopicPattern); message != "" {
+			return []metav1.Condition{
+				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s %s", spec.Name, message)),
+			}
+		}
+	}
+	keys := []*obs.ValueReference{}
+	for _, ref := range internalobs.KafkaSecretReferences(spec.Kafka.Authentication) {
+		keys = append(keys, &obs.ValueReference{Key: ref.Key, SecretName: ref.SecretName})
+	}
+	if spec.Kafka.TLS != nil {
+		keys = append(keys, internalobs.ValueReferences(spec.Kafka.TLS.TLSSpec)...)
+	}
+	if messages := common.ValidateValueReference(keys, secrets, configMaps); len(messages) > 0 {
+		return []metav1.Condition{
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, strings.Join(messages, ",")),
+		}
+	}
+
+	return []metav1.Condition{
+		internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, true, obs.ReasonValidationSuccess, fmt.Sprintf("input %q is valid", spec.Name)),
+	}
+}
+
+// validateTopicPattern returns a message when the topic pattern can not be used as a regular expression
+func validateTopicPattern(pattern string) string {
+	if _, err := regexp.Compile(pattern); err != nil {
+		return fmt.Sprintf("topicPattern is not a valid regular expression: %v", err)
+	}
+	return ""
+}
@@ -0,0 +1,83 @@
+package inputs
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("#ValidateKafka", func() {
+
+	var (
+		spec               obs.InputSpec
+		secrets            map[string]*corev1.Secret
+		configMaps         = map[string]*corev1.ConfigMap{}
+		expConditionTypeRE = obs.ConditionTypeValidInputPrefix + "-.*"
+	)
+	BeforeEach(func() {
+		secrets = map[string]*corev1.Secret{}
+		spec = obs.InputSpec{
+			Name: "mykafka",
+			Type: obs.InputTypeKafka,
+			Kafka: &obs.KafkaInput{
+				Brokers: []obs.BrokerURL{"tcp://broker:9092"},
+				Topics:  []string{"app-logs"},
+			},
+		}
+	})
+	It("should skip the validation when not a kafka type", func() {
+		spec.Type = obs.InputTypeApplication
+		Expect(ValidateKafka(spec, secrets, configMaps)).To(BeEmpty())
+	})
+	It("should fail when a kafka type but has no kafka spec", func() {
+		spec.Kafka = nil
+		conds := ValidateKafka(spec, secrets, configMaps)
+		Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonMissingSpec, "mykafka has nil kafka spec"))
+	})
+	It("should pass for a valid kafka spec", func() {
+		conds := ValidateKafka(spec, secrets, configMaps)
+		Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+	})
+	It("should fail when both topics and a topicPattern are specified", func() {
+		spec.Kafka.TopicPattern = "app-.*"
+		conds := ValidateKafka(spec, secrets, configMaps)
+		Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "exactly one of topics or topicPattern"))
+	})
+	It("should fail when neither topics nor a topicPattern are specified", func() {
+		spec.Kafka.Topics = nil
+		conds := ValidateKafka(spec, secrets, configMaps)
+		Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "exactly one of topics or topicPattern"))
+	})
+	It("should fail when the topicPattern is not a valid regular expression", func() {
+		spec.Kafka.Topics = nil
+		spec.Kafka.TopicPattern = "app-(.*"
+		conds := ValidateKafka(spec, secrets, configMaps)
+		Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "topicPattern is not a valid regular expression"))
+	})
+	Context("with SASL authentication", func() {
+		BeforeEach(func() {
+			spec.Kafka.Authentication = &obs.KafkaAuthentication{
+				SASL: &obs.SASLAuthentication{
+					Username: &obs.SecretReference{SecretName: "kafka-secret", Key: constants.ClientUsername},
+					Password: &obs.SecretReference{SecretName: "kafka-secret", Key: constants.ClientPassword},
+				},
+			}
+		})
+		It("should fail when the secret does not exist", func() {
+			conds := ValidateKafka(spec, secrets, configMaps)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "kafka-secret"))
+		})
+		It("should pass when the secret has the username and password", func() {
+			secrets["kafka-secret"] = runtime.NewSecret("", "kafka-secret", map[string][]byte{
+				constants.ClientUsername: []byte("user"),
+				constants.ClientPassword: []byte("pass"),
+			})
+			conds := ValidateKafka(spec, secrets, configMaps)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
+	})
+})
@@ -3,6 +3,7 @@ package inputs
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 
 	log "github.com/ViaQ/logerr/v2/log/static"
@@ -36,11 +37,64 @@ func ValidateReceiver(spec obs.InputSpec, secrets map[string]*corev1.Secret, con
 			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonMissingSpec, fmt.Sprintf("%s has nil HTTP receiver spec", spec.Name)),
 		}
 	}
+	if spec.Receiver.Type == obs.ReceiverTypeOTLP && spec.Receiver.OTLP == nil {
+		return []metav1.Condition{
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonMissingSpec, fmt.Sprintf("%s has nil OTLP receiver spec", spec.Name)),
+		}
+	}
+	if spec.Receiver.Type == obs.ReceiverTypeSplunkHEC && spec.Receiver.SplunkHEC == nil {
+		return []metav1.Condition{
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonMissingSpec, fmt.Sprintf("%s has nil splunkHEC receiver spec", spec.Name)),
+		}
+	}
+	if spec.Receiver.Type == obs.ReceiverTypeFluentForward && spec.Receiver.FluentForward != nil && spec.Receiver.FluentForward.TagPattern != "" {
+		if message := validateTagPattern(spec.Receiver.FluentForward.TagPattern); message != "" {
+			return []metav1.Condition{
+				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s %s", spec.Name, message)),
+			}
+		}
+	}
+	if spec.Receiver.Type == obs.ReceiverTypeFluentForward && spec.Receiver.FluentForward != nil && spec.Receiver.FluentForward.SharedKey != nil {
+		sharedKey := spec.Receiver.FluentForward.SharedKey
+		keys := []*obs.ValueReference{{Key: sharedKey.Key, SecretName: sharedKey.SecretName}}
+		if messages := common.ValidateValueReference(keys, secrets, configMaps); len(messages) > 0 {
+			return []metav1.Condition{
+				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, strings.Join(messages, ",")),
+			}
+		}
+	}
 	if spec.Receiver.Type == obs.ReceiverTypeHTTP && spec.Receiver.HTTP.Format == "" {
 		return []metav1.Condition{
 			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s does not specify a format", spec.Name)),
 		}
 	}
+	if spec.Receiver.Type == obs.ReceiverTypeHTTP && spec.Receiver.HTTP.Authentication != nil && spec.Receiver.HTTP.Authentication.Token != nil {
+		token := spec.Receiver.HTTP.Authentication.Token
+		keys := []*obs.ValueReference{{Key: token.Key, SecretName: token.SecretName}}
+		if messages := common.ValidateValueReference(keys, secrets, configMaps); len(messages) > 0 {
+			return []metav1.Condition{
+				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, strings.Join(messages, ",")),
+			}
+		}
+	}
+	if spec.Receiver.Type == obs.ReceiverTypeSplunkHEC {
+		if len(spec.Receiver.SplunkHEC.Tokens) == 0 {
+			return []metav1.Condition{
+				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, fmt.Sprintf("%s does not specify any HEC tokens", spec.Name)),
+			}
+		}
+		keys := []*obs.ValueReference{}
+		for _, token := range spec.Receiver.SplunkHEC.Tokens {
+			if token != nil {
+				keys = append(keys, &obs.ValueReference{Key: token.Key, SecretName: token.SecretName})
+			}
+		}
+		if messages := common.ValidateValueReference(keys, secrets, configMaps); len(messages) > 0 {
+			return []metav1.Condition{
+				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidInputPrefix, spec.Name, false, obs.ReasonValidationFailure, strings.Join(messages, ",")),
+			}
+		}
+	}
 	if spec.Receiver.TLS != nil {
 		tlsSpec := obs.TLSSpec(*spec.Receiver.TLS)
 		keys := internalobs.ValueReferences(tlsSpec)
@@ -58,6 +112,17 @@ func ValidateReceiver(spec obs.InputSpec, secrets map[string]*corev1.Secret, con
 	}
 }
 
+// validateTagPattern returns a message when the fluent tag pattern can not be used as a VRL raw string regex
+func validateTagPattern(pattern string) string {
+	if strings.Contains(pattern, "'") {
+		return "tagPattern must not contain a single quote"
+	}
+	if _, err := regexp.Compile(pattern); err != nil {
+		return fmt.Sprintf("tagPattern is not a valid regular expression: %v", err)
+	}
+	return ""
+}
+
 func removeGeneratedSecrets(keys []*obs.ValueReference, skipKeys *set.Set) (result []*obs.ValueReference) {
 	for _, secretKey := range keys {
 		if secretKey.SecretName != "" {
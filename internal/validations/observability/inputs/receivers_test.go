@@ -57,6 +57,94 @@ var _ = Describe("#ValidateReceiver", func() {
 			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
 			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
 		})
+		It("should fail when receiver type is OTLP but does not have otlp receiver spec", func() {
+			spec.Receiver.Type = obs.ReceiverTypeOTLP
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonMissingSpec, "myreceiver has nil OTLP receiver spec"))
+		})
+		It("should pass for a valid OTLP receiver spec", func() {
+			spec.Receiver.Type = obs.ReceiverTypeOTLP
+			spec.Receiver.Port = 4318
+			spec.Receiver.OTLP = &obs.OTLPReceiver{
+				GRPCPort: 4317,
+			}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
+		It("should pass for a fluentForward receiver with a valid tag pattern", func() {
+			spec.Receiver.Type = obs.ReceiverTypeFluentForward
+			spec.Receiver.FluentForward = &obs.FluentForwardReceiver{
+				TagPattern: `^app\.(?P<namespace>[^.]+)\.(?P<app>[^.]+)$`,
+			}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
+		It("should fail for a fluentForward receiver with an invalid tag pattern", func() {
+			spec.Receiver.Type = obs.ReceiverTypeFluentForward
+			spec.Receiver.FluentForward = &obs.FluentForwardReceiver{
+				TagPattern: `^app\.(?P<namespace>[^.]+`,
+			}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "myreceiver tagPattern is not a valid regular expression.*"))
+		})
+		It("should fail for a fluentForward receiver with a shared key in a missing secret", func() {
+			spec.Receiver.Type = obs.ReceiverTypeFluentForward
+			spec.Receiver.FluentForward = &obs.FluentForwardReceiver{
+				SharedKey: &obs.SecretReference{Key: "shared_key", SecretName: "fluent-key"},
+			}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, ".*fluent-key.*"))
+		})
+		It("should fail for a fluentForward receiver with a tag pattern containing a single quote", func() {
+			spec.Receiver.Type = obs.ReceiverTypeFluentForward
+			spec.Receiver.FluentForward = &obs.FluentForwardReceiver{
+				TagPattern: `^app'(?P<namespace>.+)$`,
+			}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "myreceiver tagPattern must not contain a single quote"))
+		})
+		It("should fail when receiver type is splunkHEC but does not have splunkHEC receiver spec", func() {
+			spec.Receiver.Type = obs.ReceiverTypeSplunkHEC
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonMissingSpec, "myreceiver has nil splunkHEC receiver spec"))
+		})
+		It("should fail for a splunkHEC receiver without tokens", func() {
+			spec.Receiver.Type = obs.ReceiverTypeSplunkHEC
+			spec.Receiver.SplunkHEC = &obs.SplunkHECReceiver{}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "myreceiver does not specify any HEC tokens"))
+		})
+		It("should fail when a splunkHEC receiver token secret is missing", func() {
+			spec.Receiver.Type = obs.ReceiverTypeSplunkHEC
+			spec.Receiver.SplunkHEC = &obs.SplunkHECReceiver{
+				Tokens: []*obs.SecretReference{
+					{Key: constants.SplunkHECTokenKey, SecretName: "hec-token"},
+					{Key: constants.SplunkHECTokenKey, SecretName: "immissing"},
+				},
+			}
+			secrets := map[string]*corev1.Secret{
+				"hec-token": runtime.NewSecret("", "hec-token", map[string][]byte{
+					constants.SplunkHECTokenKey: []byte("abc"),
+				}),
+			}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "immissing"))
+		})
+		It("should pass when the splunkHEC receiver token secrets exist", func() {
+			spec.Receiver.Type = obs.ReceiverTypeSplunkHEC
+			spec.Receiver.SplunkHEC = &obs.SplunkHECReceiver{
+				Tokens: []*obs.SecretReference{
+					{Key: constants.SplunkHECTokenKey, SecretName: "hec-token"},
+				},
+			}
+			secrets := map[string]*corev1.Secret{
+				"hec-token": runtime.NewSecret("", "hec-token", map[string][]byte{
+					constants.SplunkHECTokenKey: []byte("abc"),
+				}),
+			}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
 		It("should pass for a valid syslog receiver spec", func() {
 			spec.Receiver.Type = obs.ReceiverTypeSyslog
 			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
@@ -73,6 +161,39 @@ var _ = Describe("#ValidateReceiver", func() {
 			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
 			Expect(conds).To(Not(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, "")))
 		})
+		It("should fail when the HTTP receiver authentication token secret is missing", func() {
+			spec.Receiver.Type = obs.ReceiverTypeHTTP
+			spec.Receiver.HTTP = &obs.HTTPReceiver{
+				Format: obs.HTTPReceiverFormatJSON,
+				Authentication: &obs.HTTPReceiverAuthentication{
+					Token: &obs.SecretReference{
+						Key:        constants.TokenKey,
+						SecretName: "immissing",
+					},
+				},
+			}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, false, obs.ReasonValidationFailure, "immissing"))
+		})
+		It("should pass when the HTTP receiver authentication token secret exists", func() {
+			spec.Receiver.Type = obs.ReceiverTypeHTTP
+			spec.Receiver.HTTP = &obs.HTTPReceiver{
+				Format: obs.HTTPReceiverFormatNDJSON,
+				Authentication: &obs.HTTPReceiverAuthentication{
+					Token: &obs.SecretReference{
+						Key:        constants.TokenKey,
+						SecretName: "receiver-token",
+					},
+				},
+			}
+			secrets := map[string]*corev1.Secret{
+				"receiver-token": runtime.NewSecret("", "receiver-token", map[string][]byte{
+					constants.TokenKey: []byte("abc"),
+				}),
+			}
+			conds := ValidateReceiver(spec, secrets, configMaps, utils.NoOptions)
+			Expect(conds).To(HaveCondition(expConditionTypeRE, true, obs.ReasonValidationSuccess, `input.*is valid`))
+		})
 		Context("for secrets provided by the cert signing service", func() {
 			It("should skip validation", func() {
 
@@ -20,6 +20,10 @@ func Validate(context internalcontext.ForwarderContext) {
 			conditions = ValidateAudit(i)
 		case obs.InputTypeReceiver:
 			conditions = ValidateReceiver(i, context.Secrets, context.ConfigMaps, context.AdditionalContext)
+		case obs.InputTypeKafka:
+			conditions = ValidateKafka(i, context.Secrets, context.ConfigMaps)
+		case obs.InputTypeEvents:
+			conditions = ValidateEvents(i, context.Forwarder.Spec)
 		}
 		results = append(results, conditions...)
 	}
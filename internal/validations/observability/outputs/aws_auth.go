@@ -14,6 +14,9 @@ const (
 
 // ValidateAwsAuth ensures auth role and assumeRole ARN's are valid
 func ValidateAwsAuth(o obs.OutputSpec, context internalcontext.ForwarderContext) (results []string) {
+	if context.Secrets == nil {
+		return results
+	}
 	secrets := observability.Secrets(context.Secrets)
 	if isRoleAuth, awsAuth := aws.OutputIsAwsRoleAuth(o); isRoleAuth {
 		roleArn := aws.ParseRoleArn(awsAuth, secrets)
@@ -19,8 +19,11 @@ func Validate(context internalcontext.ForwarderContext) {
 			messages = append(messages, validateURLAccordingToTLS(out)...)
 			configs = append(configs, internalobs.ValueReferences(out.TLS.TLSSpec)...)
 		}
+		for _, ref := range internalobs.ConfigmapReferences(out) {
+			configs = append(configs, &obs.ValueReference{ConfigMapName: ref.ConfigMapName, Key: ref.Key})
+		}
 		messages = append(messages, common.ValidateValueReference(configs, context.Secrets, context.ConfigMaps)...)
-		messages = append(messages, validateOutputIsReferencedByPipelines(out, pipelines)...)
+		messages = append(messages, validateOutputIsReferencedByPipelines(out, pipelines, context.Forwarder.Spec.Outputs)...)
 		// Validate by output type
 		switch out.Type {
 		case obs.OutputTypeCloudwatch, obs.OutputTypeS3:
@@ -36,6 +39,8 @@ func Validate(context internalcontext.ForwarderContext) {
 			messages = append(messages, validateElasticsearchHeaders(out)...)
 		case obs.OutputTypeAzureLogsIngestion:
 			messages = append(messages, validateAzureLogsIngestionMaxWrite(out)...)
+		case obs.OutputTypeSocket:
+			messages = append(messages, validateSocketUnix(out, *context.Forwarder)...)
 		}
 		// Set condition
 		if len(messages) > 0 {
@@ -48,8 +53,10 @@ func Validate(context internalcontext.ForwarderContext) {
 	}
 }
 
-func validateOutputIsReferencedByPipelines(output obs.OutputSpec, pipelines internalobs.Pipelines) (results []string) {
-	if !pipelines.ReferenceOutput(output) {
+// validateOutputIsReferencedByPipelines validates the output is referenced by a pipeline directly or as
+// the dead-letter output of another output
+func validateOutputIsReferencedByPipelines(output obs.OutputSpec, pipelines internalobs.Pipelines, outputs internalobs.Outputs) (results []string) {
+	if !pipelines.ReferenceOutput(output) && !outputs.ReferenceDeadLetter(output) {
 		return append(results, "not referenced by any pipeline")
 	}
 	return results
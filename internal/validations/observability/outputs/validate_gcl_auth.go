@@ -35,6 +35,9 @@ func ValidateGCLAuth(o obs.OutputSpec, context internalcontext.ForwarderContext)
 	if auth.Credentials == nil {
 		return append(results, "GCP authentication is missing credentials")
 	}
+	if context.Secrets == nil {
+		return results
+	}
 
 	creds, err := parseGCPCredentials(auth.Credentials, secrets)
 	if err != nil {
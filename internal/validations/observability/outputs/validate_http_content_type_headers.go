@@ -9,17 +9,21 @@ import (
 )
 
 var validContentTypes = map[string]string{
-	"application/json":     "json",
-	"application/x-ndjson": "ndjson",
+	"application/json":       "json",
+	"application/x-ndjson":   "ndjson",
+	"text/plain":             "text",
+	"text/csv":               "csv",
+	"application/x-protobuf": "protobuf",
+	"application/avro":       "avro",
 }
 
 // validateHttpContentTypeHeaders will validate Content-Type header in Http Output
-// valid content-type are: "application/json" and "application/x-ndjson"
+// valid content-type are: "application/json" and "application/x-ndjson" or the content type of the codec
 // was introduced in https://github.com/openshift/cluster-logging-operator/pull/1924
 // for https://issues.redhat.com/browse/LOG-3784
 func validateHttpContentTypeHeaders(output obs.OutputSpec) (results []string) {
 	if output.Type == obs.OutputTypeHTTP && output.HTTP != nil {
-		if contentType, found := output.HTTP.Headers["Content-Type"]; found && validContentTypes[strings.ToLower(contentType)] == "" {
+		if contentType, found := output.HTTP.Headers["Content-Type"]; found && !isValidContentType(output.HTTP, contentType) {
 			validKeys := reflect.ValueOf(validContentTypes).MapKeys()
 			log.V(3).Info("validateHttpContentTypeHeaders failed", "reason", "not valid content type set in headers",
 				"content type", contentType, "supported types: ", validKeys)
@@ -28,3 +32,15 @@ func validateHttpContentTypeHeaders(output obs.OutputSpec) (results []string) {
 	}
 	return results
 }
+
+// isValidContentType returns true for json content types or the content type matching the codec of the output
+func isValidContentType(h *obs.HTTP, contentType string) bool {
+	switch codecType := validContentTypes[strings.ToLower(contentType)]; codecType {
+	case "":
+		return false
+	case string(obs.HTTPCodecTypeJSON), string(obs.HTTPCodecTypeNDJSON):
+		return true
+	default:
+		return h.Codec != nil && string(h.Codec.Type) == codecType
+	}
+}
@@ -43,6 +43,20 @@ var _ = Describe("[internal][validations] ClusterLogForwarder will validate Cont
 			}
 			Expect(validateHttpContentTypeHeaders(spec)).To(BeEmpty())
 		})
+		It("should pass validation when the Content Type header matches the codec", func() {
+			spec.HTTP.Codec = &v1.HTTPCodec{Type: v1.HTTPCodecTypeCSV}
+			spec.HTTP.Headers = map[string]string{
+				"Content-Type": "text/csv",
+			}
+			Expect(validateHttpContentTypeHeaders(spec)).To(BeEmpty())
+		})
+		It("should fail validation when the Content Type header does not match the codec", func() {
+			spec.HTTP.Codec = &v1.HTTPCodec{Type: v1.HTTPCodecTypeText}
+			spec.HTTP.Headers = map[string]string{
+				"Content-Type": "text/csv",
+			}
+			Expect(validateHttpContentTypeHeaders(spec)).ToNot(BeEmpty())
+		})
 		It("should fail validation when not valid content types", func() {
 			spec.HTTP.Headers = map[string]string{
 				"Content-Type": "application/x-www-form-urlencoded",
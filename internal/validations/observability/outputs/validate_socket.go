@@ -0,0 +1,18 @@
+package outputs
+
+import (
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+)
+
+// validateSocketUnix validates a unix socket is only the destination of a collector deployed as a daemonset which
+// forwards directly to its outputs, because the directory of the socket is only mounted from the node into its pods
+func validateSocketUnix(output obs.OutputSpec, forwarder obs.ClusterLogForwarder) (results []string) {
+	if internalobs.UnixSocketPath(output) == "" {
+		return results
+	}
+	if internalobs.DeployAsDeployment(forwarder) || internalobs.UseAggregator(forwarder) {
+		return append(results, "unix sockets are only supported when the collector is deployed as a daemonset without an aggregator")
+	}
+	return results
+}
@@ -0,0 +1,42 @@
+package outputs
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("#validateSocketUnix", func() {
+	var (
+		forwarder obs.ClusterLogForwarder
+		socket    = func(url string) obs.OutputSpec {
+			return obs.OutputSpec{Name: "local", Type: obs.OutputTypeSocket, Socket: &obs.Socket{URL: url}}
+		}
+	)
+	BeforeEach(func() {
+		forwarder = obs.ClusterLogForwarder{
+			Spec: obs.ClusterLogForwarderSpec{
+				Inputs: []obs.InputSpec{{Name: "infra", Type: obs.InputTypeInfrastructure}},
+			},
+		}
+	})
+
+	It("should pass a unix socket forwarded to by a daemonset", func() {
+		Expect(validateSocketUnix(socket("unix:///var/run/collector/logs.sock"), forwarder)).To(BeEmpty())
+	})
+
+	It("should pass a network socket forwarded to by a deployment", func() {
+		forwarder.Spec.Inputs = []obs.InputSpec{{Name: "events", Type: obs.InputTypeEvents}}
+		Expect(validateSocketUnix(socket("tcp://collector.example.com:5170"), forwarder)).To(BeEmpty())
+	})
+
+	It("should fail a unix socket forwarded to by a deployment", func() {
+		forwarder.Spec.Inputs = []obs.InputSpec{{Name: "events", Type: obs.InputTypeEvents}}
+		Expect(validateSocketUnix(socket("unix:///var/run/collector/logs.sock"), forwarder)).To(ConsistOf(ContainSubstring("daemonset")))
+	})
+
+	It("should fail a unix socket forwarded to by an aggregator", func() {
+		forwarder.Spec.Collector = &obs.CollectorSpec{Aggregator: &obs.CollectorAggregatorSpec{}}
+		Expect(validateSocketUnix(socket("unix:///var/run/collector/logs.sock"), forwarder)).To(ConsistOf(ContainSubstring("aggregator")))
+	})
+})
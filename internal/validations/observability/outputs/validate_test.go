@@ -146,6 +146,55 @@ var _ = Describe("Validating outputs", func() {
 			),
 		)
 	})
+
+	Context("with vendor outputs", func() {
+		DescribeTable("when validating the required secret keys",
+			func(spec obs.OutputSpec, expMessage string) {
+				context := createContext([]obs.OutputSpec{spec})
+				Validate(context)
+				expStatus := expMessage == ""
+				reason := obs.ReasonValidationSuccess
+				if !expStatus {
+					reason = obs.ReasonValidationFailure
+				} else {
+					expMessage = ".*"
+				}
+				Expect(context.Forwarder.Status.OutputConditions).To(HaveEach(matchers.MatchCondition(".*", expStatus, reason, expMessage)))
+			},
+			Entry("should accept a Datadog API key that exists",
+				obs.OutputSpec{
+					Name: "datadog",
+					Type: obs.OutputTypeDatadog,
+					Datadog: &obs.Datadog{
+						Authentication: &obs.DatadogAuthentication{
+							APIKey: &obs.SecretReference{SecretName: foo, Key: constants.AwsSecretAccessKey},
+						},
+					},
+				}, ""),
+			Entry("should fail when the Datadog API key is missing from the secret",
+				obs.OutputSpec{
+					Name: "datadog",
+					Type: obs.OutputTypeDatadog,
+					Datadog: &obs.Datadog{
+						Authentication: &obs.DatadogAuthentication{
+							APIKey: &obs.SecretReference{SecretName: foo, Key: "api-key"},
+						},
+					},
+				}, `secret\[foo.api-key\] not found`),
+			Entry("should fail when the New Relic license key secret does not exist",
+				obs.OutputSpec{
+					Name: "newrelic",
+					Type: obs.OutputTypeNewRelic,
+					NewRelic: &obs.NewRelic{
+						AccountID: "1234567",
+						Authentication: &obs.NewRelicAuthentication{
+							LicenseKey: &obs.SecretReference{SecretName: "missing", Key: "license-key"},
+						},
+					},
+				}, `secret\[missing\] not found`),
+		)
+	})
+
 	Context("when not referenced by any pipeline", func() {
 		It("should generate a failure validation message", func() {
 			outputName := "unreferenced"
@@ -164,5 +213,31 @@ var _ = Describe("Validating outputs", func() {
 			Validate(context)
 			Expect(context.Forwarder.Status.OutputConditions[0]).To(matchers.MatchCondition(outputName, false, obs.ReasonValidationFailure, ".*"))
 		})
+		It("should pass when referenced as the dead-letter output of another output", func() {
+			context := createContext([]obs.OutputSpec{
+				{
+					Name:                           "primary",
+					Type:                           obs.OutputTypeHTTP,
+					PreDeliveryRejectionsOutputRef: "rejects",
+					HTTP: &obs.HTTP{
+						URLSpec: obs.URLSpec{
+							URL: "http://primary",
+						},
+					},
+				},
+				{
+					Name: "rejects",
+					Type: obs.OutputTypeHTTP,
+					HTTP: &obs.HTTP{
+						URLSpec: obs.URLSpec{
+							URL: "http://rejects",
+						},
+					},
+				},
+			})
+			context.Forwarder.Spec.Pipelines[0].OutputRefs = []string{"primary"}
+			Validate(context)
+			Expect(context.Forwarder.Status.OutputConditions).To(HaveEach(matchers.MatchCondition(".*", true, obs.ReasonValidationSuccess, ".*")))
+		})
 	})
 })
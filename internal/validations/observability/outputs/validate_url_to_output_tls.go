@@ -15,6 +15,8 @@ func validateURLAccordingToTLS(output obs.OutputSpec) (results []string) {
 	switch output.Type {
 	case obs.OutputTypeCloudwatch:
 		specURL = output.Cloudwatch.URL
+	case obs.OutputTypeDatadog:
+		specURL = output.Datadog.URL
 	case obs.OutputTypeElasticsearch:
 		specURL = output.Elasticsearch.URL
 	case obs.OutputTypeHTTP:
@@ -25,6 +27,8 @@ func validateURLAccordingToTLS(output obs.OutputSpec) (results []string) {
 		specURL = output.Loki.URL
 	case obs.OutputTypeSplunk:
 		specURL = output.Splunk.URL
+	case obs.OutputTypeSocket:
+		specURL = output.Socket.URL
 	case obs.OutputTypeSyslog:
 		specURL = output.Syslog.URL
 	case obs.OutputTypeOTLP:
@@ -0,0 +1,51 @@
+package pipelines
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+)
+
+var _ = Describe("Pipeline validation #verifyOutputRejectionsRefs", func() {
+
+	It("should pass when the pre-delivery rejections outputs exist", func() {
+		outputs := map[string]obs.OutputSpec{
+			"primary":   {Name: "primary", PreDeliveryRejectionsOutputRef: "rejects"},
+			"secondary": {Name: "secondary", PreDeliveryRejectionsOutputRef: "rejects"},
+			"rejects":   {Name: "rejects"},
+		}
+		Expect(verifyOutputRejectionsRefs(outputs)).To(BeEmpty())
+	})
+
+	It("should fail when a pre-delivery rejections output does not exist", func() {
+		outputs := map[string]obs.OutputSpec{
+			"primary": {Name: "primary", PreDeliveryRejectionsOutputRef: "rejects"},
+		}
+		Expect(verifyOutputRejectionsRefs(outputs)).To(ConsistOf(`output "primary" preDeliveryRejectionsOutputRef not found: rejects`))
+	})
+
+	It("should fail when an output is its own pre-delivery rejections output", func() {
+		outputs := map[string]obs.OutputSpec{
+			"primary": {Name: "primary", PreDeliveryRejectionsOutputRef: "primary"},
+		}
+		Expect(verifyOutputRejectionsRefs(outputs)).To(ConsistOf(`output "primary" preDeliveryRejectionsOutputRef forms a cycle: primary -> primary`))
+	})
+
+	It("should fail when the pre-delivery rejections outputs form a cycle", func() {
+		outputs := map[string]obs.OutputSpec{
+			"primary":   {Name: "primary", PreDeliveryRejectionsOutputRef: "secondary"},
+			"secondary": {Name: "secondary", PreDeliveryRejectionsOutputRef: "tertiary"},
+			"tertiary":  {Name: "tertiary", PreDeliveryRejectionsOutputRef: "primary"},
+		}
+		Expect(verifyOutputRejectionsRefs(outputs)).To(ConsistOf(`output "tertiary" preDeliveryRejectionsOutputRef forms a cycle: primary -> secondary -> tertiary -> primary`))
+	})
+
+	It("should fail when outputs that are not referenced by a pipeline form a cycle", func() {
+		outputs := map[string]obs.OutputSpec{
+			"primary": {Name: "primary"},
+			"a":       {Name: "a", PreDeliveryRejectionsOutputRef: "b"},
+			"b":       {Name: "b", PreDeliveryRejectionsOutputRef: "a"},
+		}
+		Expect(verifyOutputRejectionsRefs(outputs)).To(ConsistOf(`output "b" preDeliveryRejectionsOutputRef forms a cycle: a -> b -> a`))
+	})
+})
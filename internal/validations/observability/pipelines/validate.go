@@ -2,23 +2,31 @@ package pipelines
 
 import (
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
-	"strings"
+	"github.com/openshift/cluster-logging-operator/internal/utils/sets"
 )
 
 func Validate(context internalcontext.ForwarderContext) {
 	inputs := internalobs.Inputs(context.Forwarder.Spec.Inputs).Map()
 	outputs := internalobs.Outputs(context.Forwarder.Spec.Outputs).Map()
 	filters := internalobs.FilterMap(context.Forwarder.Spec)
-	var messages []string
+	// Pre-delivery rejections references are resolved across all outputs. An invalid reference breaks the whole
+	// collector configuration and therefore invalidates every pipeline
+	rejectionsMessages := verifyOutputRejectionsRefs(outputs)
 	for _, pipelineSpec := range context.Forwarder.Spec.Pipelines {
+		var messages []string
 		refMessages := validateRef(pipelineSpec, inputs, outputs, filters)
 		if len(refMessages) > 0 {
 			messages = append(messages, fmt.Sprintf("refs not found: %s", strings.Join(refMessages, ",")))
 		}
 		messages = append(messages, verifyHostNameNotFilteredForGCL(pipelineSpec, outputs, filters)...)
+		messages = append(messages, rejectionsMessages...)
 		if len(messages) > 0 {
 			internalobs.SetCondition(&context.Forwarder.Status.PipelineConditions,
 				internalobs.NewConditionFromPrefix(obs.ConditionTypeValidPipelinePrefix, pipelineSpec.Name, false, obs.ReasonValidationFailure, strings.Join(messages, ",")))
@@ -83,6 +91,44 @@ func verifyHostNameNotFilteredForGCL(pipeline obs.PipelineSpec, outputs map[stri
 	return results
 }
 
+// verifyOutputRejectionsRefs verifies the pre-delivery rejections outputs of all outputs exist and the references
+// do not form a cycle
+func verifyOutputRejectionsRefs(outputs map[string]obs.OutputSpec) (results []string) {
+	names := make([]string, 0, len(outputs))
+	for name := range outputs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	visited := sets.NewString()
+	onPath := sets.NewString()
+	var path []string
+	var visit func(name string)
+	visit = func(name string) {
+		visited.Insert(name)
+		onPath.Insert(name)
+		path = append(path, name)
+		if ref := outputs[name].PreDeliveryRejectionsOutputRef; ref != "" {
+			if _, found := outputs[ref]; !found {
+				results = append(results, fmt.Sprintf("output %q preDeliveryRejectionsOutputRef not found: %s", name, ref))
+			} else if onPath.Has(ref) {
+				cycle := append(slices.Clone(path[slices.Index(path, ref):]), ref)
+				results = append(results, fmt.Sprintf("output %q preDeliveryRejectionsOutputRef forms a cycle: %s", name, strings.Join(cycle, " -> ")))
+			} else if !visited.Has(ref) {
+				visit(ref)
+			}
+		}
+		path = path[:len(path)-1]
+		onPath.Remove(name)
+	}
+	for _, name := range names {
+		if !visited.Has(name) {
+			visit(name)
+		}
+	}
+	return results
+}
+
 // prunesHostName checks if a prune filter prunes the `.hostname` field
 func prunesHostName(filter obs.FilterSpec) bool {
 	if filter.Type != obs.FilterTypePrune {
@@ -3,6 +3,8 @@ package observability
 import (
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	internalinit "github.com/openshift/cluster-logging-operator/internal/api/initialize"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
 	"github.com/openshift/cluster-logging-operator/internal/validations/observability/filters"
 	"github.com/openshift/cluster-logging-operator/internal/validations/observability/inputs"
 	"github.com/openshift/cluster-logging-operator/internal/validations/observability/outputs"
@@ -14,12 +16,24 @@ var (
 	clfValidators = []func(internalcontext.ForwarderContext){
 		validateLogLevelAnnotation,
 		validateMaxUnavailableAnnotation,
+		validateNodeProfiles,
 		ValidatePermissions,
 		inputs.Validate,
 		outputs.Validate,
 		filters.Validate,
 		pipelines.Validate,
 	}
+
+	// specValidators are the validators which only evaluate the forwarder spec and do not depend upon the service account
+	specValidators = []func(internalcontext.ForwarderContext){
+		validateLogLevelAnnotation,
+		validateMaxUnavailableAnnotation,
+		validateNodeProfiles,
+		inputs.Validate,
+		outputs.Validate,
+		filters.Validate,
+		pipelines.Validate,
+	}
 )
 
 // ValidateClusterLogForwarder validates the forwarder spec that can not be accomplished using api attributes and returns a set of conditions that apply to the spec
@@ -29,6 +43,33 @@ func ValidateClusterLogForwarder(context internalcontext.ForwarderContext) {
 	}
 }
 
+// ValidateClusterLogForwarderSpec validates the forwarder spec without the service account or the secrets and configmaps
+// it references (e.g. during admission) and returns the failed conditions that would be set on the status
+func ValidateClusterLogForwarderSpec(forwarder obs.ClusterLogForwarder) (failures []metav1.Condition) {
+	context := internalcontext.ForwarderContext{
+		AdditionalContext: utils.Options{},
+	}
+	initialized := internalinit.ClusterLogForwarder(*forwarder.DeepCopy(), context.AdditionalContext)
+	initialized.Status = obs.ClusterLogForwarderStatus{}
+	context.Forwarder = &initialized
+	for _, validate := range specValidators {
+		validate(context)
+	}
+	return failedConditions(context.Forwarder.Status)
+}
+
+// failedConditions returns the conditions of the status of a forwarder which are not met
+func failedConditions(status obs.ClusterLogForwarderStatus) (failures []metav1.Condition) {
+	for _, conditions := range [][]metav1.Condition{status.Conditions, status.InputConditions, status.OutputConditions, status.FilterConditions, status.PipelineConditions} {
+		for _, condition := range conditions {
+			if condition.Status == obs.ConditionFalse {
+				failures = append(failures, condition)
+			}
+		}
+	}
+	return failures
+}
+
 func MustUndeployCollector(conditions []metav1.Condition) bool {
 	for _, condition := range conditions {
 		if condition.Type == obs.ConditionTypeAuthorized && condition.Status == obs.ConditionFalse {
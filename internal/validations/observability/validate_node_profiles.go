@@ -0,0 +1,59 @@
+package observability
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+)
+
+// validateNodeProfiles validates the node profiles of the collector select distinct nodes and collect inputs of the
+// forwarder which are not served by the collector of the forwarder
+func validateNodeProfiles(context internalcontext.ForwarderContext) {
+	profiles := internalobs.NodeProfiles(*context.Forwarder)
+	inputs := internalobs.Inputs(context.Forwarder.Spec.Inputs).Map()
+	var messages []string
+	for i, profile := range profiles {
+		if profile.Name == constants.AggregatorName {
+			messages = append(messages, fmt.Sprintf("node profile %q is reserved for the aggregator tier", profile.Name))
+		}
+		if _, found := inputs[profile.Name]; found {
+			messages = append(messages, fmt.Sprintf("node profile %q must not have the name of an input", profile.Name))
+		}
+		for _, ref := range profile.InputRefs {
+			input, found := inputs[ref]
+			switch {
+			case !found:
+				messages = append(messages, fmt.Sprintf("node profile %q references undefined input %q", profile.Name, ref))
+			case input.Type == obs.InputTypeReceiver:
+				messages = append(messages, fmt.Sprintf("node profile %q references receiver input %q which is served by the collector of the forwarder", profile.Name, ref))
+			}
+		}
+		for _, other := range profiles[i+1:] {
+			if nodeSelectorsOverlap(profile.NodeSelector, other.NodeSelector) {
+				messages = append(messages, fmt.Sprintf("node profiles %q and %q select the same nodes: the node selectors must require a different value of a common label", profile.Name, other.Name))
+			}
+		}
+	}
+	if len(messages) > 0 {
+		condition := internalobs.NewCondition(obs.ConditionTypeValidNodeProfiles, obs.ConditionFalse, obs.ReasonValidationFailure, strings.Join(messages, ", "))
+		internalobs.SetCondition(&context.Forwarder.Status.Conditions, condition)
+		return
+	}
+	// Condition is only necessary when it is invalid, otherwise we can remove
+	internalobs.RemoveConditionByType(&context.Forwarder.Status.Conditions, obs.ConditionTypeValidNodeProfiles)
+}
+
+// nodeSelectorsOverlap returns true when a node can be selected by both node selectors which is the case unless
+// they require a different value of a common label
+func nodeSelectorsOverlap(selector, other map[string]string) bool {
+	for key, value := range selector {
+		if otherValue, found := other[key]; found && otherValue != value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+package observability
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	obsruntime "github.com/openshift/cluster-logging-operator/internal/runtime/observability"
+	. "github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("[internal][validations] validate clusterlogforwarder node profiles", func() {
+	var (
+		clf     *obs.ClusterLogForwarder
+		context internalcontext.ForwarderContext
+	)
+
+	BeforeEach(func() {
+		clf = obsruntime.NewClusterLogForwarder("foo", "bar", runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
+			clf.Spec.Inputs = []obs.InputSpec{
+				{Name: "my-app", Type: obs.InputTypeApplication, Application: &obs.Application{}},
+				{Name: "my-receiver", Type: obs.InputTypeReceiver, Receiver: &obs.ReceiverSpec{Type: obs.ReceiverTypeHTTP}},
+			}
+			clf.Spec.Collector = &obs.CollectorSpec{}
+		})
+		context = internalcontext.ForwarderContext{
+			Forwarder: clf,
+		}
+	})
+
+	It("should pass validation when the node selectors require a different value of a common label", func() {
+		clf.Spec.Collector.NodeProfiles = []obs.CollectorNodeProfile{
+			{Name: "gpu", NodeSelector: map[string]string{"pool": "gpu", "zone": "a"}, InputRefs: []string{"my-app"}},
+			{Name: "infra", NodeSelector: map[string]string{"pool": "infra"}},
+		}
+		validateNodeProfiles(context)
+		Expect(clf.Status.Conditions).To(BeEmpty())
+	})
+
+	It("should fail validation when the node selectors overlap", func() {
+		clf.Spec.Collector.NodeProfiles = []obs.CollectorNodeProfile{
+			{Name: "gpu", NodeSelector: map[string]string{"node-role.kubernetes.io/gpu": ""}},
+			{Name: "infra", NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""}},
+		}
+		validateNodeProfiles(context)
+		Expect(clf.Status.Conditions).To(HaveCondition(obs.ConditionTypeValidNodeProfiles, false, obs.ReasonValidationFailure, `node profiles "gpu" and "infra" select the same nodes.*`))
+	})
+
+	DescribeTable("should fail validation of the inputs and the name of a profile", func(profile obs.CollectorNodeProfile, message string) {
+		profile.NodeSelector = map[string]string{"pool": "gpu"}
+		clf.Spec.Collector.NodeProfiles = []obs.CollectorNodeProfile{profile}
+		validateNodeProfiles(context)
+		Expect(clf.Status.Conditions).To(HaveCondition(obs.ConditionTypeValidNodeProfiles, false, obs.ReasonValidationFailure, message))
+	},
+		Entry("with an undefined input", obs.CollectorNodeProfile{Name: "gpu", InputRefs: []string{"missing"}}, `.*references undefined input "missing"`),
+		Entry("with a receiver input", obs.CollectorNodeProfile{Name: "gpu", InputRefs: []string{"my-receiver"}}, `.*references receiver input "my-receiver".*`),
+		Entry("with the name of the aggregator", obs.CollectorNodeProfile{Name: "aggregator"}, `.*is reserved for the aggregator tier`),
+		Entry("with the name of an input", obs.CollectorNodeProfile{Name: "my-app"}, `.*must not have the name of an input`),
+	)
+
+	It("should remove the condition once the profiles are valid", func() {
+		clf.Spec.Collector.NodeProfiles = []obs.CollectorNodeProfile{{Name: "gpu", NodeSelector: map[string]string{"pool": "gpu"}, InputRefs: []string{"missing"}}}
+		validateNodeProfiles(context)
+		Expect(clf.Status.Conditions).ToNot(BeEmpty())
+		clf.Spec.Collector.NodeProfiles = nil
+		validateNodeProfiles(context)
+		Expect(clf.Status.Conditions).To(BeEmpty())
+	})
+})
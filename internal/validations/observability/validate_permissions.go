@@ -25,6 +25,8 @@ import (
 const (
 	//allNamespaces is used for determining cluster scoped bindings
 	allNamespaces = ""
+	//eventsAPIGroup is the API group of the events collected by an events input
+	eventsAPIGroup = "events.k8s.io"
 )
 
 var infraNamespaces = regexp.MustCompile(`^default$|^openshift.*$|^kube.*$`)
@@ -53,11 +55,24 @@ func ValidatePermissions(context internalcontext.ForwarderContext) {
 			internalobs.NewCondition(obs.ConditionTypeAuthorized, obs.ConditionFalse, obs.ReasonClusterRoleMissing, err.Error()))
 		return
 	}
+	if hasPipelineEventsInputs(*clf) {
+		if err = validateEventsPermissions(k8sClient, *serviceAccount); err != nil {
+			internalobs.SetCondition(&clf.Status.Conditions,
+				internalobs.NewCondition(obs.ConditionTypeAuthorized, obs.ConditionFalse, obs.ReasonClusterRoleMissing, err.Error()))
+			return
+		}
+		clfInputs.Insert(string(obs.InputTypeEvents))
+	}
 	internalobs.SetCondition(&clf.Status.Conditions,
 		internalobs.NewCondition(obs.ConditionTypeAuthorized, obs.ConditionTrue, obs.ReasonClusterRolesExist,
 			fmt.Sprintf("permitted to collect log types: %v", clfInputs.List())))
 }
 
+// IsInfrastructureNamespace returns true when the container logs of a namespace are infrastructure logs
+func IsInfrastructureNamespace(namespace string) bool {
+	return infraNamespaces.MatchString(namespace)
+}
+
 func getServiceAccount(name, namespace string, k8sClient client.Client) (*corev1.ServiceAccount, error) {
 	key := types.NamespacedName{Name: name, Namespace: namespace}
 	proto := runtime.NewServiceAccount(namespace, name)
@@ -106,10 +121,49 @@ func validateServiceAccountPermissions(k8sClient client.Client, inputs sets.Stri
 	return nil
 }
 
+// validateEventsPermissions validates a service account is permitted to list and watch the events of all namespaces
+func validateEventsPermissions(k8sClient client.Client, serviceAccount corev1.ServiceAccount) error {
+	username := fmt.Sprintf("system:serviceaccount:%s:%s", serviceAccount.Namespace, serviceAccount.Name)
+	var failedVerbs []string
+	for _, verb := range []string{"list", "watch"} {
+		sar := createSubjectAccessReview(username, allNamespaces, verb, "events", "", eventsAPIGroup)
+		log.V(3).Info("SubjectAccessReview", "obj", utilsjson.MustMarshal(sar))
+		if err := k8sClient.Create(context.TODO(), sar); err != nil {
+			return err
+		}
+		if !sar.Status.Allowed {
+			failedVerbs = append(failedVerbs, verb)
+		}
+	}
+	if len(failedVerbs) > 0 {
+		return errors.NewValidationError("insufficient permissions on service account, not authorized to %s events", strings.Join(failedVerbs, " and "))
+	}
+	return nil
+}
+
+// hasPipelineEventsInputs returns true if any events input is referenced by a pipeline
+func hasPipelineEventsInputs(clf obs.ClusterLogForwarder) bool {
+	inputRefs := sets.NewString()
+	for _, pipeline := range clf.Spec.Pipelines {
+		inputRefs.Insert(pipeline.InputRefs...)
+	}
+	for _, input := range clf.Spec.Inputs {
+		if input.Type == obs.InputTypeEvents && inputRefs.Has(input.Name) {
+			return true
+		}
+	}
+	return false
+}
+
 func gatherPipelineInputs(clf obs.ClusterLogForwarder) (sets.String, bool) {
 	inputRefs := sets.NewString()
 	inputTypes := sets.NewString()
 
+	// The container logs of the namespaces of the tenants are collected as application logs
+	if clf.Spec.Tenancy != nil {
+		inputTypes.Insert(string(obs.InputTypeApplication))
+	}
+
 	// Collect inputs from clf pipelines
 	for _, pipeline := range clf.Spec.Pipelines {
 		for _, input := range pipeline.InputRefs {
@@ -144,6 +198,12 @@ func gatherPipelineInputs(clf obs.ClusterLogForwarder) (sets.String, bool) {
 				if input.Receiver.Type == obs.ReceiverTypeSyslog {
 					inputTypes.Insert(string(obs.InputTypeInfrastructure))
 				}
+			case obs.InputTypeKafka:
+				// Kafka inputs consume logs over the network like receivers and do not require access to node logs
+				noOfReceivers += 1
+			case obs.InputTypeEvents:
+				// Events inputs do not require access to node logs but to the events of the API server
+				noOfReceivers += 1
 			}
 		}
 	}
@@ -197,6 +197,26 @@ var _ = Describe("[internal][validations] validate clusterlogforwarder permissio
 			expectValidateToSucceed(false, "")
 		})
 
+		It("should require permission to collect application logs to serve the LogForwarders of tenants", func() {
+			customClf.Spec = obs.ClusterLogForwarderSpec{
+				ServiceAccount: obs.ServiceAccount{
+					Name: clfServiceAccount.Name,
+				},
+				Tenancy: &obs.TenancySpec{},
+				Pipelines: []obs.PipelineSpec{
+					{
+						Name:      "pipeline1",
+						InputRefs: []string{string(obs.InputTypeAudit)},
+					},
+				},
+			}
+			k8sClient = &mockAuditSARClient{fake.NewFakeClient(clfServiceAccount)}
+			expectValidateToSucceed(false, `.*not authorized to collect \["application"\] logs`)
+			k8sClient = &mockSARClient{fake.NewFakeClient(clfServiceAccount)}
+			customClf.Spec.Pipelines[0].InputRefs = []string{string(obs.InputTypeInfrastructure)}
+			expectValidateToSucceed(true, `.*\[application infrastructure\]`)
+		})
+
 		It("should pass validation if service account can collect audit logs and there is an HTTP receiver", func() {
 			k8sAuditClient := &mockAuditSARClient{
 				fake.NewFakeClient(clfServiceAccount),
@@ -267,6 +287,94 @@ var _ = Describe("[internal][validations] validate clusterlogforwarder permissio
 			expectValidateToSucceed(true, "")
 		})
 
+		It("should pass validation without permission to collect node logs when there is only a kafka input", func() {
+			k8sAuditClient := &mockAuditSARClient{
+				fake.NewFakeClient(clfServiceAccount),
+			}
+
+			const kafkaInputName = `kafka-input`
+			customClf.Spec = obs.ClusterLogForwarderSpec{
+				ServiceAccount: obs.ServiceAccount{
+					Name: clfServiceAccount.Name,
+				},
+				Inputs: []obs.InputSpec{
+					{
+						Name: kafkaInputName,
+						Type: obs.InputTypeKafka,
+						Kafka: &obs.KafkaInput{
+							Brokers: []obs.BrokerURL{"tcp://broker:9092"},
+							Topics:  []string{"app-logs"},
+						},
+					},
+				},
+
+				Pipelines: []obs.PipelineSpec{
+					{
+						Name: "pipeline1",
+						InputRefs: []string{
+							kafkaInputName,
+						},
+					},
+				},
+			}
+			ValidatePermissions(internalcontext.ForwarderContext{
+				Client:    k8sAuditClient,
+				Reader:    k8sAuditClient,
+				Forwarder: &customClf,
+			})
+			Expect(customClf.Status.Conditions).To(HaveCondition(obs.ConditionTypeAuthorized, true, obs.ReasonClusterRolesExist, ""))
+		})
+
+		Context("when evaluating an events input", func() {
+			const eventsInputName = `cluster-events`
+			BeforeEach(func() {
+				customClf.Spec = obs.ClusterLogForwarderSpec{
+					ServiceAccount: obs.ServiceAccount{
+						Name: clfServiceAccount.Name,
+					},
+					Inputs: []obs.InputSpec{
+						{
+							Name:   eventsInputName,
+							Type:   obs.InputTypeEvents,
+							Events: &obs.EventsInput{},
+						},
+					},
+					Pipelines: []obs.PipelineSpec{
+						{
+							Name: "pipeline1",
+							InputRefs: []string{
+								eventsInputName,
+							},
+						},
+					},
+				}
+			})
+
+			It("should pass validation if service account can list and watch events", func() {
+				k8sEventsClient := &mockEventsSARClient{
+					fake.NewFakeClient(clfServiceAccount),
+				}
+				ValidatePermissions(internalcontext.ForwarderContext{
+					Client:    k8sEventsClient,
+					Reader:    k8sEventsClient,
+					Forwarder: &customClf,
+				})
+				Expect(customClf.Status.Conditions).To(HaveCondition(obs.ConditionTypeAuthorized, true, obs.ReasonClusterRolesExist, "events"))
+			})
+
+			It("should fail validation if service account cannot list and watch events", func() {
+				k8sAuditClient := &mockAuditSARClient{
+					fake.NewFakeClient(clfServiceAccount),
+				}
+				ValidatePermissions(internalcontext.ForwarderContext{
+					Client:    k8sAuditClient,
+					Reader:    k8sAuditClient,
+					Forwarder: &customClf,
+				})
+				Expect(customClf.Status.Conditions).To(HaveCondition(obs.ConditionTypeAuthorized, false, obs.ReasonClusterRoleMissing, "not authorized to list and watch events"))
+			})
+		})
+
 		Context("when evaluating custom application inputs that spec infrastructure namespaces", func() {
 			const appWithInfraNSInputName = "appWithInfra"
 			var k8sAppClient client.Client
@@ -520,3 +628,19 @@ func (c *mockAppSARClient) Create(ctx context.Context, obj client.Object, opts .
 	}
 	return nil
 }
+
+type mockEventsSARClient struct {
+	client.Client
+}
+
+func (c *mockEventsSARClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	sar, ok := obj.(*authorizationapi.SubjectAccessReview)
+	if !ok {
+		return fmt.Errorf("unexpected object type: %T", obj)
+	}
+	attributes := sar.Spec.ResourceAttributes
+	if attributes.Resource == "events" && attributes.Group == "events.k8s.io" {
+		sar.Status.Allowed = true
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package observability
+
+import (
+	"fmt"
+	"strings"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalcontext "github.com/openshift/cluster-logging-operator/internal/api/context"
+	internalinit "github.com/openshift/cluster-logging-operator/internal/api/initialize"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"github.com/openshift/cluster-logging-operator/internal/validations/observability/filters"
+	"github.com/openshift/cluster-logging-operator/internal/validations/observability/inputs"
+	"github.com/openshift/cluster-logging-operator/internal/validations/observability/outputs"
+	"github.com/openshift/cluster-logging-operator/internal/validations/observability/pipelines"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// tenantValidators are the validators of the LogForwarder of a tenant
+var tenantValidators = []func(internalcontext.ForwarderContext){
+	inputs.Validate,
+	outputs.Validate,
+	filters.Validate,
+	pipelines.Validate,
+	validateTenantOutputs,
+}
+
+// ValidateLogForwarder validates the LogForwarder of a tenant with the secrets of its namespace and returns the failed
+// conditions
+func ValidateLogForwarder(tenant obs.LogForwarder, secrets map[string]*corev1.Secret) (failures []metav1.Condition) {
+	if secrets == nil {
+		// the secrets are always validated since they are only resolved in the namespace of the tenant
+		secrets = map[string]*corev1.Secret{}
+	}
+	context := internalcontext.ForwarderContext{
+		Secrets:           secrets,
+		AdditionalContext: utils.Options{},
+	}
+	forwarder := internalinit.ClusterLogForwarder(internalobs.TenantForwarder(tenant), context.AdditionalContext)
+	context.Forwarder = &forwarder
+	for _, validate := range tenantValidators {
+		validate(context)
+	}
+	return failedConditions(context.Forwarder.Status)
+}
+
+// validateTenantOutputs validates the outputs of a tenant do not reference configmaps or the token of the service
+// account of the collector which are not resources of the tenant
+func validateTenantOutputs(context internalcontext.ForwarderContext) {
+	for _, out := range context.Forwarder.Spec.Outputs {
+		var messages []string
+		if names := internalobs.Outputs([]obs.OutputSpec{out}).ConfigmapNames(); len(names) > 0 {
+			messages = append(messages, fmt.Sprintf("configmaps can not be referenced by the outputs of a LogForwarder: %v", names))
+		}
+		if internalobs.Outputs([]obs.OutputSpec{out}).NeedServiceAccountToken() {
+			messages = append(messages, "the token of the service account of the collector can not be used by the outputs of a LogForwarder")
+		}
+		if len(messages) == 0 {
+			continue
+		}
+		conditionType := obs.ConditionTypeValidOutputPrefix + "-" + out.Name
+		if existing := meta.FindStatusCondition(context.Forwarder.Status.OutputConditions, conditionType); existing != nil && existing.Status == obs.ConditionFalse {
+			messages = append([]string{existing.Message}, messages...)
+		}
+		internalobs.SetCondition(&context.Forwarder.Status.OutputConditions,
+			internalobs.NewConditionFromPrefix(obs.ConditionTypeValidOutputPrefix, out.Name, false, obs.ReasonValidationFailure, strings.Join(messages, ",")))
+	}
+}
@@ -0,0 +1,73 @@
+package observability
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("[internal][validations] validate logforwarders", func() {
+	var (
+		tenant  obs.LogForwarder
+		secrets map[string]*corev1.Secret
+	)
+
+	BeforeEach(func() {
+		tenant = obs.LogForwarder{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team-a", Name: "logs"},
+			Spec: obs.LogForwarderSpec{
+				Outputs: []obs.OutputSpec{
+					{
+						Name: "my-splunk",
+						Type: obs.OutputTypeSplunk,
+						Splunk: &obs.Splunk{
+							URLSpec:        obs.URLSpec{URL: "https://splunk.team-a.example.com"},
+							Authentication: &obs.SplunkAuthentication{Token: &obs.SecretReference{SecretName: "hec", Key: "token"}},
+						},
+					},
+				},
+				Pipelines: []obs.LogForwarderPipelineSpec{{Name: "to-splunk", OutputRefs: []string{"my-splunk"}}},
+			},
+		}
+		secrets = map[string]*corev1.Secret{
+			"hec": runtime.NewSecret("team-a", "hec", map[string][]byte{"token": []byte("abc")}),
+		}
+	})
+
+	It("should pass validation when the secrets exist in the namespace of the tenant", func() {
+		Expect(ValidateLogForwarder(tenant, secrets)).To(BeEmpty())
+	})
+
+	It("should fail validation when a secret does not exist in the namespace of the tenant", func() {
+		failures := ValidateLogForwarder(tenant, nil)
+		Expect(failures).To(HaveLen(1))
+		Expect(failures[0].Type).To(Equal(obs.ConditionTypeValidOutputPrefix + "-my-splunk"))
+	})
+
+	It("should fail validation when an output uses the token of the service account of the collector", func() {
+		tenant.Spec.Outputs = append(tenant.Spec.Outputs, obs.OutputSpec{
+			Name: "my-loki",
+			Type: obs.OutputTypeLoki,
+			Loki: &obs.Loki{
+				URLSpec:        obs.URLSpec{URL: "https://loki.team-a.example.com"},
+				Authentication: &obs.HTTPAuthentication{Token: &obs.BearerToken{From: obs.BearerTokenFromServiceAccount}},
+			},
+		})
+		tenant.Spec.Pipelines[0].OutputRefs = append(tenant.Spec.Pipelines[0].OutputRefs, "my-loki")
+		failures := ValidateLogForwarder(tenant, secrets)
+		Expect(failures).To(HaveLen(1))
+		Expect(failures[0].Message).To(ContainSubstring("the token of the service account of the collector can not be used"))
+	})
+
+	It("should fail validation when an output references a configmap", func() {
+		tenant.Spec.Outputs[0].TLS = &obs.OutputTLSSpec{
+			TLSSpec: obs.TLSSpec{CA: &obs.ValueReference{ConfigMapName: "ca-bundle", Key: "ca.crt"}},
+		}
+		failures := ValidateLogForwarder(tenant, secrets)
+		Expect(failures).To(HaveLen(1))
+		Expect(failures[0].Message).To(ContainSubstring("configmaps can not be referenced by the outputs of a LogForwarder"))
+	})
+})
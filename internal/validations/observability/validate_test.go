@@ -5,6 +5,7 @@ import (
 	. "github.com/onsi/gomega"
 	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
 	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -33,4 +34,64 @@ var _ = Describe("validations", func() {
 
 	})
 
+	Context("#ValidateClusterLogForwarderSpec", func() {
+
+		var (
+			forwarder obs.ClusterLogForwarder
+		)
+		BeforeEach(func() {
+			forwarder = obs.ClusterLogForwarder{
+				Spec: obs.ClusterLogForwarderSpec{
+					ServiceAccount: obs.ServiceAccount{Name: "mine"},
+					Outputs: []obs.OutputSpec{
+						{
+							Name: "my-output",
+							Type: obs.OutputTypeHTTP,
+							HTTP: &obs.HTTP{
+								URLSpec: obs.URLSpec{URL: "https://my.host"},
+								Authentication: &obs.HTTPAuthentication{
+									Token: &obs.BearerToken{
+										From: obs.BearerTokenFromSecret,
+										Secret: &obs.BearerTokenSecretKey{
+											Name: "not-found",
+											Key:  "token",
+										},
+									},
+								},
+							},
+						},
+					},
+					Pipelines: []obs.PipelineSpec{
+						{
+							Name:       "my-pipeline",
+							InputRefs:  []string{string(obs.InputTypeApplication)},
+							OutputRefs: []string{"my-output"},
+						},
+					},
+				},
+			}
+		})
+
+		It("should pass a valid spec without evaluating the referenced secrets", func() {
+			Expect(ValidateClusterLogForwarderSpec(forwarder)).To(BeEmpty())
+		})
+
+		It("should not modify the forwarder", func() {
+			forwarder.Spec.Pipelines[0].OutputRefs = []string{"missing"}
+			ValidateClusterLogForwarderSpec(forwarder)
+			Expect(forwarder.Status.PipelineConditions).To(BeEmpty())
+			Expect(forwarder.Spec.Inputs).To(BeEmpty())
+		})
+
+		It("should return the failed conditions with the same messages as the status", func() {
+			forwarder.Annotations = map[string]string{constants.AnnotationVectorLogLevel: "loud"}
+			forwarder.Spec.Pipelines[0].OutputRefs = []string{"missing"}
+			failures := ValidateClusterLogForwarderSpec(forwarder)
+			Expect(failures).To(HaveLen(3))
+			Expect(failures[0].Type).To(Equal(obs.ConditionTypeLogLevel))
+			Expect(failures[1].Type).To(Equal(obs.ConditionTypeValidOutputPrefix + "-my-output"))
+			Expect(failures[1].Message).To(Equal("not referenced by any pipeline"))
+			Expect(failures[2].Type).To(Equal(obs.ConditionTypeValidPipelinePrefix + "-my-pipeline"))
+		})
+	})
 })
@@ -0,0 +1,73 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	log "github.com/ViaQ/logerr/v2/log/static"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	validations "github.com/openshift/cluster-logging-operator/internal/validations/observability"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/set"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// ClusterLogForwarderValidator rejects a ClusterLogForwarder upon admission when its spec fails the validations which
+// do not depend upon the service account or the secrets and configmaps it references
+type ClusterLogForwarderValidator struct{}
+
+// SetupWithManager registers the validating webhook for ClusterLogForwarders with the manager
+func (v *ClusterLogForwarderValidator) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &obs.ClusterLogForwarder{}).
+		WithValidator(v).
+		WithValidatorCustomPath(ClusterLogForwarderWebhookPath).
+		Complete()
+}
+
+func (v *ClusterLogForwarderValidator) ValidateCreate(_ context.Context, forwarder *obs.ClusterLogForwarder) (admission.Warnings, error) {
+	return nil, reject(forwarder, validations.ValidateClusterLogForwarderSpec(*forwarder))
+}
+
+// ValidateUpdate only rejects the failures the previous forwarder did not already have so forwarders admitted before
+// the webhook or before a validation was added can still be updated, e.g. by the removal of their finalizers
+func (v *ClusterLogForwarderValidator) ValidateUpdate(_ context.Context, old, forwarder *obs.ClusterLogForwarder) (admission.Warnings, error) {
+	// Allow the removal of finalizers from a forwarder that is being deleted
+	if forwarder.DeletionTimestamp != nil {
+		return nil, nil
+	}
+	existing := set.New[string]()
+	for _, condition := range validations.ValidateClusterLogForwarderSpec(*old) {
+		existing.Insert(failureMessage(condition))
+	}
+	var failures []metav1.Condition
+	for _, condition := range validations.ValidateClusterLogForwarderSpec(*forwarder) {
+		if !existing.Has(failureMessage(condition)) {
+			failures = append(failures, condition)
+		}
+	}
+	return nil, reject(forwarder, failures)
+}
+
+func (v *ClusterLogForwarderValidator) ValidateDelete(_ context.Context, _ *obs.ClusterLogForwarder) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// reject returns an error with the messages of the failed conditions that would be set on the status of an invalid
+// forwarder
+func reject(forwarder *obs.ClusterLogForwarder, failures []metav1.Condition) error {
+	if len(failures) == 0 {
+		return nil
+	}
+	messages := make([]string, 0, len(failures))
+	for _, condition := range failures {
+		messages = append(messages, failureMessage(condition))
+	}
+	log.V(3).Info("rejecting ClusterLogForwarder", "namespace", forwarder.Namespace, "name", forwarder.Name, "failures", messages)
+	return fmt.Errorf("invalid ClusterLogForwarder: %s", strings.Join(messages, "; "))
+}
+
+func failureMessage(condition metav1.Condition) string {
+	return fmt.Sprintf("%s: %s", condition.Type, condition.Message)
+}
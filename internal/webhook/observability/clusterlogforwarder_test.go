@@ -0,0 +1,86 @@
+package observability
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	obsruntime "github.com/openshift/cluster-logging-operator/internal/runtime/observability"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("[internal][webhook][observability] ClusterLogForwarderValidator", func() {
+
+	var (
+		validator *ClusterLogForwarderValidator
+		forwarder *obs.ClusterLogForwarder
+	)
+	BeforeEach(func() {
+		validator = &ClusterLogForwarderValidator{}
+		forwarder = obsruntime.NewClusterLogForwarder(constants.OpenshiftNS, "my-forwarder", runtime.Initialize)
+		forwarder.Spec.ServiceAccount.Name = "my-sa"
+		forwarder.Spec.Outputs = []obs.OutputSpec{
+			{
+				Name: "my-output",
+				Type: obs.OutputTypeHTTP,
+				HTTP: &obs.HTTP{URLSpec: obs.URLSpec{URL: "http://my.host"}},
+			},
+		}
+		forwarder.Spec.Pipelines = []obs.PipelineSpec{
+			{
+				Name:       "my-pipeline",
+				InputRefs:  []string{string(obs.InputTypeApplication)},
+				OutputRefs: []string{"my-output"},
+			},
+		}
+	})
+
+	It("should admit a valid forwarder", func() {
+		_, err := validator.ValidateCreate(context.TODO(), forwarder)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should reject an invalid forwarder with the messages of the status conditions", func() {
+		old := forwarder.DeepCopy()
+		forwarder.Spec.Pipelines[0].OutputRefs = []string{"missing"}
+		_, err := validator.ValidateUpdate(context.TODO(), old, forwarder)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(obs.ConditionTypeValidOutputPrefix + "-my-output: not referenced by any pipeline"))
+		Expect(err.Error()).To(ContainSubstring(obs.ConditionTypeValidPipelinePrefix + "-my-pipeline: "))
+	})
+
+	It("should admit the update of a forwarder which does not add failures to the previous forwarder", func() {
+		forwarder.Spec.Pipelines[0].OutputRefs = []string{"missing"}
+		old := forwarder.DeepCopy()
+		forwarder.Finalizers = nil
+		forwarder.Labels = map[string]string{"team": "a"}
+		_, err := validator.ValidateUpdate(context.TODO(), old, forwarder)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should only reject the failures an update adds to the previous forwarder", func() {
+		forwarder.Spec.Pipelines[0].OutputRefs = []string{"missing"}
+		old := forwarder.DeepCopy()
+		forwarder.Spec.Pipelines[0].InputRefs = []string{"unknown"}
+		_, err := validator.ValidateUpdate(context.TODO(), old, forwarder)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).ToNot(ContainSubstring(obs.ConditionTypeValidOutputPrefix + "-my-output"))
+		Expect(err.Error()).To(ContainSubstring(obs.ConditionTypeValidPipelinePrefix + "-my-pipeline: "))
+	})
+
+	It("should admit the update of an invalid forwarder that is being deleted", func() {
+		forwarder.Spec.Pipelines[0].OutputRefs = []string{"missing"}
+		forwarder.DeletionTimestamp = &metav1.Time{}
+		_, err := validator.ValidateUpdate(context.TODO(), forwarder.DeepCopy(), forwarder)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should admit deleting an invalid forwarder", func() {
+		forwarder.Spec.Pipelines = nil
+		_, err := validator.ValidateDelete(context.TODO(), forwarder)
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
@@ -0,0 +1,126 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/reconcile"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ConfigurationName is the name of the ValidatingWebhookConfiguration managed by the operator
+	ConfigurationName = "cluster-logging-operator"
+
+	// ServiceName is the name of the service of the webhook server whose certificate is generated by the service CA
+	ServiceName = "cluster-logging-operator-webhook"
+
+	// ServicePort is the port of the service of the webhook server
+	ServicePort = int32(443)
+
+	// ClusterLogForwarderWebhookName is the name of the webhook which validates ClusterLogForwarders
+	ClusterLogForwarderWebhookName = "vclusterlogforwarder.observability.openshift.io"
+
+	// ClusterLogForwarderWebhookPath is the path of the webhook which validates ClusterLogForwarders
+	ClusterLogForwarderWebhookPath = "/validate-observability-openshift-io-v1-clusterlogforwarder"
+)
+
+// ParseFailurePolicy returns the failure policy of the webhook. 'Ignore' fails open and admits forwarders when the
+// webhook is unavailable, 'Fail' fails closed and rejects them
+func ParseFailurePolicy(value string) (admissionregistrationv1.FailurePolicyType, error) {
+	switch policy := admissionregistrationv1.FailurePolicyType(value); policy {
+	case admissionregistrationv1.Ignore, admissionregistrationv1.Fail:
+		return policy, nil
+	}
+	return "", fmt.Errorf("webhook failure policy %q must be one of [%s, %s]", value, admissionregistrationv1.Ignore, admissionregistrationv1.Fail)
+}
+
+// NewValidatingWebhookConfiguration returns the configuration which sends ClusterLogForwarders of the watched
+// namespaces to the webhook server of the operator. An empty list of namespaces watches all namespaces
+func NewValidatingWebhookConfiguration(namespace string, failurePolicy admissionregistrationv1.FailurePolicyType, watchNamespaces []string) *admissionregistrationv1.ValidatingWebhookConfiguration {
+	sideEffects := admissionregistrationv1.SideEffectClassNone
+	matchPolicy := admissionregistrationv1.Equivalent
+	webhook := admissionregistrationv1.ValidatingWebhook{
+		Name: ClusterLogForwarderWebhookName,
+		ClientConfig: admissionregistrationv1.WebhookClientConfig{
+			Service: &admissionregistrationv1.ServiceReference{
+				Namespace: namespace,
+				Name:      ServiceName,
+				Path:      utils.GetPtr(ClusterLogForwarderWebhookPath),
+				Port:      utils.GetPtr(ServicePort),
+			},
+		},
+		Rules: []admissionregistrationv1.RuleWithOperations{
+			{
+				Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   []string{obs.GroupVersion.Group},
+					APIVersions: []string{obs.GroupVersion.Version},
+					Resources:   []string{"clusterlogforwarders"},
+					Scope:       utils.GetPtr(admissionregistrationv1.NamespacedScope),
+				},
+			},
+		},
+		FailurePolicy:           &failurePolicy,
+		MatchPolicy:             &matchPolicy,
+		SideEffects:             &sideEffects,
+		AdmissionReviewVersions: []string{"v1"},
+		TimeoutSeconds:          utils.GetPtr(int32(10)),
+	}
+	if namespaces := nonEmpty(watchNamespaces); len(namespaces) > 0 {
+		webhook.NamespaceSelector = &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{
+					Key:      "kubernetes.io/metadata.name",
+					Operator: metav1.LabelSelectorOpIn,
+					Values:   namespaces,
+				},
+			},
+		}
+	}
+	return &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: ConfigurationName,
+			Labels: map[string]string{
+				constants.LabelK8sManagedBy: constants.ClusterLoggingOperator,
+			},
+			Annotations: map[string]string{
+				constants.AnnotationInjectCABundle: "true",
+			},
+		},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{webhook},
+	}
+}
+
+// ReconcileValidatingWebhookConfiguration reconciles the configuration of the webhook which validates ClusterLogForwarders
+func ReconcileValidatingWebhookConfiguration(k8Client client.Client, namespace string, failurePolicy admissionregistrationv1.FailurePolicyType, watchNamespaces []string) error {
+	return reconcile.ValidatingWebhookConfiguration(k8Client, NewValidatingWebhookConfiguration(namespace, failurePolicy, watchNamespaces))
+}
+
+// RemoveValidatingWebhookConfiguration removes the configuration of the webhook when the webhook server is disabled
+func RemoveValidatingWebhookConfiguration(k8Client client.Client) error {
+	configuration := &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: ConfigurationName,
+		},
+	}
+	if err := k8Client.Delete(context.TODO(), configuration); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failure deleting validatingwebhookconfiguration %s: %v", ConfigurationName, err)
+	}
+	return nil
+}
+
+func nonEmpty(values []string) (result []string) {
+	for _, value := range values {
+		if value != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,70 @@
+package observability
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("[internal][webhook][observability] ValidatingWebhookConfiguration", func() {
+
+	Context("#ParseFailurePolicy", func() {
+		It("should accept the policies to fail open and closed", func() {
+			Expect(ParseFailurePolicy("Ignore")).To(Equal(admissionregistrationv1.Ignore))
+			Expect(ParseFailurePolicy("Fail")).To(Equal(admissionregistrationv1.Fail))
+		})
+		It("should reject an unknown policy", func() {
+			_, err := ParseFailurePolicy("Maybe")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("#NewValidatingWebhookConfiguration", func() {
+		It("should request the injection of the service CA and reference the webhook service", func() {
+			configuration := NewValidatingWebhookConfiguration(constants.OpenshiftNS, admissionregistrationv1.Fail, []string{""})
+			Expect(configuration.Annotations).To(HaveKeyWithValue(constants.AnnotationInjectCABundle, "true"))
+			Expect(configuration.Webhooks).To(HaveLen(1))
+			webhook := configuration.Webhooks[0]
+			Expect(*webhook.FailurePolicy).To(Equal(admissionregistrationv1.Fail))
+			Expect(webhook.ClientConfig.Service.Namespace).To(Equal(constants.OpenshiftNS))
+			Expect(webhook.ClientConfig.Service.Name).To(Equal(ServiceName))
+			Expect(*webhook.ClientConfig.Service.Path).To(Equal(ClusterLogForwarderWebhookPath))
+			Expect(webhook.NamespaceSelector).To(BeNil(), "expected all namespaces to be selected when watching all namespaces")
+		})
+		It("should select only the watched namespaces", func() {
+			configuration := NewValidatingWebhookConfiguration(constants.OpenshiftNS, admissionregistrationv1.Ignore, []string{"a", "b"})
+			Expect(configuration.Webhooks[0].NamespaceSelector.MatchExpressions[0].Values).To(Equal([]string{"a", "b"}))
+		})
+	})
+
+	Context("#ReconcileValidatingWebhookConfiguration", func() {
+		It("should update the failure policy and retain the injected CA bundle", func() {
+			existing := NewValidatingWebhookConfiguration(constants.OpenshiftNS, admissionregistrationv1.Ignore, nil)
+			existing.Webhooks[0].ClientConfig.CABundle = []byte("injected")
+			k8sClient := fake.NewFakeClient(existing)
+
+			Expect(ReconcileValidatingWebhookConfiguration(k8sClient, constants.OpenshiftNS, admissionregistrationv1.Fail, nil)).To(Succeed())
+
+			actual := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+			Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Name: ConfigurationName}, actual)).To(Succeed())
+			Expect(*actual.Webhooks[0].FailurePolicy).To(Equal(admissionregistrationv1.Fail))
+			Expect(actual.Webhooks[0].ClientConfig.CABundle).To(Equal([]byte("injected")))
+		})
+	})
+
+	Context("#RemoveValidatingWebhookConfiguration", func() {
+		It("should remove the configuration and ignore one that does not exist", func() {
+			k8sClient := fake.NewFakeClient(&admissionregistrationv1.ValidatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: ConfigurationName},
+			})
+			Expect(RemoveValidatingWebhookConfiguration(k8sClient)).To(Succeed())
+			Expect(RemoveValidatingWebhookConfiguration(k8sClient)).To(Succeed())
+		})
+	})
+})
@@ -24,14 +24,13 @@ var _ = Describe("Test collector deployment type", func() {
 		receiverName = "http-audit"
 	)
 	var (
-		err                  error
-		forwarder            *obs.ClusterLogForwarder
-		forwarderName        = "my-forwarder"
-		deploymentAnnotation = map[string]string{constants.AnnotationEnableCollectorAsDeployment: "true"}
-		fluentDeployment     *apps.Deployment
-		deployNS             string
-		e2e                  = framework.NewE2ETestFramework()
-		serviceAccount       *corev1.ServiceAccount
+		err              error
+		forwarder        *obs.ClusterLogForwarder
+		forwarderName    = "my-forwarder"
+		fluentDeployment *apps.Deployment
+		deployNS         string
+		e2e              = framework.NewE2ETestFramework()
+		serviceAccount   *corev1.ServiceAccount
 	)
 
 	Describe("with vector collector", func() {
@@ -55,7 +54,6 @@ var _ = Describe("Test collector deployment type", func() {
 
 			forwarder = obsruntime.NewClusterLogForwarder(deployNS, forwarderName, runtime.Initialize, func(clf *obs.ClusterLogForwarder) {
 				clf.Spec.ServiceAccount.Name = serviceAccount.Name
-				clf.Annotations = deploymentAnnotation
 				clf.Spec.Inputs = []obs.InputSpec{
 					{
 						Name: receiverName,
@@ -100,7 +98,7 @@ var _ = Describe("Test collector deployment type", func() {
 			})
 		})
 
-		It("should be a deployment when annotated and http receiver is the only input", func() {
+		It("should be a deployment when http receiver is the only input", func() {
 			Expect(e2e.CreateObservabilityClusterLogForwarder(forwarder)).To(Succeed(), "Exp. to create instance of ClusterLogForwarder")
 			Expect(e2e.WaitForDeployment(forwarder.Namespace, forwarder.Name, 5*time.Second, 3*time.Minute)).To(Succeed(), "Exp. the collector to deploy as a deployment")
 
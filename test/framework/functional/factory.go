@@ -25,6 +25,19 @@ func NewAuditHostLog(eventTime time.Time) string {
 	now := fmt.Sprintf("%.3f", float64(eventTime.UnixNano())/float64(time.Second))
 	return fmt.Sprintf(`type=DAEMON_START msg=audit(%s:2914): op=start ver=3.0 format=enriched kernel=4.18.0-240.15.1.el8_3.x86_64 auid=4294967295 pid=1396 uid=0 ses=4294967295 subj=system_u:system_r:auditd_t:s0 res=successAUID="unset" UID="root" msg="PAM:authentication"`, now)
 }
+
+// NewAuditHostSyscallLog returns the records of an enriched host audit event of an execve syscall
+func NewAuditHostSyscallLog(eventTime time.Time) string {
+	now := fmt.Sprintf("%.3f", float64(eventTime.UnixNano())/float64(time.Second))
+	return strings.Join([]string{
+		fmt.Sprintf("type=SYSCALL msg=audit(%s:6174): arch=c000003e syscall=59 success=yes exit=0 pid=4215 auid=1000 uid=0 comm=\"cat\" exe=\"/usr/bin/cat\" key=\"exec\"\x1dARCH=x86_64 SYSCALL=execve AUID=\"alice\" UID=\"root\"", now),
+		fmt.Sprintf("type=EXECVE msg=audit(%s:6174): argc=2 a0=\"cat\" a1=2F6574632F6D792066696C65", now),
+		fmt.Sprintf("type=CWD msg=audit(%s:6174): cwd=\"/root\"", now),
+		fmt.Sprintf("type=PATH msg=audit(%s:6174): item=0 name=\"/usr/bin/cat\" inode=1442 nametype=NORMAL", now),
+		fmt.Sprintf("type=PROCTITLE msg=audit(%s:6174): proctitle=636174002F6574632F6D792066696C65", now),
+	}, "\n")
+}
+
 func NewOVNAuditLog(eventTime time.Time) string {
 	now := CRIOTime(eventTime)
 	return fmt.Sprintf(OVNLogTemplate, now)
@@ -259,6 +259,19 @@ func (f *CollectorFunctionalFramework) WriteToHttpInputWithPortForwarder(inputNa
 	return fmt.Errorf("WriteToHttpInput: no HTTP input named %s", inputName)
 }
 
+// PostToSplunkHECInput posts a request to an endpoint of the splunkHEC input on the given request channel and returns the response body
+func (f *CollectorFunctionalFramework) PostToSplunkHECInput(inputName, path, token, channel, body string) (string, error) {
+	for _, input := range f.Forwarder.Spec.Inputs {
+		if input.Receiver != nil && input.Receiver.SplunkHEC != nil && input.Name == inputName {
+			return f.RunCommand(constants.CollectorName, "curl", "-ksS", fmt.Sprintf("http://localhost:%d%s", input.Receiver.Port, path),
+				"-H", "Authorization: Splunk "+token,
+				"-H", "X-Splunk-Request-Channel: "+channel,
+				"-d", body)
+		}
+	}
+	return "", fmt.Errorf("PostToSplunkHECInput: no splunkHEC input named %s", inputName)
+}
+
 // LogWriter returns an io.WriteCloser that appends to a log file on the collector Pod.
 // Call Close() when finished to terminate the writer process.
 func (f *CollectorFunctionalFramework) LogWriter(filename string) (io.WriteCloser, error) {
@@ -239,6 +239,39 @@ var _ = Describe("Policy to VRL Filter", func() {
 		})
 	})
 
+	Context("oauth login events", func() {
+		login := func(decision string) Event {
+			return Event{
+				Verb:        "get",
+				User:        authv1.UserInfo{Username: "system:anonymous"},
+				RequestURI:  "/login?then=%2Foauth%2Fauthorize",
+				Annotations: map[string]string{"authentication.openshift.io/decision": decision},
+			}
+		}
+		It("are dropped by the default rules without oauth", func() {
+			Expect(Filtered(&obs.KubeAPIAudit{}, login("allow"))).To(HaveLevel(LevelNone))
+		})
+		It("are forwarded unless their decision is omitted", func() {
+			p := &obs.KubeAPIAudit{OAuth: &obs.OAuthAudit{OmitDecisions: []obs.OAuthLoginDecision{obs.OAuthLoginDecisionAllow}}}
+			Expect(Filtered(p, login("allow"))).To(HaveLevel(LevelNone))
+			Expect(Filtered(p, login("deny"))).To(HaveLevel(LevelRequestResponse))
+			// Other read-only system events are still dropped.
+			Expect(Filtered(p, Event{Verb: "get", User: authv1.UserInfo{Username: "system:anonymous"}})).To(HaveLevel(LevelNone))
+		})
+	})
+
+	Context("wildcard API groups", func() {
+		It("matches the API group of the event", func() {
+			p := &obs.KubeAPIAudit{Rules: []PolicyRule{
+				{Level: LevelNone, Resources: []GroupResources{{Group: "*.openshift.io"}}},
+				{Level: LevelMetadata},
+			}}
+			Expect(Filtered(p, Event{ObjectRef: &ObjectReference{APIGroup: "route.openshift.io", Resource: "routes"}})).To(HaveLevel(LevelNone))
+			Expect(Filtered(p, Event{ObjectRef: &ObjectReference{APIGroup: "apps", Resource: "deployments"}})).To(HaveLevel(LevelMetadata))
+			Expect(Filtered(p, Event{ObjectRef: &ObjectReference{Resource: "pods"}})).To(HaveLevel(LevelMetadata))
+		})
+	})
+
 	Context("omit managed fields", func() {
 		createEventWithManagedFields := func() Event {
 			reqObj := &v1.PartialObjectMetadata{
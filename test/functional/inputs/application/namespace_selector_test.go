@@ -0,0 +1,92 @@
+package application
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	"github.com/openshift/cluster-logging-operator/test/helpers/oc"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("[Functional][Inputs][Application] namespaceSelector", func() {
+
+	const (
+		labelKey   = "team"
+		labelValue = "payments"
+		timestamp  = "2021-03-31T12:59:28.573159188+00:00"
+		// allow the collector to observe the change of the namespace labels
+		labelSyncDelay = 15 * time.Second
+	)
+
+	var (
+		framework *functional.CollectorFunctionalFramework
+	)
+
+	labelNamespace := func(label string) {
+		_, err := oc.Literal().From(fmt.Sprintf("oc label namespace %s %s --overwrite", framework.Namespace, label)).Run()
+		Expect(err).To(BeNil(), "Expected no errors labeling the namespace")
+		time.Sleep(labelSyncDelay)
+	}
+
+	writeMessage := func(message string) {
+		matchers.ExpectOK(framework.WriteMessagesToApplicationLog(functional.NewCRIOLogMessage(timestamp, message, false), 1),
+			"Expected no errors writing the logs")
+	}
+
+	readRawLogs := func() string {
+		// the output has not written any log when none was collected
+		out, _ := framework.RunCommand(string(obs.OutputTypeHTTP), "cat", functional.ApplicationLogFile)
+		return out
+	}
+
+	readMessages := func() []string {
+		logs, err := framework.ReadApplicationLogsFrom(string(obs.OutputTypeHTTP))
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		messages := []string{}
+		for _, log := range logs {
+			messages = append(messages, log.Message)
+		}
+		return messages
+	}
+
+	BeforeEach(func() {
+		framework = functional.NewCollectorFunctionalFramework()
+		testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInputName("payments",
+				func(spec *obs.InputSpec) {
+					spec.Type = obs.InputTypeApplication
+					spec.Application = &obs.Application{
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{labelKey: labelValue},
+						},
+					}
+				}).
+			ToHttpOutput()
+		Expect(framework.Deploy()).To(BeNil())
+	})
+
+	AfterEach(func() {
+		framework.Cleanup()
+	})
+
+	It("should not collect namespaces without the label", func() {
+		writeMessage("without-label")
+		Consistently(readRawLogs, labelSyncDelay, 5*time.Second).ShouldNot(ContainSubstring("without-label"))
+	})
+
+	It("should start and stop collecting namespaces gaining and losing the label without a redeploy", func() {
+		labelNamespace(fmt.Sprintf("%s=%s", labelKey, labelValue))
+		writeMessage("with-label")
+		Expect(readMessages()).To(ContainElement("with-label"))
+
+		labelNamespace(labelKey + "-")
+		writeMessage("after-unlabel")
+		Consistently(readRawLogs, labelSyncDelay, 5*time.Second).ShouldNot(ContainSubstring("after-unlabel"))
+	})
+})
@@ -0,0 +1,140 @@
+package fluentforward
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	"github.com/openshift/cluster-logging-operator/test/helpers/certificate"
+	"github.com/openshift/cluster-logging-operator/test/helpers/fluentd"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	fluentForwardInputName = `fluent-source`
+	fluentForwardPort      = 24224
+	tlsSecretName          = "fluent-source-tls"
+	sharedKeySecretName    = "fluent-source-shared-key"
+	sharedKey              = "my-shared-key"
+	senderConfigMapName    = "fluent-sender"
+	tag                    = "app.my-namespace.my-app"
+	record                 = `{"log":"hello fluent","team":"edge"}`
+)
+
+var _ = Describe("[Functional][Inputs][FluentForward] Functional tests", func() {
+
+	var (
+		framework *functional.CollectorFunctionalFramework
+		ca        *certificate.CertKey
+	)
+
+	// deploy runs the collector with a fluentForward input requiring the shared key and a client certificate signed
+	// by the CA, and a sender relaying the records written with fluent-cat to the input using the given settings
+	deploy := func(sender fluentd.TLSSender) {
+		serverCert := certificate.NewCert(ca, "Server", "localhost")
+		framework.AddSecret(runtime.NewSecret(framework.Namespace, tlsSecretName, map[string][]byte{
+			constants.ClientCertKey:      serverCert.CertificatePEM(),
+			constants.ClientPrivateKey:   serverCert.PrivateKeyPEM(),
+			constants.TrustedCABundleKey: ca.CertificatePEM(),
+		}))
+		framework.AddSecret(runtime.NewSecret(framework.Namespace, sharedKeySecretName, map[string][]byte{
+			"shared_key": []byte(sharedKey),
+		}))
+		testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInputName(fluentForwardInputName,
+				func(spec *obs.InputSpec) {
+					spec.Type = obs.InputTypeReceiver
+					spec.Receiver = &obs.ReceiverSpec{
+						Port: fluentForwardPort,
+						Type: obs.ReceiverTypeFluentForward,
+						TLS: &obs.InputTLSSpec{
+							CA:          &obs.ValueReference{Key: constants.TrustedCABundleKey, SecretName: tlsSecretName},
+							Certificate: &obs.ValueReference{Key: constants.ClientCertKey, SecretName: tlsSecretName},
+							Key:         &obs.SecretReference{Key: constants.ClientPrivateKey, SecretName: tlsSecretName},
+						},
+						FluentForward: &obs.FluentForwardReceiver{
+							TagPattern: `^app\.(?P<namespace>[^.]+)\.(?P<app>[^.]+)$`,
+							SharedKey:  &obs.SecretReference{Key: "shared_key", SecretName: sharedKeySecretName},
+						},
+					}
+				}).ToHttpOutput()
+
+		sender.Port = fluentForwardPort
+		sender.CA = ca
+		Expect(framework.Test.Create(sender.ConfigMap(framework.Namespace, senderConfigMapName))).To(Succeed())
+		Expect(framework.DeployWithVisitors(append(
+			framework.AddOutputContainersVisitors(),
+			func(b *runtime.PodBuilder) error {
+				b.AddSecretVolume(tlsSecretName, tlsSecretName).
+					AddSecretVolume(sharedKeySecretName, sharedKeySecretName)
+				return fluentd.AddTLSSenderContainer(b, senderConfigMapName)
+			}))).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		framework = functional.NewCollectorFunctionalFramework()
+		ca = certificate.NewCA(nil, "Fluent CA")
+	})
+
+	AfterEach(func() {
+		framework.Cleanup()
+	})
+
+	It("should map the fluent tag of records sent with a client certificate signed by the CA and the shared key", func() {
+		deploy(fluentd.TLSSender{
+			Cert:      certificate.NewClient(ca, "Fluent Sender"),
+			SharedKey: sharedKey,
+		})
+		Expect(fluentd.WriteToTLSSender(framework, tag, record)).To(Succeed())
+
+		var lines []string
+		Expect(wait.PollUntilContextTimeout(context.TODO(), time.Second, time.Minute, true, func(context.Context) (done bool, err error) {
+			if lines, err = framework.ReadRawApplicationLogsFrom(string(obs.OutputTypeHTTP)); err != nil {
+				return true, err
+			}
+			return len(lines) >= 1, nil
+		})).To(Succeed(), "Expected no errors reading the logs and there to be at least 1")
+
+		record := map[string]interface{}{}
+		Expect(json.Unmarshal([]byte(lines[0]), &record)).To(Succeed())
+		Expect(record).To(HaveKeyWithValue("message", "hello fluent"))
+		Expect(record).To(HaveKeyWithValue("log_type", string(obs.InputTypeApplication)))
+		Expect(record).To(HaveKeyWithValue("log_source", string(obs.ReceiverTypeFluentForward)))
+		Expect(record["kubernetes"]).To(HaveKeyWithValue("namespace_name", "my-namespace"))
+		Expect(record["structured"]).To(HaveKeyWithValue("team", "edge"))
+		Expect(record["structured"]).To(HaveKeyWithValue("fluent_tag", tag))
+	})
+
+	DescribeTable("should reject records of senders", func(sender func() fluentd.TLSSender) {
+		deploy(sender())
+		Expect(fluentd.WriteToTLSSender(framework, tag, record)).To(Succeed())
+
+		Consistently(func() string {
+			logs, _ := framework.RunCommand(string(obs.OutputTypeHTTP), "cat", functional.ApplicationLogFile)
+			return logs
+		}, 20*time.Second, 2*time.Second).Should(BeEmpty(), "Exp. the input to reject the records of the sender")
+	},
+		Entry("without a client certificate", func() fluentd.TLSSender {
+			return fluentd.TLSSender{SharedKey: sharedKey}
+		}),
+		Entry("with a client certificate not signed by the CA", func() fluentd.TLSSender {
+			return fluentd.TLSSender{
+				Cert:      certificate.NewClient(certificate.NewCA(nil, "Other CA"), "Fluent Sender"),
+				SharedKey: sharedKey,
+			}
+		}),
+		Entry("with the wrong shared key", func() fluentd.TLSSender {
+			return fluentd.TLSSender{
+				Cert:      certificate.NewClient(ca, "Fluent Sender"),
+				SharedKey: "not-the-shared-key",
+			}
+		}),
+	)
+})
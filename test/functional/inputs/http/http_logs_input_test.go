@@ -0,0 +1,78 @@
+package http
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+)
+
+var _ = Describe("[Functional][Inputs][Http] Application logs", func() {
+
+	var (
+		framework *functional.CollectorFunctionalFramework
+	)
+
+	BeforeEach(func() {
+		framework = functional.NewCollectorFunctionalFramework()
+		framework.VisitConfig = func(conf string) string {
+			return strings.Replace(conf, "enabled = true", "enabled = false", 2) // turn off TLS for testing
+		}
+		testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInputName(httpInputName,
+				func(spec *obs.InputSpec) {
+					spec.Type = obs.InputTypeReceiver
+					spec.Receiver = &obs.ReceiverSpec{
+						Port: servicePortNum,
+						Type: obs.ReceiverTypeHTTP,
+						HTTP: &obs.HTTPReceiver{
+							Format: obs.HTTPReceiverFormatNDJSON,
+							Namespace: &obs.HTTPReceiverAttribution{
+								Field: ".ns",
+							},
+						},
+					}
+				}).ToHttpOutput()
+		Expect(framework.DeployWithVisitor(
+			func(b *runtime.PodBuilder) error {
+				return framework.AddVectorHttpOutput(b, framework.Forwarder.Spec.Outputs[0])
+			}),
+		).To(BeNil())
+	})
+
+	AfterEach(func() {
+		framework.Cleanup()
+	})
+
+	It("should forward each line of an ndjson payload as an application log", func() {
+		payload := strings.Join([]string{
+			`{"message":"first message","level":"info","ns":"my-namespace"}`,
+			`{"message":"second message","level":"error","ns":"my-namespace"}`,
+		}, "\n")
+		Expect(framework.WriteToHttpInputWithPortForwarder(httpInputName, []byte(payload))).To(Succeed())
+
+		raw, err := framework.ReadFileFromWithRetryInterval(string(obs.OutputTypeHTTP), functional.ApplicationLogFile, time.Second)
+		Expect(err).To(BeNil(), "Expected no errors reading the logs")
+		lines := strings.Split(strings.TrimSpace(raw), "\n")
+		Expect(lines).To(HaveLen(2), "--- raw lines:\n%v\n...", raw)
+
+		expMessages := []string{"first message", "second message"}
+		expLevels := []string{"info", "error"}
+		for i, line := range lines {
+			entry := map[string]interface{}{}
+			Expect(json.Unmarshal([]byte(line), &entry)).To(Succeed())
+			Expect(entry["message"]).To(Equal(expMessages[i]))
+			Expect(entry["level"]).To(Equal(expLevels[i]))
+			Expect(entry["log_type"]).To(Equal(string(obs.InputTypeApplication)))
+			Expect(entry["log_source"]).To(Equal(string(obs.ReceiverTypeHTTP)))
+			Expect(entry["kubernetes"]).To(HaveKeyWithValue("namespace_name", "my-namespace"))
+		}
+	})
+})
@@ -0,0 +1,111 @@
+package splunkhec
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	internalobs "github.com/openshift/cluster-logging-operator/internal/api/observability"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	"github.com/openshift/cluster-logging-operator/test/helpers/splunk"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+const (
+	splunkHECInputName = `hec-source`
+	splunkHECPort      = 9088
+	splunkSecretName   = `splunk-secret`
+	requestChannel     = `0aeeac95-ac74-4aa9-b30d-6c4c0ac581ba`
+)
+
+var _ = Describe("[Functional][Inputs][SplunkHEC] Functional tests", func() {
+
+	var (
+		framework *functional.CollectorFunctionalFramework
+		hecToken  = *internalobs.NewSecretReference(constants.SplunkHECTokenKey, splunkSecretName)
+	)
+
+	deploy := func(acknowledgements bool) {
+		testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInputName(splunkHECInputName,
+				func(spec *obs.InputSpec) {
+					spec.Type = obs.InputTypeReceiver
+					spec.Receiver = &obs.ReceiverSpec{
+						Port: splunkHECPort,
+						Type: obs.ReceiverTypeSplunkHEC,
+						SplunkHEC: &obs.SplunkHECReceiver{
+							Tokens:           []*obs.SecretReference{&hecToken},
+							Acknowledgements: acknowledgements,
+						},
+					}
+				}).ToSplunkOutput(hecToken)
+		framework.Secrets = append(framework.Secrets, runtime.NewSecret(framework.Namespace, splunkSecretName,
+			map[string][]byte{
+				constants.SplunkHECTokenKey: functional.HecToken,
+			},
+		))
+		Expect(framework.Deploy()).To(BeNil())
+		splunk.WaitOnSplunk(framework)
+	}
+
+	BeforeEach(func() {
+		framework = functional.NewCollectorFunctionalFramework()
+		framework.VisitConfig = func(conf string) string {
+			// turn off TLS for testing
+			return strings.Replace(conf, "[sources.input_hec_source.tls]\nenabled = true", "[sources.input_hec_source.tls]\nenabled = false", 1)
+		}
+	})
+
+	AfterEach(func() {
+		framework.Cleanup()
+	})
+
+	It("should preserve the index, source and sourcetype of events sent to the splunkHEC input", func() {
+		deploy(false)
+
+		event := fmt.Sprintf(`{"event":"hello hec","index":%q,"source":"my-app","sourcetype":"my:sourcetype"}`, functional.SplunkIndexName)
+		resp, err := framework.PostToSplunkHECInput(splunkHECInputName, "/services/collector/event", string(functional.HecToken), requestChannel, event)
+		Expect(err).To(BeNil())
+		Expect(resp).To(ContainSubstring(`"text":"Success"`))
+
+		logs, err := framework.ReadAppLogsByIndexFromSplunk(functional.SplunkIndexName)
+		Expect(err).To(BeNil(), "Expected no errors getting logs from splunk")
+		Expect(strings.Join(logs, "\n")).To(SatisfyAll(
+			ContainSubstring("hello hec"),
+			ContainSubstring(`"log_source":"splunkHEC"`),
+		))
+
+		sources, err := framework.ReadFieldByIndexFromSplunk(functional.SplunkIndexName, "source", "")
+		Expect(err).To(BeNil())
+		Expect(strings.Join(sources, "\n")).To(ContainSubstring("my-app"))
+
+		sourceTypes, err := framework.ReadFieldByIndexFromSplunk(functional.SplunkIndexName, "sourcetype", "")
+		Expect(err).To(BeNil())
+		Expect(strings.Join(sourceTypes, "\n")).To(ContainSubstring("my:sourcetype"))
+	})
+
+	It("should reject events with an invalid token", func() {
+		deploy(false)
+
+		resp, err := framework.PostToSplunkHECInput(splunkHECInputName, "/services/collector/event", "not-a-valid-token", requestChannel, `{"event":"hello hec"}`)
+		Expect(err).To(BeNil())
+		Expect(resp).To(ContainSubstring("Invalid authentication token"))
+	})
+
+	It("should acknowledge events delivered by the splunkHEC input", func() {
+		deploy(true)
+
+		resp, err := framework.PostToSplunkHECInput(splunkHECInputName, "/services/collector/event", string(functional.HecToken), requestChannel, `{"event":"hello ack"}`)
+		Expect(err).To(BeNil())
+		Expect(resp).To(ContainSubstring(`"ackId":0`))
+
+		Eventually(func() (string, error) {
+			return framework.PostToSplunkHECInput(splunkHECInputName, "/services/collector/ack", string(functional.HecToken), requestChannel, `{"acks":[0]}`)
+		}, 2*time.Minute, 5*time.Second).Should(ContainSubstring(`"0":true`))
+	})
+})
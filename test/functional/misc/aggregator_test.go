@@ -0,0 +1,158 @@
+package misc
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/auth"
+	"github.com/openshift/cluster-logging-operator/internal/collector/common"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/factory"
+	"github.com/openshift/cluster-logging-operator/internal/generator/vector/aggregator"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"github.com/openshift/cluster-logging-operator/internal/utils/toml"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	"github.com/openshift/cluster-logging-operator/test/helpers/certificate"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+	testruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+)
+
+var _ = Describe("[Functional][Misc] forwarding from the agent tier to the aggregator tier", func() {
+
+	const (
+		aggregatorContainer = "aggregator"
+		aggregatorLogs      = "/tmp/aggregator.log"
+		servingSecretName   = "aggregator-serving"
+		serviceCAName       = "aggregator-service-ca"
+		timestamp           = "2021-03-31T12:59:28.573159188+00:00"
+	)
+
+	var (
+		framework     *functional.CollectorFunctionalFramework
+		resourceNames *factory.ForwarderResourceNames
+		serviceCA     *certificate.CertKey
+		clientCAPEM   []byte
+		clientCertPEM []byte
+		clientKeyPEM  []byte
+	)
+
+	// deploy runs the collector as the agent tier with the aggregator sink of the generator and an aggregator tier
+	// sidecar with the aggregator source of the generator which requires a client certificate signed by the client CA
+	deploy := func(certPEM, keyPEM []byte) {
+		framework.AddSecret(runtime.NewSecret(framework.Namespace, resourceNames.AggregatorClientSecret, map[string][]byte{
+			constants.ClientCertKey:    certPEM,
+			constants.ClientPrivateKey: keyPEM,
+		}))
+		framework.VisitConfig = func(conf string) string {
+			tree := map[string]interface{}{}
+			toml.MustUnmarshal(conf, &tree)
+			sinks := tree["sinks"].(map[string]interface{})
+			var inputs []string
+			for _, id := range sinks["output_http"].(map[string]interface{})["inputs"].([]interface{}) {
+				inputs = append(inputs, id.(string))
+			}
+			sink := map[string]interface{}{}
+			toml.MustUnmarshal(toml.MustMarshal(aggregator.NewSink("localhost:6000", resourceNames.AggregatorClientSecret, utils.NoOptions, inputs...)), &sink)
+			sinks[aggregator.SinkID] = sink
+			return toml.MustMarshal(tree)
+		}
+
+		serverCert := certificate.NewCert(serviceCA, "Service CA", "localhost")
+		Expect(framework.Test.Create(runtime.NewSecret(framework.Namespace, servingSecretName, map[string][]byte{
+			constants.ClientCertKey:    serverCert.CertificatePEM(),
+			constants.ClientPrivateKey: serverCert.PrivateKeyPEM(),
+		}))).To(Succeed())
+		Expect(framework.Test.Create(runtime.NewConfigMap(framework.Namespace, serviceCAName, map[string]string{
+			constants.ServiceCABundleKey: string(serviceCA.CertificatePEM()),
+		}))).To(Succeed())
+		Expect(framework.Test.Create(runtime.NewConfigMap(framework.Namespace, resourceNames.AggregatorClientCA, map[string]string{
+			constants.TrustedCABundleKey: string(clientCAPEM),
+		}))).To(Succeed())
+
+		aggregatorConf := toml.MustMarshal(map[string]interface{}{
+			"sources": map[string]interface{}{
+				aggregator.SourceID: aggregator.NewSource(resourceNames.AggregatorClientCA, utils.NoOptions),
+			},
+			"sinks": map[string]interface{}{
+				"aggregated": map[string]interface{}{
+					"type":     "file",
+					"inputs":   []string{aggregator.SourceID},
+					"path":     aggregatorLogs,
+					"encoding": map[string]interface{}{"codec": "json"},
+				},
+			},
+		})
+		Expect(framework.Test.Create(runtime.NewConfigMap(framework.Namespace, aggregatorContainer, map[string]string{
+			"vector.toml": aggregatorConf,
+		}))).To(Succeed())
+
+		visitors := append(framework.AddOutputContainersVisitors(), func(b *runtime.PodBuilder) error {
+			b.AddSecretVolume(resourceNames.AggregatorClientSecret, resourceNames.AggregatorClientSecret).
+				AddConfigMapVolume(serviceCAName, serviceCAName).
+				GetContainer(constants.CollectorName).
+				AddVolumeMount(serviceCAName, common.ConfigMapBasePath(constants.ServiceCABundleConfigMapName), "", true).
+				Update()
+			b.AddContainer(aggregatorContainer, utils.GetComponentImage(constants.VectorName)).
+				AddVolumeMount(aggregatorContainer, "/tmp/config", "", true).
+				AddVolumeMount(servingSecretName, "/etc/collector/metrics", "", true).
+				AddVolumeMount(resourceNames.AggregatorClientCA, common.ConfigMapBasePath(resourceNames.AggregatorClientCA), "", true).
+				WithCmd([]string{"vector", "--config-toml", "/tmp/config/vector.toml"}).
+				End().
+				AddConfigMapVolume(aggregatorContainer, aggregatorContainer).
+				AddSecretVolume(servingSecretName, servingSecretName).
+				AddConfigMapVolume(resourceNames.AggregatorClientCA, resourceNames.AggregatorClientCA)
+			return nil
+		})
+		Expect(framework.DeployWithVisitors(visitors)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		framework = functional.NewCollectorFunctionalFramework()
+		testruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInput(obs.InputTypeApplication).
+			ToHttpOutput()
+		resourceNames = factory.ResourceNames(*framework.Forwarder)
+		serviceCA = certificate.NewCA(nil, "Service CA")
+		var err error
+		clientCAPEM, clientCertPEM, clientKeyPEM, err = auth.NewAggregatorClientCertificate(resourceNames.CommonName, time.Hour)
+		Expect(err).ToNot(HaveOccurred())
+	})
+	AfterEach(func() {
+		framework.Cleanup()
+	})
+
+	It("should forward logs when the agent presents its client certificate", func() {
+		deploy(clientCertPEM, clientKeyPEM)
+		msg := functional.NewCRIOLogMessage(timestamp, "from the agent tier", false)
+		matchers.ExpectOK(framework.WriteMessagesToApplicationLog(msg, 1))
+
+		logs, err := framework.ReadFileFrom(aggregatorContainer, aggregatorLogs)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(logs).To(ContainSubstring("from the agent tier"))
+	})
+
+	It("should reject an agent presenting a serving certificate of the service CA", func() {
+		servingCert := certificate.New(&x509.Certificate{
+			SerialNumber: big.NewInt(4321),
+			Subject:      pkix.Name{CommonName: "some-service"},
+			NotBefore:    time.Now(),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			DNSNames:     []string{"some-service.svc"},
+		}, serviceCA)
+		deploy(servingCert.CertificatePEM(), servingCert.PrivateKeyPEM())
+		msg := functional.NewCRIOLogMessage(timestamp, "from the agent tier", false)
+		matchers.ExpectOK(framework.WriteMessagesToApplicationLog(msg, 1))
+
+		Expect(framework.ReadApplicationLogsFrom(string(obs.OutputTypeHTTP))).To(HaveLen(1), "Exp. the agent to collect the log")
+		logs, _ := framework.RunCommand(aggregatorContainer, "cat", aggregatorLogs)
+		Expect(logs).To(BeEmpty(), "Exp. the aggregator to reject the connection of the agent")
+	})
+})
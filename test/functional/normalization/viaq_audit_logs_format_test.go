@@ -159,6 +159,30 @@ var _ = Describe("[Functional][LogForwarding][Normalization] message format test
 				Expect(log).ToNot(ContainSubstring(`"kubernetes"`), "audit logs should not have a kubernetes field")
 			}
 		})
+		It("should correlate the records of a linux audit event into a structured log", func() {
+			testTime := time.Now().Truncate(time.Millisecond)
+			auditEvent := functional.NewAuditHostSyscallLog(testTime)
+			Expect(framework.WriteMessagesToAuditLog(auditEvent, 1)).To(BeNil())
+			raw, err := framework.ReadAuditLogsFrom(string(obs.OutputTypeElasticsearch))
+			Expect(err).To(BeNil(), "Expected no errors reading the logs")
+			var logs []types.LinuxAuditLog
+			Expect(types.StrictlyParseLogs(utils.ToJsonLogs(raw), &logs)).To(Succeed())
+			Expect(logs).To(HaveLen(1), "Expected the records of the event to be correlated into a single log")
+
+			audit := logs[0].AuditLinux
+			Expect(logs[0].Message).To(Equal(auditEvent))
+			Expect(audit.Type).To(Equal("SYSCALL"))
+			Expect(audit.RecordID).To(Equal("6174"))
+			Expect(audit.RecordTypes).To(Equal([]string{"SYSCALL", "EXECVE", "CWD", "PATH", "PROCTITLE"}))
+			Expect(audit.Syscall).To(HaveKeyWithValue("name", "execve"))
+			Expect(audit.Syscall).To(HaveKeyWithValue("exe", "/usr/bin/cat"))
+			Expect(audit.Execve).To(Equal(&types.AuditLinuxExecve{Argc: 2, Args: []string{"cat", "/etc/my file"}}))
+			Expect(audit.Cwd).To(Equal("/root"))
+			Expect(audit.Proctitle).To(Equal("cat /etc/my file"))
+			Expect(audit.Paths).To(ConsistOf(HaveKeyWithValue("name", "/usr/bin/cat")))
+			Expect(audit.User).To(HaveKeyWithValue("auid", types.AuditLinuxID{ID: "1000", Name: "alice"}))
+			Expect(audit.User).To(HaveKeyWithValue("uid", types.AuditLinuxID{ID: "0", Name: "root"}))
+		})
 		It("should parse ovn audit log correctly", func() {
 			// Log message data
 			level := "info"
@@ -0,0 +1,129 @@
+package datadog
+
+import (
+	"encoding/json"
+	"time"
+
+	obstestruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/test/client"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	datadoghelper "github.com/openshift/cluster-logging-operator/test/helpers/datadog"
+	"github.com/openshift/cluster-logging-operator/test/matchers"
+)
+
+var _ = Describe("Forwarding to Datadog", func() {
+	var (
+		framework *functional.CollectorFunctionalFramework
+	)
+
+	setup := func(inputType obs.InputType, visitor func(output *obs.OutputSpec), testOptions ...client.TestOption) {
+		framework = functional.NewCollectorFunctionalFramework(testOptions...)
+
+		secret := runtime.NewSecret(framework.Namespace, datadoghelper.SecretName,
+			map[string][]byte{
+				datadoghelper.APIKey: []byte("fake-api-key"),
+			},
+		)
+		framework.Secrets = append(framework.Secrets, secret)
+
+		obstestruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInput(inputType).
+			ToDatadogOutput(func(output *obs.OutputSpec) {
+				output.Datadog.Tuning = &obs.DatadogTuningSpec{
+					Compression: "none",
+				}
+				if visitor != nil {
+					visitor(output)
+				}
+			})
+		Expect(framework.DeployWithVisitor(
+			datadoghelper.NewMockoonVisitor(framework),
+		)).To(BeNil())
+	}
+
+	AfterEach(func() {
+		framework.Cleanup()
+	})
+
+	Context("application logs", func() {
+		It("should accept application logs", func() {
+			setup(obs.InputTypeApplication, nil)
+
+			timestamp := "2020-11-04T18:13:59.061892+00:00"
+			nanoTime, _ := time.Parse(time.RFC3339Nano, timestamp)
+			message := "This is my new test message"
+			appLogTemplate := functional.NewApplicationLogTemplate()
+			appLogTemplate.TimestampLegacy = nanoTime
+			appLogTemplate.Message = message
+			appLogTemplate.Level = "**optional**"
+			appLogTemplate.Kubernetes.PodName = framework.Pod.Name
+			appLogTemplate.Kubernetes.ContainerName = constants.CollectorName
+			appLogTemplate.Kubernetes.NamespaceName = framework.Namespace
+
+			applicationLogLine := functional.NewCRIOLogMessage(timestamp, message, false)
+			Expect(framework.WriteMessagesToApplicationLog(applicationLogLine, 3)).To(BeNil())
+			time.Sleep(30 * time.Second)
+
+			collectorLog, err := framework.ReadCollectorLogs()
+			Expect(err).To(BeNil())
+			Expect(collectorLog).ToNot(ContainSubstring("error sending request"))
+
+			appLogs, err := datadoghelper.ReadApplicationLog(framework.Namespace, framework.Name)
+			Expect(err).To(BeNil())
+			Expect(appLogs).To(HaveLen(3))
+			for i := range 3 {
+				Expect(appLogs[i]).To(matchers.FitLogFormatTemplate(appLogTemplate))
+			}
+		})
+
+		It("should set the Datadog reserved attributes", func() {
+			setup(obs.InputTypeApplication, func(output *obs.OutputSpec) {
+				output.Datadog.Service = "{.kubernetes.namespace_name}"
+				output.Datadog.Source = "my-source"
+				output.Datadog.Tags = []obs.DatadogTag{
+					{Name: "pod", Field: ".kubernetes.pod_name"},
+				}
+			})
+
+			Expect(framework.WriteMessagesToApplicationLog(functional.NewCRIOLogMessage(functional.CRIOTime(time.Now()), "hello datadog", false), 1)).To(BeNil())
+			time.Sleep(30 * time.Second)
+
+			rawEntries, err := datadoghelper.ReadRawLogEntries(framework.Namespace, framework.Name)
+			Expect(err).To(BeNil())
+			Expect(rawEntries).To(HaveLen(1))
+
+			var event map[string]interface{}
+			Expect(json.Unmarshal([]byte(rawEntries[0]), &event)).To(Succeed())
+			Expect(event["service"]).To(Equal(framework.Namespace))
+			Expect(event["ddsource"]).To(Equal("my-source"))
+			Expect(event["ddtags"]).To(Equal("pod:" + framework.Pod.Name))
+			Expect(event["hostname"]).ToNot(BeEmpty())
+		})
+	})
+
+	Context("infrastructure logs", func() {
+		It("should accept infrastructure container logs", func() {
+			setup(obs.InputTypeInfrastructure, nil, client.UseInfraNamespaceTestOption)
+
+			infraLogTemplate := functional.NewContainerInfrastructureLogTemplate()
+			infraLogTemplate.Level = "**optional**"
+			infraLogTemplate.Kubernetes.ContainerStream = "**optional**"
+
+			Expect(framework.WritesInfraContainerLogs(3)).To(BeNil())
+			time.Sleep(30 * time.Second)
+
+			infraLogs, err := datadoghelper.ReadApplicationLog(framework.Namespace, framework.Name)
+			Expect(err).To(BeNil())
+			Expect(infraLogs).To(HaveLen(3))
+			for i := range 3 {
+				Expect(infraLogs[i]).To(matchers.FitLogFormatTemplate(infraLogTemplate))
+			}
+		})
+	})
+})
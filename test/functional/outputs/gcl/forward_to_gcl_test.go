@@ -86,6 +86,52 @@ var _ = Describe("Forwarding to GCP Cloud Logging", func() {
 		})
 	})
 
+	Context("with entry metadata", func() {
+		BeforeEach(func() {
+			framework = functional.NewCollectorFunctionalFramework()
+
+			saJSON, err := gclhelper.GenerateFakeServiceAccountJSON(
+				fmt.Sprintf("https://%s/token", gclhelper.GCLDomain))
+			Expect(err).To(BeNil())
+			framework.Secrets = append(framework.Secrets, runtime.NewSecret(framework.Namespace, gclhelper.SecretName,
+				map[string][]byte{
+					internalgcl.GoogleApplicationCredentialsKey: saJSON,
+				},
+			))
+
+			obstestruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+				FromInput(obs.InputTypeApplication).
+				ToGoogleCloudLoggingOutput(func(output *obs.OutputSpec) {
+					output.GoogleCloudLogging.Labels = map[string]string{
+						"log_type": `{.log_type||"none"}`,
+					}
+				})
+			Expect(framework.DeployWithVisitor(
+				gclhelper.NewMockoonVisitor(framework),
+			)).To(BeNil())
+		})
+
+		It("should write entries with a k8s_container resource, labels and severity", func() {
+			applicationLogLine := functional.NewCRIOLogMessage(functional.CRIOTime(time.Now()), "my error message", false)
+			Expect(framework.WriteMessagesToApplicationLog(applicationLogLine, 1)).To(BeNil())
+			time.Sleep(30 * time.Second)
+
+			entries, err := gclhelper.ReadLogEntries(framework.Namespace, framework.Name)
+			Expect(err).To(BeNil())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Resource).To(HaveKeyWithValue("type", "k8s_container"))
+			Expect(entries[0].Resource).To(HaveKeyWithValue("labels", And(
+				HaveKeyWithValue("project_id", "test-project"),
+				HaveKeyWithValue("location", internalgcl.DefaultLocation),
+				HaveKeyWithValue("namespace_name", framework.Namespace),
+				HaveKeyWithValue("pod_name", framework.Pod.Name),
+				HaveKeyWithValue("container_name", constants.CollectorName),
+			)))
+			Expect(entries[0].Labels).To(HaveKeyWithValue("log_type", string(obs.InputTypeApplication)))
+			Expect(entries[0].Severity).ToNot(BeNil())
+		})
+	})
+
 	Context("infrastructure logs", func() {
 		BeforeEach(func() {
 			setup(obs.InputTypeInfrastructure, client.UseInfraNamespaceTestOption)
@@ -137,5 +183,20 @@ var _ = Describe("Forwarding to GCP Cloud Logging", func() {
 			Expect(auditLogs[0].LogType).To(Equal(string(obs.InputTypeAudit)))
 			Expect(auditLogs[0].Timestamp).ToNot(BeZero())
 		})
+
+		It("should write entries with a k8s_node resource", func() {
+			Expect(framework.WriteK8sAuditLog(1)).To(BeNil())
+			time.Sleep(30 * time.Second)
+
+			entries, err := gclhelper.ReadLogEntries(framework.Namespace, framework.Name)
+			Expect(err).To(BeNil())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Resource).To(HaveKeyWithValue("type", internalgcl.NodeResourceType))
+			Expect(entries[0].Resource).To(HaveKeyWithValue("labels", And(
+				HaveKeyWithValue("location", internalgcl.DefaultLocation),
+				HaveKeyWithValue("node_name", Not(BeEmpty())),
+				HaveKeyWithValue("pod_name", ""),
+			)))
+		})
 	})
 })
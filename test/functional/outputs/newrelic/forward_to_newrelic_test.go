@@ -0,0 +1,100 @@
+package newrelic
+
+import (
+	"encoding/json"
+	"time"
+
+	obstestruntime "github.com/openshift/cluster-logging-operator/test/runtime/observability"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/test/client"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	newrelichelper "github.com/openshift/cluster-logging-operator/test/helpers/newrelic"
+)
+
+var _ = Describe("Forwarding to New Relic", func() {
+	var (
+		framework *functional.CollectorFunctionalFramework
+	)
+
+	setup := func(inputType obs.InputType, testOptions ...client.TestOption) {
+		framework = functional.NewCollectorFunctionalFramework(testOptions...)
+
+		secret := runtime.NewSecret(framework.Namespace, newrelichelper.SecretName,
+			map[string][]byte{
+				newrelichelper.LicenseKey: []byte("fake-license-key"),
+			},
+		)
+		framework.Secrets = append(framework.Secrets, secret)
+
+		obstestruntime.NewClusterLogForwarderBuilder(framework.Forwarder).
+			FromInput(inputType).
+			ToNewRelicOutput(func(output *obs.OutputSpec) {
+				output.NewRelic.Tuning = &obs.NewRelicTuningSpec{
+					Compression: "none",
+				}
+			})
+		Expect(framework.DeployWithVisitor(
+			newrelichelper.NewMockoonVisitor(framework),
+		)).To(BeNil())
+	}
+
+	readLogs := func() []map[string]interface{} {
+		rawEntries, err := newrelichelper.ReadRawLogEntries(framework.Namespace, framework.Name)
+		Expect(err).To(BeNil())
+		var logs []map[string]interface{}
+		for _, raw := range rawEntries {
+			entry := map[string]interface{}{}
+			Expect(json.Unmarshal([]byte(raw), &entry)).To(Succeed())
+			logs = append(logs, entry)
+		}
+		return logs
+	}
+
+	AfterEach(func() {
+		framework.Cleanup()
+	})
+
+	Context("application logs", func() {
+		BeforeEach(func() {
+			setup(obs.InputTypeApplication)
+		})
+
+		It("should accept application logs", func() {
+			message := "This is my new test message"
+			applicationLogLine := functional.NewCRIOLogMessage(functional.CRIOTime(time.Now()), message, false)
+			Expect(framework.WriteMessagesToApplicationLog(applicationLogLine, 3)).To(BeNil())
+			time.Sleep(30 * time.Second)
+
+			collectorLog, err := framework.ReadCollectorLogs()
+			Expect(err).To(BeNil())
+			Expect(collectorLog).ToNot(ContainSubstring("error sending request"))
+
+			logs := readLogs()
+			Expect(logs).To(HaveLen(3))
+			for _, entry := range logs {
+				Expect(entry["message"]).To(Equal(message))
+				Expect(entry["log_type"]).To(Equal(string(obs.InputTypeApplication)))
+				Expect(entry).To(HaveKey("timestamp"))
+			}
+		})
+	})
+
+	Context("audit logs", func() {
+		BeforeEach(func() {
+			setup(obs.InputTypeAudit)
+		})
+
+		It("should accept audit logs", func() {
+			Expect(framework.WriteK8sAuditLog(1)).To(BeNil())
+			time.Sleep(30 * time.Second)
+
+			logs := readLogs()
+			Expect(logs).To(HaveLen(1))
+			Expect(logs[0]["log_type"]).To(Equal(string(obs.InputTypeAudit)))
+		})
+	})
+})
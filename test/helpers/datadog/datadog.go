@@ -0,0 +1,75 @@
+package datadog
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/internal/utils"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	"github.com/openshift/cluster-logging-operator/test/helpers/mockoon"
+	"github.com/openshift/cluster-logging-operator/test/helpers/types"
+)
+
+//go:embed datadog-logs-api.json
+var apiConfig string
+
+const (
+	apiJsonFile   = "datadog-logs-api.json"
+	SecretName    = "datadog-secret"
+	APIKey        = "api-key"
+	IntakeDomain  = "http-intake.logs.datadoghq.com"
+	IntakePort    = int32(443)
+	logsRoutePath = "api/v2/logs"
+)
+
+// NewMockoonVisitor prepares the framework for Datadog testing and returns a PodBuilder visitor serving the Logs API
+// on the intake host of the default site to which the collector sends.
+func NewMockoonVisitor(framework *functional.CollectorFunctionalFramework) runtime.PodBuilderVisitor {
+	return mockoon.NewVendorVisitor(framework, IntakeDomain, IntakePort, apiJsonFile, apiConfig)
+}
+
+func extractRawEntries(output string) ([]string, error) {
+	bodies, err := mockoon.RequestBodies(output, logsRoutePath, 202)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []string
+	for _, body := range bodies {
+		var events []json.RawMessage
+		if err := json.Unmarshal([]byte(body), &events); err != nil {
+			fmt.Printf("error parsing Datadog logs request: %v\n", err)
+			continue
+		}
+		for _, event := range events {
+			entries = append(entries, string(event))
+		}
+	}
+
+	return entries, nil
+}
+
+// ReadRawLogEntries reads the raw JSON log events from the Mockoon transaction logs.
+func ReadRawLogEntries(ns, podName string) ([]string, error) {
+	output, err := mockoon.ReadLogs(ns, podName)
+	if err != nil {
+		return nil, err
+	}
+	return extractRawEntries(output)
+}
+
+// ReadApplicationLog reads and parses application logs from the Mockoon container.
+func ReadApplicationLog(ns, podName string) ([]types.ApplicationLog, error) {
+	rawEntries, err := ReadRawLogEntries(ns, podName)
+	if err != nil {
+		return nil, err
+	}
+
+	var appLogs []types.ApplicationLog
+	if err := types.ParseLogsFrom(utils.ToJsonLogs(rawEntries), &appLogs, false); err != nil {
+		return nil, err
+	}
+	return appLogs, nil
+}
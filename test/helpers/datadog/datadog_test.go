@@ -0,0 +1,64 @@
+package datadog
+
+import (
+	_ "embed"
+	"encoding/json"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/cluster-logging-operator/test/helpers/mockoon"
+)
+
+//go:embed test_mockoon_datadog.log
+var logData string
+
+var _ = Describe("Parsing Mockoon logs for the Datadog Logs API", func() {
+
+	It("should parse log events from test data", func() {
+		entries, err := extractRawEntries(logData)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(3))
+
+		expectedMessages := []string{"First test message", "Second test message", "Third test message"}
+		for i, raw := range entries {
+			var payload map[string]interface{}
+			Expect(json.Unmarshal([]byte(raw), &payload)).To(Succeed())
+			Expect(payload["message"]).To(Equal(expectedMessages[i]))
+			Expect(payload["ddsource"]).To(Equal("openshift"))
+		}
+	})
+
+	It("should ignore GET requests", func() {
+		input := mockoon.NewLogLine("GET", "/api/v2/logs", 202, `[{"message":"should be ignored"}]`)
+		entries, err := extractRawEntries(input)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(BeEmpty())
+	})
+
+	It("should ignore rejected requests", func() {
+		input := mockoon.NewLogLine("POST", "/api/v2/logs", 403, `[{"message":"should be ignored"}]`)
+		entries, err := extractRawEntries(input)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(BeEmpty())
+	})
+
+	It("should skip malformed body without error", func() {
+		input := mockoon.NewLogLine("POST", "/api/v2/logs", 202, `not-valid-json`)
+		entries, err := extractRawEntries(input)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(BeEmpty())
+	})
+
+	It("should aggregate logs across multiple batches", func() {
+		line1 := mockoon.NewLogLine("POST", "/api/v2/logs", 202, `[{"message":"batch1-msg1"},{"message":"batch1-msg2"}]`)
+		line2 := mockoon.NewLogLine("POST", "/api/v2/logs", 202, `[{"message":"batch2-msg1"}]`)
+		entries, err := extractRawEntries(strings.Join([]string{line1, line2}, "\n"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(Equal([]string{
+			`{"message":"batch1-msg1"}`,
+			`{"message":"batch1-msg2"}`,
+			`{"message":"batch2-msg1"}`,
+		}))
+	})
+})
@@ -0,0 +1,126 @@
+package fluentd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	obs "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/test/helpers/certificate"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	sender          = "fluent-sender"
+	senderConfigDir = "/opt/app-root/etc/sender"
+	// relayPort is the port on which the sender relays records written with fluent-cat over TLS
+	relayPort = 24225
+)
+
+// CommandRunner runs a command in a container of the collector pod
+type CommandRunner interface {
+	RunCommand(container string, cmd ...string) (string, error)
+}
+
+// AddSenderContainer adds a fluentd container to the collector pod used to send records with fluent-cat
+func AddSenderContainer(pb *runtime.PodBuilder) error {
+	pb.AddContainer(sender, Image).WithCmd([]string{"sh", "-c", "sleep infinity"}).End()
+	return nil
+}
+
+// WriteToFluentForwardInput sends a JSON record with the given tag to a fluentForward receiver input using the forward protocol
+func WriteToFluentForwardInput(runner CommandRunner, inputs []obs.InputSpec, inputName, tag, record string) error {
+	for _, input := range inputs {
+		if input.Type == obs.InputTypeReceiver && input.Receiver != nil && input.Receiver.Type == obs.ReceiverTypeFluentForward && input.Name == inputName {
+			return writeWithFluentCat(runner, input.Receiver.Port, tag, record)
+		}
+	}
+	return fmt.Errorf("WriteToFluentForwardInput: no fluentForward input named %s", inputName)
+}
+
+// TLSSender relays the records written with fluent-cat to a fluentForward input using TLS
+type TLSSender struct {
+	// Port is the port of the fluentForward input
+	Port int32
+	// CA verifies the serving certificate of the input
+	CA *certificate.CertKey
+	// Cert is the client certificate presented to the input when defined
+	Cert *certificate.CertKey
+	// SharedKey authenticates the sender with the shared key handshake of the forward protocol when not empty
+	SharedKey string
+}
+
+// ConfigMap returns the certificates and the fluentd configuration of the sender
+func (s TLSSender) ConfigMap(ns, name string) *corev1.ConfigMap {
+	data := map[string]string{
+		"ca.pem": string(s.CA.CertificatePEM()),
+	}
+	if s.Cert != nil {
+		data["cert.pem"] = string(s.Cert.CertificatePEM())
+		data["key.pem"] = string(s.Cert.PrivateKeyPEM())
+	}
+	t := template.Must(template.New("sender").Funcs(template.FuncMap{
+		"ConfigPath": func(name string) string { return filepath.Join(senderConfigDir, name) },
+	}).Parse(`
+<source>
+  @type forward
+  bind 127.0.0.1
+  port {{.RelayPort}}
+</source>
+<match **>
+  @type forward
+  transport tls
+  tls_cert_path {{ConfigPath "ca.pem"}}
+{{- if .Cert}}
+  tls_client_cert_path {{ConfigPath "cert.pem"}}
+  tls_client_private_key_path {{ConfigPath "key.pem"}}
+{{- end}}
+{{- if .SharedKey}}
+  <security>
+    self_hostname fluent-sender
+    shared_key "{{.SharedKey}}"
+  </security>
+{{- end}}
+  <server>
+    host 127.0.0.1
+    port {{.Port}}
+  </server>
+  <buffer>
+    flush_interval 1s
+  </buffer>
+</match>
+`))
+	conf := &strings.Builder{}
+	if err := t.Execute(conf, struct {
+		TLSSender
+		RelayPort int
+	}{s, relayPort}); err != nil {
+		panic(err)
+	}
+	data["fluent.conf"] = conf.String()
+	return runtime.NewConfigMap(ns, name, data)
+}
+
+// AddTLSSenderContainer adds a fluentd container to the collector pod relaying the records written with fluent-cat
+// using the configuration of a TLSSender in the given configmap
+func AddTLSSenderContainer(pb *runtime.PodBuilder, configMapName string) error {
+	pb.AddConfigMapVolume(sender, configMapName).
+		AddContainer(sender, Image).
+		AddVolumeMount(sender, senderConfigDir, "", true).
+		WithCmd([]string{"fluentd", "--no-supervisor", "-c", filepath.Join(senderConfigDir, "fluent.conf")}).
+		End()
+	return nil
+}
+
+// WriteToTLSSender sends a JSON record with the given tag to the sender added by AddTLSSenderContainer
+func WriteToTLSSender(runner CommandRunner, tag, record string) error {
+	return writeWithFluentCat(runner, relayPort, tag, record)
+}
+
+func writeWithFluentCat(runner CommandRunner, port int32, tag, record string) error {
+	cmd := fmt.Sprintf("echo %q | fluent-cat --host 127.0.0.1 --port %d %s", record, port, tag)
+	_, err := runner.RunCommand(sender, "sh", "-c", cmd)
+	return err
+}
@@ -8,17 +8,12 @@ import (
 	"encoding/json"
 	"encoding/pem"
 	"fmt"
-	"strings"
 
-	"github.com/openshift/cluster-logging-operator/internal/constants"
 	"github.com/openshift/cluster-logging-operator/internal/runtime"
 	"github.com/openshift/cluster-logging-operator/internal/utils"
 	"github.com/openshift/cluster-logging-operator/test/framework/functional"
-	"github.com/openshift/cluster-logging-operator/test/helpers/certificate"
 	"github.com/openshift/cluster-logging-operator/test/helpers/mockoon"
-	"github.com/openshift/cluster-logging-operator/test/helpers/oc"
 	"github.com/openshift/cluster-logging-operator/test/helpers/types"
-	v1 "k8s.io/api/core/v1"
 )
 
 //go:embed gcl-logging-api.json
@@ -38,6 +33,7 @@ type GCLWriteRequest struct {
 type GCLEntry struct {
 	LogName     string                 `json:"logName"`
 	Resource    map[string]interface{} `json:"resource"`
+	Labels      map[string]interface{} `json:"labels,omitempty"`
 	JsonPayload map[string]interface{} `json:"jsonPayload"`
 	Severity    interface{}            `json:"severity"`
 }
@@ -80,97 +76,63 @@ func GenerateFakeServiceAccountJSON(tokenURI string) ([]byte, error) {
 	return json.Marshal(sa)
 }
 
-// NewMockoonVisitor prepares the framework for GCP Cloud Logging testing and returns
-// a PodBuilder visitor. Vector's gcp_stackdriver_logs sink hardcodes
-// logging.googleapis.com:443 as the endpoint with no override, so we redirect
-// at the network level: a host alias resolves the domain to 127.0.0.1, Mockoon
-// runs as root (UID 0) to bind port 443, and a TLS certificate is generated
-// for the real domain name.
+// NewMockoonVisitor prepares the framework for GCP Cloud Logging testing and returns a PodBuilder visitor serving the
+// Cloud Logging API since Vector's gcp_stackdriver_logs sink hardcodes logging.googleapis.com:443 as the endpoint
+// with no override.
 func NewMockoonVisitor(framework *functional.CollectorFunctionalFramework) runtime.PodBuilderVisitor {
-	return func(pb *runtime.PodBuilder) error {
-		ca := certificate.NewCA(nil, "Test CA")
-		serverCert := certificate.NewCert(ca, "test", GCLDomain)
-
-		configMap := runtime.NewConfigMap(framework.Namespace, mockoon.ContainerName, map[string]string{})
-		runtime.NewConfigMapBuilder(configMap).
-			Add(apiJsonFile, apiConfig).
-			Add("tls.crt", string(serverCert.CertificatePEM())).
-			Add("tls.key", string(serverCert.PrivateKeyPEM())).
-			Add("ca.crt", string(ca.CertificatePEM()))
-		if err := framework.Test.Create(configMap); err != nil {
-			return err
-		}
+	return mockoon.NewVendorVisitor(framework, GCLDomain, GCLPort, apiJsonFile, apiConfig)
+}
 
-		hostAlias := v1.HostAlias{
-			IP:        "127.0.0.1",
-			Hostnames: []string{GCLDomain},
-		}
+func extractEntries(output string) ([]GCLEntry, error) {
+	bodies, err := mockoon.RequestBodies(output, "entries:write", 200)
+	if err != nil {
+		return nil, err
+	}
 
-		mountPath := "/data"
-		mockoonDataVolume := "mockoon-data"
-
-		pb.AddConfigMapVolume(mockoonDataVolume, mockoon.ContainerName).
-			AddHostAlias(hostAlias).
-			AddContainer(mockoon.ContainerName, mockoon.Image).
-			AddRunAsUser(0).
-			AddContainerPort(mockoon.ContainerName, GCLPort).
-			WithCmdArgs([]string{
-				fmt.Sprintf("--data=%s/%s", mountPath, apiJsonFile),
-				"--log-transaction",
-			}).AddVolumeMount(mockoonDataVolume, mountPath, "", true).End()
-
-		combinedBundle := "/tmp/ca-bundle.crt"
-		pb.GetContainer(constants.CollectorName).
-			AddEnvVar("SSL_CERT_FILE", combinedBundle).
-			WithCmd([]string{"sh", "-c", fmt.Sprintf(
-				"cat /etc/pki/tls/certs/ca-bundle.crt %s/ca.crt > %s && exec /opt/app-root/src/run.sh",
-				mountPath, combinedBundle)}).
-			AddVolumeMount(mockoonDataVolume, mountPath, "", true).
-			Update()
-
-		return nil
+	var entries []GCLEntry
+	for _, body := range bodies {
+		var writeReq GCLWriteRequest
+		if err := json.Unmarshal([]byte(body), &writeReq); err != nil {
+			fmt.Printf("error parsing GCL write request: %v\n", err)
+			continue
+		}
+		entries = append(entries, writeReq.Entries...)
 	}
-}
 
-func readMockoonLogs(ns, podName string) (string, error) {
-	return oc.Logs().WithNamespace(ns).WithPod(podName).WithContainer(mockoon.ContainerName).Run()
+	return entries, nil
 }
 
 func extractRawEntries(output string) ([]string, error) {
-	logs, err := mockoon.DecodeLogs(output)
+	gclEntries, err := extractEntries(output)
 	if err != nil {
 		return nil, err
 	}
 
 	var entries []string
-	for _, log := range logs {
-		if log.RequestMethod != "POST" || log.ResponseStatus != 200 ||
-			!strings.Contains(log.RequestPath, "entries:write") {
+	for _, entry := range gclEntries {
+		payloadJSON, err := json.Marshal(entry.JsonPayload)
+		if err != nil {
+			fmt.Printf("error marshaling jsonPayload: %v\n", err)
 			continue
 		}
-
-		var writeReq GCLWriteRequest
-		if err := json.Unmarshal([]byte(log.Transaction.Request.Body), &writeReq); err != nil {
-			fmt.Printf("error parsing GCL write request: %v\n", err)
-			continue
-		}
-
-		for _, entry := range writeReq.Entries {
-			payloadJSON, err := json.Marshal(entry.JsonPayload)
-			if err != nil {
-				fmt.Printf("error marshaling jsonPayload: %v\n", err)
-				continue
-			}
-			entries = append(entries, string(payloadJSON))
-		}
+		entries = append(entries, string(payloadJSON))
 	}
 
 	return entries, nil
 }
 
+// ReadLogEntries reads the log entries, including their resource and severity, from the Mockoon transaction logs.
+func ReadLogEntries(ns, podName string) ([]GCLEntry, error) {
+	output, err := mockoon.ReadLogs(ns, podName)
+	if err != nil {
+		return nil, err
+	}
+	return extractEntries(output)
+}
+
 // ReadRawLogEntries reads raw JSON payloads from the Mockoon transaction logs.
 func ReadRawLogEntries(ns, podName string) ([]string, error) {
-	output, err := readMockoonLogs(ns, podName)
+	output, err := mockoon.ReadLogs(ns, podName)
 	if err != nil {
 		return nil, err
 	}
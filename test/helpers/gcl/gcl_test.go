@@ -29,6 +29,14 @@ var _ = Describe("Parsing Mockoon logs for GCP Cloud Logging API", func() {
 		}
 	})
 
+	It("should parse the resource and severity of entries", func() {
+		entries, err := extractEntries(logData)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(3))
+		Expect(entries[0].Resource).To(HaveKeyWithValue("type", "k8s_node"))
+		Expect(entries[0].Severity).To(Equal("DEFAULT"))
+	})
+
 	It("should ignore OAuth2 token requests", func() {
 		body := `{"entries":[{"logName":"projects/test/logs/test","jsonPayload":{"log_type":"application","message":"should be ignored"},"severity":"DEFAULT"}]}`
 		input := mockoon.NewLogLine("POST", "/token", 200, body)
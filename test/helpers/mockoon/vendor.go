@@ -0,0 +1,87 @@
+package mockoon
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openshift/cluster-logging-operator/internal/constants"
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	"github.com/openshift/cluster-logging-operator/test/helpers/certificate"
+	"github.com/openshift/cluster-logging-operator/test/helpers/oc"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	mountPath  = "/data"
+	dataVolume = "mockoon-data"
+)
+
+// NewVendorVisitor prepares the framework to serve the API of a vendor whose endpoint can not be overridden in the
+// sink of the collector and returns a PodBuilder visitor. A host alias resolves the domain of the vendor to 127.0.0.1,
+// Mockoon runs as root (UID 0) to bind the port of the API (e.g. 443) with the routes of the apiConfig, and the
+// collector trusts the TLS certificate generated for the real domain name.
+func NewVendorVisitor(framework *functional.CollectorFunctionalFramework, domain string, port int32, apiFile, apiConfig string) runtime.PodBuilderVisitor {
+	return func(pb *runtime.PodBuilder) error {
+		ca := certificate.NewCA(nil, "Test CA")
+		serverCert := certificate.NewCert(ca, "test", domain)
+
+		configMap := runtime.NewConfigMap(framework.Namespace, ContainerName, map[string]string{})
+		runtime.NewConfigMapBuilder(configMap).
+			Add(apiFile, apiConfig).
+			Add("tls.crt", string(serverCert.CertificatePEM())).
+			Add("tls.key", string(serverCert.PrivateKeyPEM())).
+			Add("ca.crt", string(ca.CertificatePEM()))
+		if err := framework.Test.Create(configMap); err != nil {
+			return err
+		}
+
+		hostAlias := v1.HostAlias{
+			IP:        "127.0.0.1",
+			Hostnames: []string{domain},
+		}
+
+		pb.AddConfigMapVolume(dataVolume, ContainerName).
+			AddHostAlias(hostAlias).
+			AddContainer(ContainerName, Image).
+			AddRunAsUser(0).
+			AddContainerPort(ContainerName, port).
+			WithCmdArgs([]string{
+				fmt.Sprintf("--data=%s/%s", mountPath, apiFile),
+				"--log-transaction",
+			}).AddVolumeMount(dataVolume, mountPath, "", true).End()
+
+		combinedBundle := "/tmp/ca-bundle.crt"
+		pb.GetContainer(constants.CollectorName).
+			AddEnvVar("SSL_CERT_FILE", combinedBundle).
+			WithCmd([]string{"sh", "-c", fmt.Sprintf(
+				"cat /etc/pki/tls/certs/ca-bundle.crt %s/ca.crt > %s && exec /opt/app-root/src/run.sh",
+				mountPath, combinedBundle)}).
+			AddVolumeMount(dataVolume, mountPath, "", true).
+			Update()
+
+		return nil
+	}
+}
+
+// ReadLogs reads the transaction logs of the Mockoon container of a pod
+func ReadLogs(ns, podName string) (string, error) {
+	return oc.Logs().WithNamespace(ns).WithPod(podName).WithContainer(ContainerName).Run()
+}
+
+// RequestBodies returns the bodies of the POST requests whose path contains the route and which were answered with
+// the status from Mockoon's transaction log output
+func RequestBodies(output, route string, status int) ([]string, error) {
+	logs, err := DecodeLogs(output)
+	if err != nil {
+		return nil, err
+	}
+	var bodies []string
+	for _, log := range logs {
+		if log.RequestMethod != "POST" || log.ResponseStatus != status || !strings.Contains(log.RequestPath, route) {
+			continue
+		}
+		bodies = append(bodies, log.Transaction.Request.Body)
+	}
+	return bodies, nil
+}
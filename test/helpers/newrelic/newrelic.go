@@ -0,0 +1,66 @@
+package newrelic
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+
+	"github.com/openshift/cluster-logging-operator/internal/runtime"
+	"github.com/openshift/cluster-logging-operator/test/framework/functional"
+	"github.com/openshift/cluster-logging-operator/test/helpers/mockoon"
+)
+
+//go:embed newrelic-log-api.json
+var apiConfig string
+
+const (
+	apiJsonFile   = "newrelic-log-api.json"
+	SecretName    = "newrelic-secret"
+	LicenseKey    = "license-key"
+	LogAPIDomain  = "log-api.newrelic.com"
+	LogAPIPort    = int32(443)
+	logsRoutePath = "log/v1"
+)
+
+// LogAPIBlock is a block of logs of a New Relic log API payload
+type LogAPIBlock struct {
+	Logs []json.RawMessage `json:"logs"`
+}
+
+// NewMockoonVisitor prepares the framework for New Relic testing and returns a PodBuilder visitor serving the log API
+// on the US log API domain since Vector's new_relic sink derives the endpoint from the region with no override.
+func NewMockoonVisitor(framework *functional.CollectorFunctionalFramework) runtime.PodBuilderVisitor {
+	return mockoon.NewVendorVisitor(framework, LogAPIDomain, LogAPIPort, apiJsonFile, apiConfig)
+}
+
+func extractRawEntries(output string) ([]string, error) {
+	bodies, err := mockoon.RequestBodies(output, logsRoutePath, 202)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []string
+	for _, body := range bodies {
+		var blocks []LogAPIBlock
+		if err := json.Unmarshal([]byte(body), &blocks); err != nil {
+			fmt.Printf("error parsing New Relic log API request: %v\n", err)
+			continue
+		}
+		for _, block := range blocks {
+			for _, entry := range block.Logs {
+				entries = append(entries, string(entry))
+			}
+		}
+	}
+
+	return entries, nil
+}
+
+// ReadRawLogEntries reads the raw JSON logs from the Mockoon transaction logs.
+func ReadRawLogEntries(ns, podName string) ([]string, error) {
+	output, err := mockoon.ReadLogs(ns, podName)
+	if err != nil {
+		return nil, err
+	}
+	return extractRawEntries(output)
+}
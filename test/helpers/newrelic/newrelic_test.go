@@ -0,0 +1,63 @@
+package newrelic
+
+import (
+	_ "embed"
+	"encoding/json"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/openshift/cluster-logging-operator/test/helpers/mockoon"
+)
+
+//go:embed test_mockoon_newrelic.log
+var logData string
+
+var _ = Describe("Parsing Mockoon logs for the New Relic Log API", func() {
+
+	It("should parse logs from test data", func() {
+		entries, err := extractRawEntries(logData)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(HaveLen(3))
+
+		expectedMessages := []string{"First test message", "Second test message", "Third test message"}
+		for i, raw := range entries {
+			var payload map[string]interface{}
+			Expect(json.Unmarshal([]byte(raw), &payload)).To(Succeed())
+			Expect(payload["message"]).To(Equal(expectedMessages[i]))
+		}
+	})
+
+	It("should ignore GET requests", func() {
+		input := mockoon.NewLogLine("GET", "/log/v1", 202, `[{"logs":[{"message":"should be ignored"}]}]`)
+		entries, err := extractRawEntries(input)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(BeEmpty())
+	})
+
+	It("should ignore rejected requests", func() {
+		input := mockoon.NewLogLine("POST", "/log/v1", 403, `[{"logs":[{"message":"should be ignored"}]}]`)
+		entries, err := extractRawEntries(input)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(BeEmpty())
+	})
+
+	It("should skip malformed body without error", func() {
+		input := mockoon.NewLogLine("POST", "/log/v1", 202, `not-valid-json`)
+		entries, err := extractRawEntries(input)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(BeEmpty())
+	})
+
+	It("should aggregate logs across blocks and batches", func() {
+		line1 := mockoon.NewLogLine("POST", "/log/v1", 202, `[{"logs":[{"message":"batch1-msg1"}]},{"logs":[{"message":"batch1-msg2"}]}]`)
+		line2 := mockoon.NewLogLine("POST", "/log/v1", 202, `[{"logs":[{"message":"batch2-msg1"}]}]`)
+		entries, err := extractRawEntries(strings.Join([]string{line1, line2}, "\n"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entries).To(Equal([]string{
+			`{"message":"batch1-msg1"}`,
+			`{"message":"batch1-msg2"}`,
+			`{"message":"batch2-msg1"}`,
+		}))
+	})
+})
@@ -0,0 +1,22 @@
+package types
+
+import (
+	eventsv1 "k8s.io/api/events/v1"
+)
+
+// KubernetesEventLog is a Viaq wrappered events.k8s.io/v1 event collected from the API server
+type KubernetesEventLog struct {
+	ViaQCommon `json:",inline,omitempty"`
+
+	// The Kubernetes-specific metadata
+	Kubernetes KubernetesWithAPIEvent `json:"kubernetes,omitempty"`
+}
+
+type KubernetesWithAPIEvent struct {
+
+	// NamespaceName is the namespace of the object the event is about
+	NamespaceName string `json:"namespace_name,omitempty"`
+
+	// Event is the events.k8s.io/v1 event
+	Event eventsv1.Event `json:"event,omitempty"`
+}
@@ -330,9 +330,46 @@ type LinuxAuditLog struct {
 	Level            string `json:"level,omitempty"`
 }
 
+// AuditLinux is the structured host audit event correlated from the records of the event
 type AuditLinux struct {
+	// Type is the type of the first record of the event
 	Type     string `json:"type,omitempty"`
 	RecordID string `json:"record_id,omitempty"`
+
+	// RecordTypes are the types of all the records of the event in order
+	RecordTypes []string `json:"record_types,omitempty"`
+
+	// Syscall are the fields of the SYSCALL record, including the name of the syscall when available
+	Syscall map[string]string `json:"syscall,omitempty"`
+
+	// Execve are the decoded arguments of the EXECVE record
+	Execve *AuditLinuxExecve `json:"execve,omitempty"`
+
+	// Cwd is the decoded working directory of the CWD record
+	Cwd string `json:"cwd,omitempty"`
+
+	// Proctitle is the decoded command line of the PROCTITLE record
+	Proctitle string `json:"proctitle,omitempty"`
+
+	// Paths are the fields of the PATH records with decoded names
+	Paths []map[string]string `json:"paths,omitempty"`
+
+	// User are the user and group ids of the event keyed by field, e.g. auid or uid
+	User map[string]AuditLinuxID `json:"user,omitempty"`
+
+	// Fields are the fields of the first record of an event without a SYSCALL record
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+type AuditLinuxExecve struct {
+	Argc int      `json:"argc,omitempty"`
+	Args []string `json:"args,omitempty"`
+}
+
+// AuditLinuxID is a user or group id with its name when auditd logs enriched records
+type AuditLinuxID struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
 }
 
 // OVN Audit log
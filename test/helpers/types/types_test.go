@@ -154,6 +154,51 @@ const (
   "viaq_index_name": "audit-write",
   "viaq_msg_id": "Y2M1NThmYzUtODYxYS00MzY5LWJmZDQtN2FkYjk4ZDlmYjE3",
   "kubernetes": {}
+}
+   `
+	LinuxAuditSyscallLogStr = `
+{
+  "hostname": "crc-j55b9-master-0",
+  "audit.linux": {
+    "type": "SYSCALL",
+    "record_id": "6174",
+    "record_types": ["SYSCALL", "EXECVE", "CWD", "PATH", "PROCTITLE"],
+    "syscall": {
+      "arch": "c000003e",
+      "syscall": "59",
+      "name": "execve",
+      "success": "yes",
+      "exit": "0",
+      "pid": "4215",
+      "auid": "1000",
+      "uid": "0",
+      "comm": "cat",
+      "exe": "/usr/bin/cat",
+      "key": "exec"
+    },
+    "execve": {
+      "argc": 2,
+      "args": ["cat", "/etc/my file"]
+    },
+    "cwd": "/root",
+    "proctitle": "cat /etc/my file",
+    "paths": [
+      {"item": "0", "name": "/usr/bin/cat", "inode": "1442", "nametype": "NORMAL"}
+    ],
+    "user": {
+      "auid": {"id": "1000", "name": "alice"},
+      "uid": {"id": "0", "name": "root"}
+    }
+  },
+  "message": "type=SYSCALL msg=audit(1606655808.785:6174): arch=c000003e syscall=59 success=yes exit=0 pid=4215 auid=1000 uid=0 comm=\"cat\" exe=\"/usr/bin/cat\" key=\"exec\"\u001dARCH=x86_64 SYSCALL=execve AUID=\"alice\" UID=\"root\"\ntype=EXECVE msg=audit(1606655808.785:6174): argc=2 a0=\"cat\" a1=2F6574632F6D792066696C65\ntype=CWD msg=audit(1606655808.785:6174): cwd=\"/root\"\ntype=PATH msg=audit(1606655808.785:6174): item=0 name=\"/usr/bin/cat\" inode=1442 nametype=NORMAL\ntype=PROCTITLE msg=audit(1606655808.785:6174): proctitle=636174002F6574632F6D792066696C65",
+  "log_source": "auditd",
+  "log_type": "audit",
+  "level": "default",
+  "timestamp": "2020-11-29T13:16:48.785000+00:00",
+  "@timestamp": "2020-11-29T13:16:48.785000+00:00",
+  "viaq_index_name": "audit-write",
+  "viaq_msg_id": "Y2M1NThmYzUtODYxYS00MzY5LWJmZDQtN2FkYjk4ZDlmYjE3",
+  "kubernetes": {}
 }
    `
 	K8sAuditLogStr = `
@@ -391,6 +436,7 @@ var _ = Describe("[test][helpers][types]", func() {
 		Entry("journal logs", []JournalLog{}, JournalLogStr),
 		Entry("infra container and journal logs as infra logs", []InfraLog{}, InfraContainerLogStr, JournalLogStr),
 		Entry("linux auditd logs", []LinuxAuditLog{}, LinuxAuditLogStr),
+		Entry("correlated linux auditd syscall logs", []LinuxAuditLog{}, LinuxAuditSyscallLogStr),
 		Entry("OVN audit logs", []OVNAuditLog{}, OVNAuditLogStr),
 		Entry("kubernetes audit logs", []K8sAuditLog{}, K8sAuditLogStr),
 		Entry("eventrouter logs", []EventRouterLog{}, EventRouterLogExample),
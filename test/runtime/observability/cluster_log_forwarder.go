@@ -8,8 +8,10 @@ import (
 	"github.com/openshift/cluster-logging-operator/internal/constants"
 	gcl "github.com/openshift/cluster-logging-operator/internal/generator/vector/output/gcl"
 	"github.com/openshift/cluster-logging-operator/test/helpers/azure/logsingestion"
+	datadoghelper "github.com/openshift/cluster-logging-operator/test/helpers/datadog"
 	gclhelper "github.com/openshift/cluster-logging-operator/test/helpers/gcl"
 	"github.com/openshift/cluster-logging-operator/test/helpers/kafka"
+	newrelichelper "github.com/openshift/cluster-logging-operator/test/helpers/newrelic"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
@@ -383,6 +385,45 @@ func (p *PipelineBuilder) ToGoogleCloudLoggingOutput(visitors ...func(output *ob
 	return p.ToOutputWithVisitor(v, string(obs.OutputTypeGoogleCloudLogging))
 }
 
+func (p *PipelineBuilder) ToDatadogOutput(visitors ...func(output *obs.OutputSpec)) *ClusterLogForwarderBuilder {
+	v := func(output *obs.OutputSpec) {
+		output.Name = string(obs.OutputTypeDatadog)
+		output.Type = obs.OutputTypeDatadog
+		output.Datadog = &obs.Datadog{
+			Authentication: &obs.DatadogAuthentication{
+				APIKey: &obs.SecretReference{
+					Key:        datadoghelper.APIKey,
+					SecretName: datadoghelper.SecretName,
+				},
+			},
+		}
+		for _, v := range visitors {
+			v(output)
+		}
+	}
+	return p.ToOutputWithVisitor(v, string(obs.OutputTypeDatadog))
+}
+
+func (p *PipelineBuilder) ToNewRelicOutput(visitors ...func(output *obs.OutputSpec)) *ClusterLogForwarderBuilder {
+	v := func(output *obs.OutputSpec) {
+		output.Name = string(obs.OutputTypeNewRelic)
+		output.Type = obs.OutputTypeNewRelic
+		output.NewRelic = &obs.NewRelic{
+			AccountID: "1234567",
+			Authentication: &obs.NewRelicAuthentication{
+				LicenseKey: &obs.SecretReference{
+					Key:        newrelichelper.LicenseKey,
+					SecretName: newrelichelper.SecretName,
+				},
+			},
+		}
+		for _, v := range visitors {
+			v(output)
+		}
+	}
+	return p.ToOutputWithVisitor(v, string(obs.OutputTypeNewRelic))
+}
+
 func (p *PipelineBuilder) ToOutputWithVisitor(visit OutputSpecVisitor, outputName string) *ClusterLogForwarderBuilder {
 	clf := p.clfb.Forwarder
 	outputs := internalobs.Outputs(clf.Spec.Outputs).Map()
@@ -28,6 +28,24 @@ func ClusterVersion(k8client client.Reader) (string, string, error) {
 	return clusterVersion, clusterID, nil
 }
 
+// ClusterRegion retrieves the region of the cloud platform of the cluster or an empty string when the platform has none
+func ClusterRegion(k8client client.Reader) string {
+	infra := &configv1.Infrastructure{}
+	if err := k8client.Get(context.TODO(), client.ObjectKey{Name: "cluster"}, infra); err != nil {
+		log.V(3).Error(err, "Unable to retrieve the cluster infrastructure")
+		return ""
+	}
+	if status := infra.Status.PlatformStatus; status != nil {
+		switch {
+		case status.GCP != nil:
+			return status.GCP.Region
+		case status.AWS != nil:
+			return status.AWS.Region
+		}
+	}
+	return ""
+}
+
 // HostedClusterVersion retrieves the version info of the hosted cluster or the cluster ID where the operator is deployed
 // upon error
 func HostedClusterVersion(ctx context.Context, k8client client.Reader, namespace string) (version, id string) {